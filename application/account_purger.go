@@ -0,0 +1,217 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// purgePageSize is how many media a purge deletes at a time.
+const purgePageSize = 100
+
+// PurgeRepositories are the repositories a purge deletes from.
+type PurgeRepositories struct {
+	Users           db.UserRepository
+	Tweets          db.TweetRepository
+	ScheduledTweets db.ScheduledTweetRepository
+	Media           db.MediaRepository
+	Bookmarks       db.BookmarkRepository
+	PollVotes       db.PollVoteRepository
+	FollowRequests  db.FollowRequestRepository
+	Notifications   db.NotificationRepository
+	Lists           db.ListRepository
+	Exports         db.AccountExportRepository
+}
+
+// AccountPurger purges the data of deleted users once their grace period is
+// over. Like the TweetScheduler, several purgers may run against the same
+// repositories: each due deletion is claimed by a single one under a lease.
+// Progress is saved after every step, so a purge whose purger died resumes
+// where it stopped once the lease expires.
+type AccountPurger struct {
+	deletionRepo db.AccountDeletionRepository
+	repos        PurgeRepositories
+	blobs        infrastructure.BlobStore
+	events       infrastructure.EventPublisher
+	logger       *slog.Logger
+
+	owner     string
+	interval  time.Duration
+	lease     time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+// NewAccountPurger checks for due deletions every interval and purges up to
+// batchSize of them at a time, each claimed for lease. The lease is renewed
+// after every step, so it only needs to outlast the longest step.
+func NewAccountPurger(deletionRepo db.AccountDeletionRepository, repos PurgeRepositories, blobs infrastructure.BlobStore, events infrastructure.EventPublisher, interval, lease time.Duration, batchSize int, logger *slog.Logger) *AccountPurger {
+	return &AccountPurger{
+		deletionRepo: deletionRepo,
+		repos:        repos,
+		blobs:        blobs,
+		events:       events,
+		logger:       logger,
+		owner:        generateID(),
+		interval:     interval,
+		lease:        lease,
+		batchSize:    batchSize,
+		now:          time.Now,
+	}
+}
+
+// Run purges due deletions until ctx is done.
+func (p *AccountPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		for {
+			purged, err := p.PurgeDue(ctx)
+			if err != nil {
+				p.logger.ErrorContext(ctx, "purging deleted accounts failed", "error", err)
+			}
+			if err != nil || purged < p.batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDue claims one batch of due deletions and purges them, returning how
+// many it claimed.
+func (p *AccountPurger) PurgeDue(ctx context.Context) (int, error) {
+	now := p.now()
+	claimed, err := p.deletionRepo.ClaimDue(ctx, p.owner, now, now.Add(p.lease), p.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, deletion := range claimed {
+		if err := p.purge(ctx, deletion); err != nil {
+			// Left claimed, the purge resumes when the lease expires.
+			p.logger.ErrorContext(ctx, "purging deleted account failed", "user_id", deletion.UserID, "error", err)
+		}
+	}
+	return len(claimed), nil
+}
+
+func (p *AccountPurger) purge(ctx context.Context, deletion *domain.AccountDeletion) error {
+	for {
+		step, ok := deletion.NextStep()
+		if !ok {
+			break
+		}
+		if err := p.run(ctx, step, deletion.UserID); err != nil {
+			deletion.StepFailed(fmt.Errorf("%s: %w", step, err), p.now())
+			if saveErr := p.deletionRepo.Save(ctx, deletion); saveErr != nil {
+				p.logger.ErrorContext(ctx, "saving account deletion failed", "user_id", deletion.UserID, "error", saveErr)
+			}
+			return err
+		}
+		now := p.now()
+		deletion.StepDone(step, now)
+		deletion.LeaseUntil = now.Add(p.lease)
+		if err := p.deletionRepo.Save(ctx, deletion); err != nil {
+			return err
+		}
+	}
+	deletion.Complete(p.now())
+	return p.deletionRepo.Save(ctx, deletion)
+}
+
+func (p *AccountPurger) run(ctx context.Context, step domain.PurgeStep, userID string) error {
+	switch step {
+	case domain.PurgeEvent:
+		return p.events.Publish(ctx, domain.UserDeleted{UserID: userID, At: p.now()})
+	case domain.PurgeTweets:
+		return p.repos.Tweets.DeleteByUserID(ctx, userID)
+	case domain.PurgeScheduledTweets:
+		return p.repos.ScheduledTweets.DeleteByUserID(ctx, userID)
+	case domain.PurgeMedia:
+		return p.purgeMedia(ctx, userID)
+	case domain.PurgeBookmarks:
+		return p.repos.Bookmarks.DeleteByUserID(ctx, userID)
+	case domain.PurgePollVotes:
+		return p.repos.PollVotes.DeleteByUserID(ctx, userID)
+	case domain.PurgeFollows:
+		if err := p.repos.Users.RemoveRelationships(ctx, userID); err != nil {
+			return err
+		}
+		return p.repos.FollowRequests.DeleteByUserID(ctx, userID)
+	case domain.PurgeNotifications:
+		return p.repos.Notifications.DeleteByUserID(ctx, userID)
+	case domain.PurgeLists:
+		return p.purgeLists(ctx, userID)
+	case domain.PurgeExports:
+		return p.purgeExports(ctx, userID)
+	case domain.PurgeUser:
+		return p.repos.Users.Delete(ctx, userID)
+	}
+	return fmt.Errorf("unknown purge step %q", step)
+}
+
+// purgeMedia deletes the blobs of each media before the media itself, so
+// that no blob is left behind without a media pointing to it.
+func (p *AccountPurger) purgeMedia(ctx context.Context, userID string) error {
+	for {
+		media, err := p.repos.Media.FindByUserID(ctx, userID, purgePageSize)
+		if err != nil {
+			return err
+		}
+		for _, m := range media {
+			if err := p.blobs.Delete(ctx, m.BlobKey()); err != nil {
+				return err
+			}
+			if err := p.blobs.Delete(ctx, m.ThumbnailKey()); err != nil {
+				return err
+			}
+			if err := p.repos.Media.Delete(ctx, m.ID); err != nil {
+				return err
+			}
+		}
+		if len(media) < purgePageSize {
+			return nil
+		}
+	}
+}
+
+// purgeLists deletes the lists userID owns and takes them out of the others.
+func (p *AccountPurger) purgeLists(ctx context.Context, userID string) error {
+	lists, err := p.repos.Lists.FindByOwnerID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, list := range lists {
+		err := p.repos.Lists.Delete(ctx, list.ID)
+		if err != nil && !errors.Is(err, domain.ErrListNotFound) {
+			return err
+		}
+	}
+	return p.repos.Lists.RemoveMember(ctx, userID)
+}
+
+func (p *AccountPurger) purgeExports(ctx context.Context, userID string) error {
+	exports, err := p.repos.Exports.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if err := p.blobs.Delete(ctx, export.ArchiveKey()); err != nil {
+			return err
+		}
+		if err := p.repos.Exports.Delete(ctx, export.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountPurger_PurgeDue(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	type fixture struct {
+		purger       *AccountPurger
+		deletionRepo *mocks.MockAccountDeletionRepository
+		userRepo     *mocks.MockUserRepository
+		tweetRepo    *mocks.MockTweetRepository
+		scheduled    *mocks.MockScheduledTweetRepository
+		mediaRepo    *mocks.MockMediaRepository
+		bookmarkRepo *mocks.MockBookmarkRepository
+		pollVoteRepo *mocks.MockPollVoteRepository
+		requestRepo  *mocks.MockFollowRequestRepository
+		notifRepo    *mocks.MockNotificationRepository
+		listRepo     *mocks.MockListRepository
+		exportRepo   *mocks.MockAccountExportRepository
+		blobs        *mocks.MockBlobStore
+		events       *mocks.MockEventPublisher
+	}
+	setup := func(t *testing.T) *fixture {
+		ctrl := gomock.NewController(t)
+		f := &fixture{
+			deletionRepo: mocks.NewMockAccountDeletionRepository(ctrl),
+			userRepo:     mocks.NewMockUserRepository(ctrl),
+			tweetRepo:    mocks.NewMockTweetRepository(ctrl),
+			scheduled:    mocks.NewMockScheduledTweetRepository(ctrl),
+			mediaRepo:    mocks.NewMockMediaRepository(ctrl),
+			bookmarkRepo: mocks.NewMockBookmarkRepository(ctrl),
+			pollVoteRepo: mocks.NewMockPollVoteRepository(ctrl),
+			requestRepo:  mocks.NewMockFollowRequestRepository(ctrl),
+			notifRepo:    mocks.NewMockNotificationRepository(ctrl),
+			listRepo:     mocks.NewMockListRepository(ctrl),
+			exportRepo:   mocks.NewMockAccountExportRepository(ctrl),
+			blobs:        mocks.NewMockBlobStore(ctrl),
+			events:       mocks.NewMockEventPublisher(ctrl),
+		}
+		f.purger = NewAccountPurger(f.deletionRepo, PurgeRepositories{
+			Users:           f.userRepo,
+			Tweets:          f.tweetRepo,
+			ScheduledTweets: f.scheduled,
+			Media:           f.mediaRepo,
+			Bookmarks:       f.bookmarkRepo,
+			PollVotes:       f.pollVoteRepo,
+			FollowRequests:  f.requestRepo,
+			Notifications:   f.notifRepo,
+			Lists:           f.listRepo,
+			Exports:         f.exportRepo,
+		}, f.blobs, f.events, time.Second, time.Minute, 10, slog.New(slog.DiscardHandler))
+		f.purger.now = func() time.Time { return now }
+		return f
+	}
+	claimed := func(owner string, done ...domain.PurgeStep) *domain.AccountDeletion {
+		deletion := domain.NewAccountDeletion("user1", now.Add(-time.Hour), time.Hour)
+		deletion.Claim(owner, now.Add(time.Minute), now)
+		deletion.Done = append(deletion.Done, done...)
+		return deletion
+	}
+
+	t.Run("purges everything", func(t *testing.T) {
+		f := setup(t)
+		deletion := claimed(f.purger.owner)
+		f.deletionRepo.EXPECT().ClaimDue(gomock.Any(), f.purger.owner, now, now.Add(time.Minute), 10).Return([]*domain.AccountDeletion{deletion}, nil).Times(1)
+
+		var saved [][]domain.PurgeStep
+		f.deletionRepo.EXPECT().Save(gomock.Any(), deletion).Do(func(_ context.Context, d *domain.AccountDeletion) {
+			saved = append(saved, append([]domain.PurgeStep{}, d.Done...))
+		}).Return(nil).Times(len(domain.PurgeSteps) + 1)
+
+		media := &domain.Media{ID: "media1", UserID: "user1"}
+		export := domain.NewAccountExport("export1", "user1", now)
+		gomock.InOrder(
+			f.events.EXPECT().Publish(gomock.Any(), domain.UserDeleted{UserID: "user1", At: now}).Return(nil).Times(1),
+			f.tweetRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.scheduled.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.mediaRepo.EXPECT().FindByUserID(gomock.Any(), "user1", purgePageSize).Return([]*domain.Media{media}, nil).Times(1),
+			f.blobs.EXPECT().Delete(gomock.Any(), "media1").Return(nil).Times(1),
+			f.blobs.EXPECT().Delete(gomock.Any(), "media1/thumbnail").Return(nil).Times(1),
+			f.mediaRepo.EXPECT().Delete(gomock.Any(), "media1").Return(nil).Times(1),
+			f.bookmarkRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.pollVoteRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.userRepo.EXPECT().RemoveRelationships(gomock.Any(), "user1").Return(nil).Times(1),
+			f.requestRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.notifRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(nil).Times(1),
+			f.listRepo.EXPECT().FindByOwnerID(gomock.Any(), "user1").Return([]*domain.List{{ID: "list1", OwnerID: "user1"}}, nil).Times(1),
+			f.listRepo.EXPECT().Delete(gomock.Any(), "list1").Return(domain.ErrListNotFound).Times(1),
+			f.listRepo.EXPECT().RemoveMember(gomock.Any(), "user1").Return(nil).Times(1),
+			f.exportRepo.EXPECT().FindByUserID(gomock.Any(), "user1").Return([]*domain.AccountExport{export}, nil).Times(1),
+			f.blobs.EXPECT().Delete(gomock.Any(), "exports/export1.zip").Return(nil).Times(1),
+			f.exportRepo.EXPECT().Delete(gomock.Any(), "export1").Return(nil).Times(1),
+			f.userRepo.EXPECT().Delete(gomock.Any(), "user1").Return(nil).Times(1),
+		)
+
+		count, err := f.purger.PurgeDue(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, domain.AccountDeletionCompleted, deletion.Status)
+		assert.Equal(t, domain.PurgeSteps, deletion.Done)
+		assert.Len(t, saved[0], 1, "progress is saved after every step")
+	})
+
+	t.Run("resumes after the last step done", func(t *testing.T) {
+		f := setup(t)
+		deletion := claimed(f.purger.owner, domain.PurgeSteps[:len(domain.PurgeSteps)-1]...)
+		f.deletionRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.AccountDeletion{deletion}, nil).Times(1)
+		f.userRepo.EXPECT().Delete(gomock.Any(), "user1").Return(nil).Times(1)
+		f.deletionRepo.EXPECT().Save(gomock.Any(), deletion).Return(nil).Times(2)
+
+		_, err := f.purger.PurgeDue(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AccountDeletionCompleted, deletion.Status)
+	})
+
+	t.Run("a failed step is recorded and left for the next claim", func(t *testing.T) {
+		f := setup(t)
+		deletion := claimed(f.purger.owner, domain.PurgeEvent)
+		f.deletionRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.AccountDeletion{deletion}, nil).Times(1)
+		f.tweetRepo.EXPECT().DeleteByUserID(gomock.Any(), "user1").Return(errors.New("db down")).Times(1)
+		f.deletionRepo.EXPECT().Save(gomock.Any(), deletion).Return(nil).Times(1)
+
+		count, err := f.purger.PurgeDue(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, domain.AccountDeletionPurging, deletion.Status)
+		assert.Equal(t, "tweets: db down", deletion.Error)
+		assert.Equal(t, []domain.PurgeStep{domain.PurgeEvent}, deletion.Done)
+	})
+
+	t.Run("claim fails", func(t *testing.T) {
+		f := setup(t)
+		f.deletionRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+
+		_, err := f.purger.PurgeDue(context.Background())
+		assert.EqualError(t, err, "db down")
+	})
+}
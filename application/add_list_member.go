@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_add_list_member.go -package=mocks github.com/pedro00627/urblog/application AddListMember
+type AddListMember interface {
+	Execute(ctx context.Context, userID, listID, memberID string) error
+}
+
+type AddListMemberUseCase struct {
+	listRepo db.ListRepository
+	userRepo db.UserRepository
+}
+
+func NewAddListMemberUseCase(listRepo db.ListRepository, userRepo db.UserRepository) AddListMember {
+	return &AddListMemberUseCase{
+		listRepo: listRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Execute adds memberID to the list on behalf of its owner. Users who block
+// the owner, or are blocked by them, cannot be added.
+func (uc *AddListMemberUseCase) Execute(ctx context.Context, userID, listID, memberID string) error {
+	list, err := findOwnedList(ctx, uc.listRepo, userID, listID)
+	if err != nil {
+		return err
+	}
+	owner, err := uc.userRepo.FindByID(ctx, list.OwnerID)
+	if err != nil {
+		return err
+	}
+	member, err := uc.userRepo.FindByID(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if domain.BlockedBetween(owner, member) {
+		return domain.ErrUserBlocked
+	}
+	if err := list.AddMember(member.ID); err != nil {
+		return err
+	}
+	return uc.listRepo.Save(ctx, list)
+}
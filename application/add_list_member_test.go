@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddListMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	addListMemberUseCase := NewAddListMemberUseCase(listRepo, userRepo)
+
+	tests := []struct {
+		name    string
+		userID  string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:   "success",
+			userID: "user1",
+			setup: func() {
+				listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				listRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, list *domain.List) error {
+					assert.True(t, list.Members["user2"])
+					return nil
+				}).Times(1)
+			},
+		},
+		{
+			name:   "already a member",
+			userID: "user1",
+			setup: func() {
+				listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false, "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+			},
+			wantErr: domain.ErrAlreadyListMember,
+		},
+		{
+			name:   "member has blocked the owner",
+			userID: "user1",
+			setup: func() {
+				member := domain.NewUser("user2", "user2")
+				member.Blocked["user1"] = true
+				listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(member, nil).Times(1)
+			},
+			wantErr: domain.ErrUserBlocked,
+		},
+		{
+			name:   "member not found",
+			userID: "user1",
+			setup: func() {
+				listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+		{
+			name:   "not the owner",
+			userID: "user3",
+			setup: func() {
+				listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+			},
+			wantErr: domain.ErrNotListOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			assert.Equal(t, tt.wantErr, addListMemberUseCase.Execute(context.Background(), tt.userID, "list1", "user2"))
+		})
+	}
+}
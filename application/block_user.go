@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_block_user.go -package=mocks github.com/pedro00627/urblog/application BlockUser
+type BlockUser interface {
+	Execute(ctx context.Context, userID, targetID string) error
+}
+
+type BlockUserUseCase struct {
+	userRepo db.UserRepository
+	events   infrastructure.EventPublisher
+}
+
+func NewBlockUserUseCase(userRepo db.UserRepository, events infrastructure.EventPublisher) BlockUser {
+	return &BlockUserUseCase{
+		userRepo: userRepo,
+		events:   events,
+	}
+}
+
+// Execute makes userID block targetID. Follows in either direction are
+// removed.
+func (uc *BlockUserUseCase) Execute(ctx context.Context, userID, targetID string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	target, err := uc.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if err := user.Block(target.ID); err != nil {
+		return err
+	}
+	target.Unfollow(user.ID)
+
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Save(ctx, target); err != nil {
+		return err
+	}
+	return uc.events.Publish(ctx, domain.UserBlocked{BlockerID: user.ID, BlockedID: target.ID, At: time.Now()})
+}
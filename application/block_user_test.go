@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
+
+	blockUserUseCase := NewBlockUserUseCase(userRepo, events)
+
+	tests := []struct {
+		name    string
+		userID  string
+		target  string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:   "success removes follows in both directions",
+			userID: "user1",
+			target: "user2",
+			setup: func() {
+				user := domain.NewUser("user1", "user1")
+				user.Following["user2"] = true
+				target := domain.NewUser("user2", "user2")
+				target.Following["user1"] = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(target, nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), user).DoAndReturn(func(_ context.Context, u *domain.User) error {
+					assert.True(t, u.Blocked["user2"])
+					assert.False(t, u.Following["user2"])
+					return nil
+				}).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), target).DoAndReturn(func(_ context.Context, u *domain.User) error {
+					assert.False(t, u.Following["user1"])
+					return nil
+				}).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.UserBlocked{})).DoAndReturn(func(_ context.Context, event domain.Event) error {
+					assert.Equal(t, "user1", event.(domain.UserBlocked).BlockerID)
+					assert.Equal(t, "user2", event.(domain.UserBlocked).BlockedID)
+					return nil
+				}).Times(1)
+			},
+		},
+		{
+			name:   "blocking yourself",
+			userID: "user1",
+			target: "user1",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(2)
+			},
+			wantErr: domain.ErrInvalidBlockAction,
+		},
+		{
+			name:   "target not found",
+			userID: "user1",
+			target: "user2",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+		{
+			name:   "error saving user",
+			userID: "user1",
+			target: "user2",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("error saving user")).Times(1)
+			},
+			wantErr: errors.New("error saving user"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := blockUserUseCase.Execute(context.Background(), tt.userID, tt.target)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
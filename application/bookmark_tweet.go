@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_bookmark_tweet.go -package=mocks github.com/pedro00627/urblog/application BookmarkTweet
+type BookmarkTweet interface {
+	Execute(ctx context.Context, userID, tweetID string) error
+}
+
+type BookmarkTweetUseCase struct {
+	bookmarkRepo db.BookmarkRepository
+	tweetRepo    db.TweetRepository
+	userRepo     db.UserRepository
+}
+
+func NewBookmarkTweetUseCase(bookmarkRepo db.BookmarkRepository, tweetRepo db.TweetRepository, userRepo db.UserRepository) BookmarkTweet {
+	return &BookmarkTweetUseCase{
+		bookmarkRepo: bookmarkRepo,
+		tweetRepo:    tweetRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Execute saves tweetID to userID's bookmarks. Only tweets the user can see
+// can be bookmarked.
+func (uc *BookmarkTweetUseCase) Execute(ctx context.Context, userID, tweetID string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	tweet, err := uc.tweetRepo.FindByID(ctx, tweetID)
+	if err != nil {
+		return err
+	}
+	author, err := uc.userRepo.FindByID(ctx, tweet.UserID)
+	if err != nil {
+		return err
+	}
+	if domain.BlockedBetween(user, author) {
+		return domain.ErrUserBlocked
+	}
+	if !domain.CanSeeTweets(user, author) {
+		return domain.ErrProtectedAccount
+	}
+
+	_, err = uc.bookmarkRepo.Find(ctx, userID, tweet.ID)
+	if err == nil {
+		return domain.ErrAlreadyBookmarked
+	}
+	if !errors.Is(err, domain.ErrBookmarkNotFound) {
+		return err
+	}
+	return uc.bookmarkRepo.Save(ctx, domain.NewBookmark(userID, tweet.ID))
+}
@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkTweet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocks.NewMockBookmarkRepository(ctrl)
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	bookmarkTweetUseCase := NewBookmarkTweetUseCase(bookmarkRepo, tweetRepo, userRepo)
+
+	tweet := &domain.Tweet{ID: "tweet1", UserID: "user2"}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				bookmarkRepo.EXPECT().Find(gomock.Any(), "user1", "tweet1").Return(nil, domain.ErrBookmarkNotFound).Times(1)
+				bookmarkRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, bookmark *domain.Bookmark) error {
+					assert.Equal(t, "user1", bookmark.UserID)
+					assert.Equal(t, "tweet1", bookmark.TweetID)
+					return nil
+				}).Times(1)
+			},
+		},
+		{
+			name: "already bookmarked",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				bookmarkRepo.EXPECT().Find(gomock.Any(), "user1", "tweet1").Return(domain.NewBookmark("user1", "tweet1"), nil).Times(1)
+			},
+			wantErr: domain.ErrAlreadyBookmarked,
+		},
+		{
+			name: "protected tweet",
+			setup: func() {
+				author := domain.NewUser("user2", "user2")
+				author.Protected = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(1)
+			},
+			wantErr: domain.ErrProtectedAccount,
+		},
+		{
+			name: "tweet not found",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(nil, domain.ErrTweetNotFound).Times(1)
+			},
+			wantErr: domain.ErrTweetNotFound,
+		},
+		{
+			name: "user not found",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			assert.Equal(t, tt.wantErr, bookmarkTweetUseCase.Execute(context.Background(), "user1", "tweet1"))
+		})
+	}
+}
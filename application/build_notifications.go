@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_build_notifications.go -package=mocks github.com/pedro00627/urblog/application BuildNotifications
+type BuildNotifications interface {
+	Execute(ctx context.Context, event domain.Event) error
+}
+
+type BuildNotificationsUseCase struct {
+	notificationRepo db.NotificationRepository
+}
+
+func NewBuildNotificationsUseCase(notificationRepo db.NotificationRepository) BuildNotifications {
+	return &BuildNotificationsUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (uc *BuildNotificationsUseCase) Execute(ctx context.Context, event domain.Event) error {
+	for _, target := range domain.NotificationTargetsFor(event) {
+		if err := uc.notify(ctx, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notify adds the actor to the user's unread notification of the same kind,
+// or creates a new one.
+func (uc *BuildNotificationsUseCase) notify(ctx context.Context, target domain.NotificationTarget) error {
+	notification, err := uc.notificationRepo.FindUnread(ctx, target.UserID, target.Type, target.TweetID)
+	switch {
+	case errors.Is(err, domain.ErrNotificationNotFound):
+		notification = domain.NewNotification(generateID(), target.UserID, target.Type, target.TweetID, target.ActorID)
+	case err != nil:
+		return err
+	default:
+		notification.AddActor(target.ActorID)
+	}
+	return uc.notificationRepo.Save(ctx, notification)
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildNotificationsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mocks.NewMockNotificationRepository(ctrl)
+
+	useCase := NewBuildNotificationsUseCase(notificationRepo)
+
+	tweet := &domain.Tweet{
+		ID:      "tweet1",
+		UserID:  "user1",
+		Content: "Hola @user2 @user1",
+		Entities: []domain.Entity{
+			{Type: domain.EntityMention, Text: "@user2", Start: 5, End: 11, UserID: "user2"},
+			{Type: domain.EntityMention, Text: "@user1", Start: 12, End: 18, UserID: "user1"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		event   domain.Event
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:  "follow creates notification",
+			event: domain.UserFollowed{FollowerID: "user1", FolloweeID: "user2"},
+			setup: func() {
+				notificationRepo.EXPECT().FindUnread(gomock.Any(), "user2", domain.NotificationFollowed, "").Return(nil, domain.ErrNotificationNotFound).Times(1)
+				notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *domain.Notification) error {
+					assert.NotEmpty(t, n.ID)
+					assert.Equal(t, "user2", n.UserID)
+					assert.Equal(t, []string{"user1"}, n.ActorIDs)
+					return nil
+				}).Times(1)
+			},
+		},
+		{
+			name:  "follow is aggregated into unread notification",
+			event: domain.UserFollowed{FollowerID: "user3", FolloweeID: "user1"},
+			setup: func() {
+				existing := domain.NewNotification("n1", "user1", domain.NotificationFollowed, "", "user2")
+				notificationRepo.EXPECT().FindUnread(gomock.Any(), "user1", domain.NotificationFollowed, "").Return(existing, nil).Times(1)
+				notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *domain.Notification) error {
+					assert.Equal(t, "n1", n.ID)
+					assert.Equal(t, []string{"user3", "user2"}, n.ActorIDs)
+					assert.Equal(t, 2, n.ActorCount)
+					return nil
+				}).Times(1)
+			},
+		},
+		{
+			name:  "mentions notify resolved users except the author",
+			event: domain.TweetCreated{Tweet: tweet},
+			setup: func() {
+				notificationRepo.EXPECT().FindUnread(gomock.Any(), "user2", domain.NotificationMentioned, "tweet1").Return(nil, domain.ErrNotificationNotFound).Times(1)
+				notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:  "own actions are ignored",
+			event: domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet2", UserID: "user1", Entities: []domain.Entity{{Type: domain.EntityMention, Text: "@user1", UserID: "user1"}}}},
+			setup: func() {},
+		},
+		{
+			name:  "error finding notification",
+			event: domain.UserFollowed{FollowerID: "user1", FolloweeID: "user2"},
+			setup: func() {
+				notificationRepo.EXPECT().FindUnread(gomock.Any(), "user2", domain.NotificationFollowed, "").Return(nil, errors.New("database error")).Times(1)
+			},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name:  "error saving notification",
+			event: domain.UserFollowed{FollowerID: "user1", FolloweeID: "user2"},
+			setup: func() {
+				notificationRepo.EXPECT().FindUnread(gomock.Any(), "user2", domain.NotificationFollowed, "").Return(nil, domain.ErrNotificationNotFound).Times(1)
+				notificationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database error")).Times(1)
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := useCase.Execute(context.Background(), tt.event)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
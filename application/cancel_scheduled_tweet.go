@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_cancel_scheduled_tweet.go -package=mocks github.com/pedro00627/urblog/application CancelScheduledTweet
+type CancelScheduledTweet interface {
+	Execute(ctx context.Context, userID, id string) error
+}
+
+type CancelScheduledTweetUseCase struct {
+	scheduledTweetRepo db.ScheduledTweetRepository
+	now                func() time.Time
+}
+
+func NewCancelScheduledTweetUseCase(scheduledTweetRepo db.ScheduledTweetRepository) CancelScheduledTweet {
+	return &CancelScheduledTweetUseCase{
+		scheduledTweetRepo: scheduledTweetRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute cancels a pending scheduled tweet. Once the scheduler has claimed
+// the tweet it can no longer be cancelled.
+func (uc *CancelScheduledTweetUseCase) Execute(ctx context.Context, userID, id string) error {
+	tweet, err := findOwnScheduledTweet(ctx, uc.scheduledTweetRepo, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := tweet.Cancel(uc.now()); err != nil {
+		return err
+	}
+	return uc.scheduledTweetRepo.Update(ctx, tweet)
+}
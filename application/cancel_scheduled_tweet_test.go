@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelScheduledTweet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledTweetRepo := mocks.NewMockScheduledTweetRepository(ctrl)
+
+	cancelScheduledTweetUseCase := NewCancelScheduledTweetUseCase(scheduledTweetRepo)
+
+	now := time.Now()
+	pending := func() *domain.ScheduledTweet {
+		tweet, _ := domain.NewScheduledTweet("scheduled1", "user1", "hello", now.Add(time.Hour), now)
+		return tweet
+	}
+
+	t.Run("success", func(t *testing.T) {
+		scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(pending(), nil).Times(1)
+		scheduledTweetRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tweet *domain.ScheduledTweet) error {
+			assert.Equal(t, domain.ScheduledTweetCancelled, tweet.Status)
+			return nil
+		}).Times(1)
+
+		assert.NoError(t, cancelScheduledTweetUseCase.Execute(context.Background(), "user1", "scheduled1"))
+	})
+
+	t.Run("being published", func(t *testing.T) {
+		tweet := pending()
+		tweet.Claim("scheduler1", now.Add(time.Minute), now)
+		scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(tweet, nil).Times(1)
+
+		assert.Equal(t, domain.ErrScheduledTweetNotPending, cancelScheduledTweetUseCase.Execute(context.Background(), "user1", "scheduled1"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(nil, domain.ErrScheduledTweetNotFound).Times(1)
+
+		assert.Equal(t, domain.ErrScheduledTweetNotFound, cancelScheduledTweetUseCase.Execute(context.Background(), "user1", "scheduled1"))
+	})
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_create_list.go -package=mocks github.com/pedro00627/urblog/application CreateList
+type CreateList interface {
+	Execute(ctx context.Context, ownerID, name string, private bool) (*domain.List, error)
+}
+
+type CreateListUseCase struct {
+	listRepo db.ListRepository
+	userRepo db.UserRepository
+}
+
+func NewCreateListUseCase(listRepo db.ListRepository, userRepo db.UserRepository) CreateList {
+	return &CreateListUseCase{
+		listRepo: listRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (uc *CreateListUseCase) Execute(ctx context.Context, ownerID, name string, private bool) (*domain.List, error) {
+	if _, err := uc.userRepo.FindByID(ctx, ownerID); err != nil {
+		return nil, err
+	}
+	list, err := domain.NewList(generateID(), ownerID, name, private)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.listRepo.Save(ctx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	createListUseCase := NewCreateListUseCase(listRepo, userRepo)
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		listRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		list, err := createListUseCase.Execute(context.Background(), "user1", "friends", true)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, list.ID)
+		assert.Equal(t, "user1", list.OwnerID)
+		assert.Equal(t, "friends", list.Name)
+		assert.True(t, list.Private)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		_, err := createListUseCase.Execute(context.Background(), "user1", " ", false)
+		assert.True(t, errors.Is(err, domain.ErrInvalidListName))
+	})
+
+	t.Run("owner not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := createListUseCase.Execute(context.Background(), "ghost", "friends", false)
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
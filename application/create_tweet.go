@@ -1,6 +1,9 @@
 package application
 
 import (
+	"context"
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/pedro00627/urblog/domain"
 	"github.com/pedro00627/urblog/infrastructure"
@@ -9,24 +12,34 @@ import (
 
 //go:generate mockgen -destination=./mocks/mock_create_tweet.go -package=mocks github.com/pedro00627/urblog/application CreateTweet
 type CreateTweet interface {
-	Execute(string, string) (*domain.Tweet, error)
+	Execute(context.Context, string, string, domain.TweetAttachments) (*domain.Tweet, error)
 }
 type CreateTweetUseCase struct {
 	tweetRepo db.TweetRepository
 	userRepo  db.UserRepository
+	mediaRepo db.MediaRepository
 	queue     infrastructure.Queue
+	events    infrastructure.EventPublisher
 }
 
-func NewCreateTweetUseCase(tweetRepo db.TweetRepository, userRepo db.UserRepository, queue infrastructure.Queue) *CreateTweetUseCase {
+func NewCreateTweetUseCase(tweetRepo db.TweetRepository, userRepo db.UserRepository, mediaRepo db.MediaRepository, queue infrastructure.Queue, events infrastructure.EventPublisher) *CreateTweetUseCase {
 	return &CreateTweetUseCase{
 		userRepo:  userRepo,
 		tweetRepo: tweetRepo,
+		mediaRepo: mediaRepo,
 		queue:     queue,
+		events:    events,
 	}
 }
 
-func (uc *CreateTweetUseCase) Execute(userID, content string) (*domain.Tweet, error) {
-	user, err := uc.userRepo.FindByID(userID)
+func (uc *CreateTweetUseCase) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments) (*domain.Tweet, error) {
+	return uc.create(ctx, generateID(), userID, content, attachments)
+}
+
+// create publishes a tweet with the given ID. Scheduled tweets are published
+// through here too, under the ID of the scheduled tweet.
+func (uc *CreateTweetUseCase) create(ctx context.Context, id, userID, content string, attachments domain.TweetAttachments) (*domain.Tweet, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -35,22 +48,81 @@ func (uc *CreateTweetUseCase) Execute(userID, content string) (*domain.Tweet, er
 		return nil, domain.ErrUserNotFound
 	}
 
-	tweet, err := domain.NewTweet(generateID(), userID, content)
+	media, err := findAttachedMedia(ctx, uc.mediaRepo, userID, attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet, err := domain.NewTweetWithAttachments(id, userID, content, media, attachments.Poll)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet.Entities, err = uc.resolveMentions(ctx, user, tweet.Entities)
 	if err != nil {
 		return nil, err
 	}
-	err = uc.tweetRepo.Save(tweet)
+	err = uc.tweetRepo.Save(ctx, tweet)
 	if err != nil {
 		return nil, err
 	}
 
-	err = uc.queue.WriteMessage([]byte("New tweet published: " + tweet.ID))
+	err = uc.queue.WriteMessage(ctx, []byte("New tweet published: "+tweet.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.events.Publish(ctx, domain.TweetCreated{Tweet: tweet})
 	if err != nil {
 		return nil, err
 	}
 	return tweet, nil
 }
 
+// resolveMentions fills in the user ID of each mention and drops mentions of
+// unknown users, leaving them as plain text. Mentioning a user across a block
+// is refused.
+func (uc *CreateTweetUseCase) resolveMentions(ctx context.Context, author *domain.User, entities []domain.Entity) ([]domain.Entity, error) {
+	resolved := entities[:0]
+	for _, entity := range entities {
+		if entity.Type == domain.EntityMention {
+			user, err := uc.userRepo.FindByName(ctx, entity.Name())
+			if errors.Is(err, domain.ErrUserNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if domain.BlockedBetween(author, user) {
+				return nil, domain.ErrUserBlocked
+			}
+			entity.UserID = user.ID
+		}
+		resolved = append(resolved, entity)
+	}
+	return resolved, nil
+}
+
+// findAttachedMedia looks up the media userID attaches to a tweet. Media
+// uploaded by somebody else are reported as not found.
+func findAttachedMedia(ctx context.Context, mediaRepo db.MediaRepository, userID string, attachments domain.TweetAttachments) ([]*domain.Media, error) {
+	if err := attachments.Validate(); err != nil {
+		return nil, err
+	}
+	var media []*domain.Media
+	for _, id := range attachments.MediaIDs {
+		m, err := mediaRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if m.UserID != userID {
+			return nil, domain.ErrMediaNotFound
+		}
+		media = append(media, m)
+	}
+	return media, nil
+}
+
 func generateID() string {
 	return uuid.New().String()
 }
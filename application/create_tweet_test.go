@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
@@ -9,6 +10,7 @@ import (
 	"github.com/pedro00627/urblog/infrastructure/db"
 	"github.com/pedro00627/urblog/infrastructure/mocks"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 	"time"
 )
@@ -16,25 +18,32 @@ import (
 func TestNewCreateTweetUseCase(t *testing.T) {
 	tweetRepo := &mocks.MockTweetRepository{}
 	userRepo := &mocks.MockUserRepository{}
+	mediaRepo := &mocks.MockMediaRepository{}
 	queue := &mocks.MockQueue{}
+	events := &mocks.MockEventPublisher{}
 
-	useCase := NewCreateTweetUseCase(tweetRepo, userRepo, queue)
+	useCase := NewCreateTweetUseCase(tweetRepo, userRepo, mediaRepo, queue, events)
 
 	assert.NotNil(t, useCase)
 	assert.Equal(t, tweetRepo, useCase.tweetRepo)
 	assert.Equal(t, userRepo, useCase.userRepo)
+	assert.Equal(t, mediaRepo, useCase.mediaRepo)
 	assert.Equal(t, queue, useCase.queue)
+	assert.Equal(t, events, useCase.events)
 }
 
 func TestCreateTweetUseCase_Execute(t *testing.T) {
 	type fields struct {
 		tweetRepo db.TweetRepository
 		userRepo  db.UserRepository
+		mediaRepo db.MediaRepository
 		queue     infrastructure.Queue
+		events    infrastructure.EventPublisher
 	}
 	type args struct {
-		userID  string
-		content string
+		userID      string
+		content     string
+		attachments domain.TweetAttachments
 	}
 
 	ctrl := gomock.NewController(t)
@@ -53,7 +62,9 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -66,9 +77,158 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
-				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any()).Times(1)
-				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any()).Return(nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.events.(*mocks.MockEventPublisher).EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.TweetCreated{})).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "mentions are resolved",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:  "user1",
+				content: "Hola @user2 y @ghost #golang",
+			},
+			want: &domain.Tweet{
+				UserID:  "user1",
+				Content: "Hola @user2 y @ghost #golang",
+				Entities: []domain.Entity{
+					{Type: domain.EntityMention, Text: "@user2", Start: 5, End: 11, UserID: "user2"},
+					{Type: domain.EntityHashtag, Text: "#golang", Start: 21, End: 28},
+				},
+				Timestamp: time.Now(),
+			},
+			wantErr: assert.NoError,
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByName(gomock.Any(), gomock.Eq("user2")).Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByName(gomock.Any(), gomock.Eq("ghost")).Return(nil, domain.ErrUserNotFound).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.events.(*mocks.MockEventPublisher).EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.TweetCreated{})).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "error resolving mentions",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:  "user1",
+				content: "Hola @user2",
+			},
+			want:    nil,
+			wantErr: assert.Error,
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByName(gomock.Any(), gomock.Eq("user2")).Return(nil, errors.New("database error")).Times(1)
+			},
+		},
+		{
+			name: "mentioning a user across a block",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:  "user1",
+				content: "Hola @user2",
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, domain.ErrUserBlocked, err)
+			},
+			mocks: func(f fields) {
+				mentioned := domain.NewUser("user2", "user2")
+				mentioned.Blocked["user1"] = true
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByName(gomock.Any(), gomock.Eq("user2")).Return(mentioned, nil).Times(1)
+			},
+		},
+		{
+			name: "media are attached",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:      "user1",
+				attachments: domain.TweetAttachments{MediaIDs: []string{"media1"}},
+			},
+			want: &domain.Tweet{
+				UserID:    "user1",
+				Media:     []domain.TweetMedia{{ID: "media1", ContentType: "image/png", Width: 640, Height: 480}},
+				Timestamp: time.Now(),
+			},
+			wantErr: assert.NoError,
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.mediaRepo.(*mocks.MockMediaRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("media1")).Return(&domain.Media{ID: "media1", UserID: "user1", ContentType: "image/png", Width: 640, Height: 480}, nil).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.events.(*mocks.MockEventPublisher).EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.TweetCreated{})).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "media of another user",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:      "user1",
+				content:     "Look",
+				attachments: domain.TweetAttachments{MediaIDs: []string{"media1"}},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, domain.ErrMediaNotFound, err)
+			},
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.mediaRepo.(*mocks.MockMediaRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("media1")).Return(&domain.Media{ID: "media1", UserID: "user2"}, nil).Times(1)
+			},
+		},
+		{
+			name: "too many media",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:      "user1",
+				content:     "Look",
+				attachments: domain.TweetAttachments{MediaIDs: []string{"1", "2", "3", "4", "5"}},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, domain.ErrTooManyMedia, err)
+			},
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
 			},
 		},
 		{
@@ -76,7 +236,9 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -85,7 +247,7 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: assert.Error,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(nil, domain.ErrUserNotFound).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(nil, domain.ErrUserNotFound).Times(1)
 			},
 		},
 		{
@@ -93,7 +255,9 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -102,7 +266,7 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: assert.Error,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(nil, nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(nil, nil).Times(1)
 			},
 		},
 		{
@@ -110,7 +274,9 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -119,8 +285,8 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: assert.Error,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
-				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any()).Return(errors.New("error saving tweet")).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("error saving tweet")).Times(1)
 			},
 		},
 		{
@@ -128,7 +294,30 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:  "user1",
+				content: "Hello, world!",
+			},
+			want:    nil,
+			wantErr: assert.Error,
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(errors.New("error writing to queue")).Times(1)
+			},
+		},
+		{
+			name: "error publishing event",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -137,9 +326,10 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: assert.Error,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
-				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any()).Times(1)
-				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any()).Return(errors.New("error writing to queue")).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.tweetRepo.(*mocks.MockTweetRepository).EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				f.queue.(*mocks.MockQueue).EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.events.(*mocks.MockEventPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("error publishing event")).Times(1)
 			},
 		},
 		{
@@ -147,7 +337,9 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			fields: fields{
 				tweetRepo: mocks.NewMockTweetRepository(ctrl),
 				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
 				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
 			},
 			args: args{
 				userID:  "user1",
@@ -156,7 +348,26 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: assert.Error,
 			mocks: func(f fields) {
-				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
+			},
+		},
+		{
+			name: "tweet content too long",
+			fields: fields{
+				tweetRepo: mocks.NewMockTweetRepository(ctrl),
+				userRepo:  mocks.NewMockUserRepository(ctrl),
+				mediaRepo: mocks.NewMockMediaRepository(ctrl),
+				queue:     mocks.NewMockQueue(ctrl),
+				events:    mocks.NewMockEventPublisher(ctrl),
+			},
+			args: args{
+				userID:  "user1",
+				content: strings.Repeat("ñ", 281),
+			},
+			want:    nil,
+			wantErr: assert.Error,
+			mocks: func(f fields) {
+				f.userRepo.(*mocks.MockUserRepository).EXPECT().FindByID(gomock.Any(), gomock.Eq("user1")).Return(domain.NewUser("user1", "User 1"), nil).Times(1)
 			},
 		},
 	}
@@ -165,10 +376,12 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 			uc := &CreateTweetUseCase{
 				tweetRepo: tt.fields.tweetRepo,
 				userRepo:  tt.fields.userRepo,
+				mediaRepo: tt.fields.mediaRepo,
 				queue:     tt.fields.queue,
+				events:    tt.fields.events,
 			}
 			tt.mocks(tt.fields)
-			got, err := uc.Execute(tt.args.userID, tt.args.content)
+			got, err := uc.Execute(context.Background(), tt.args.userID, tt.args.content, tt.args.attachments)
 			if !tt.wantErr(t, err, fmt.Sprintf("Execute(%v, %v)", tt.args.userID, tt.args.content)) {
 				return
 			}
@@ -176,6 +389,8 @@ func TestCreateTweetUseCase_Execute(t *testing.T) {
 				assert.NotEmpty(t, got.ID, "Tweet ID should not be empty")
 				assert.Equalf(t, tt.want.UserID, got.UserID, "Execute(%v, %v)", tt.args.userID, tt.args.content)
 				assert.Equalf(t, tt.want.Content, got.Content, "Execute(%v, %v)", tt.args.userID, tt.args.content)
+				assert.Equalf(t, tt.want.Entities, got.Entities, "Execute(%v, %v)", tt.args.userID, tt.args.content)
+				assert.Equalf(t, tt.want.Media, got.Media, "Execute(%v, %v)", tt.args.userID, tt.args.content)
 				assert.WithinDuration(t, tt.want.Timestamp, got.Timestamp, time.Second, "Execute(%v, %v)", tt.args.userID, tt.args.content)
 			}
 		})
@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_delete_list.go -package=mocks github.com/pedro00627/urblog/application DeleteList
+type DeleteList interface {
+	Execute(ctx context.Context, userID, listID string) error
+}
+
+type DeleteListUseCase struct {
+	listRepo db.ListRepository
+}
+
+func NewDeleteListUseCase(listRepo db.ListRepository) DeleteList {
+	return &DeleteListUseCase{
+		listRepo: listRepo,
+	}
+}
+
+func (uc *DeleteListUseCase) Execute(ctx context.Context, userID, listID string) error {
+	if _, err := findOwnedList(ctx, uc.listRepo, userID, listID); err != nil {
+		return err
+	}
+	return uc.listRepo.Delete(ctx, listID)
+}
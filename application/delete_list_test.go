@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+
+	deleteListUseCase := NewDeleteListUseCase(listRepo)
+
+	t.Run("success", func(t *testing.T) {
+		listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+		listRepo.EXPECT().Delete(gomock.Any(), "list1").Return(nil).Times(1)
+
+		assert.NoError(t, deleteListUseCase.Execute(context.Background(), "user1", "list1"))
+	})
+
+	t.Run("someone else's private list", func(t *testing.T) {
+		listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(true), nil).Times(1)
+
+		assert.Equal(t, domain.ErrListNotFound, deleteListUseCase.Execute(context.Background(), "user2", "list1"))
+	})
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_delete_user.go -package=mocks github.com/pedro00627/urblog/application DeleteUser
+type DeleteUser interface {
+	Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error)
+}
+
+type DeleteUserUseCase struct {
+	userRepo     db.UserRepository
+	deletionRepo db.AccountDeletionRepository
+	gracePeriod  time.Duration
+	now          func() time.Time
+}
+
+// NewDeleteUserUseCase deletes users, whose data is purged by the
+// AccountPurger once gracePeriod is over.
+func NewDeleteUserUseCase(userRepo db.UserRepository, deletionRepo db.AccountDeletionRepository, gracePeriod time.Duration) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepo:     userRepo,
+		deletionRepo: deletionRepo,
+		gracePeriod:  gracePeriod,
+		now:          time.Now,
+	}
+}
+
+// Execute deactivates userID right away and schedules the purge of their
+// data. The deletion is saved first, so that no user is left deactivated
+// without a purge to come.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	deletion := domain.NewAccountDeletion(user.ID, uc.now(), uc.gracePeriod)
+	if err := uc.deletionRepo.Save(ctx, deletion); err != nil {
+		return nil, err
+	}
+	user.Deactivate()
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteUserUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	deletionRepo := mocks.NewMockAccountDeletionRepository(ctrl)
+	deleteUserUseCase := NewDeleteUserUseCase(userRepo, deletionRepo, 24*time.Hour)
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	deleteUserUseCase.now = func() time.Time { return now }
+
+	t.Run("deactivates and schedules the purge", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "ana"), nil).Times(1)
+		gomock.InOrder(
+			deletionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+			userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Do(func(_ context.Context, user *domain.User) {
+				assert.True(t, user.Deactivated)
+			}).Return(nil).Times(1),
+		)
+
+		deletion, err := deleteUserUseCase.Execute(context.Background(), "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewAccountDeletion("user1", now, 24*time.Hour), deletion)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := deleteUserUseCase.Execute(context.Background(), "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+
+	t.Run("deletion not saved", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "ana"), nil).Times(1)
+		deletionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("db down")).Times(1)
+
+		_, err := deleteUserUseCase.Execute(context.Background(), "user1")
+		assert.EqualError(t, err, "db down")
+	})
+}
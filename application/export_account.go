@@ -0,0 +1,146 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// maxSyncExportTweets is how many tweets an account may have for its archive
+// to be built while the request waits. Larger accounts are exported in the
+// background.
+const maxSyncExportTweets = 1000
+
+// exportPageSize is how many tweets or bookmarks an export reads at a time.
+const exportPageSize = 500
+
+//go:generate mockgen -destination=./mocks/mock_export_account.go -package=mocks github.com/pedro00627/urblog/application ExportAccount
+type ExportAccount interface {
+	// Execute returns the archive of a small account right away. For a
+	// large one, it starts building the archive in the background and
+	// returns the pending export instead.
+	Execute(ctx context.Context, userID string) (*domain.AccountExport, []byte, error)
+}
+
+type ExportAccountUseCase struct {
+	exportRepo   db.AccountExportRepository
+	userRepo     db.UserRepository
+	tweetRepo    db.TweetRepository
+	bookmarkRepo db.BookmarkRepository
+	blobs        infrastructure.BlobStore
+	logger       *slog.Logger
+	now          func() time.Time
+	// start runs an export in the background.
+	start func(func())
+}
+
+func NewExportAccountUseCase(exportRepo db.AccountExportRepository, userRepo db.UserRepository, tweetRepo db.TweetRepository, bookmarkRepo db.BookmarkRepository, blobs infrastructure.BlobStore, logger *slog.Logger) *ExportAccountUseCase {
+	return &ExportAccountUseCase{
+		exportRepo:   exportRepo,
+		userRepo:     userRepo,
+		tweetRepo:    tweetRepo,
+		bookmarkRepo: bookmarkRepo,
+		blobs:        blobs,
+		logger:       logger,
+		now:          time.Now,
+		start:        func(run func()) { go run() },
+	}
+}
+
+func (uc *ExportAccountUseCase) Execute(ctx context.Context, userID string) (*domain.AccountExport, []byte, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tweets, err := uc.tweetRepo.FindByUserID(ctx, user.ID, maxSyncExportTweets+1, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tweets) <= maxSyncExportTweets {
+		data, err := uc.archive(ctx, user, tweets)
+		return nil, data, err
+	}
+
+	export := domain.NewAccountExport(generateID(), user.ID, uc.now())
+	if err := uc.exportRepo.Save(ctx, export); err != nil {
+		return nil, nil, err
+	}
+	// The export keeps changing as it runs, so it runs on a copy. It outlives
+	// the request, so it keeps the request's values but not its cancellation.
+	running := *export
+	uc.start(func() { uc.run(context.WithoutCancel(ctx), &running, user) })
+	return export, nil, nil
+}
+
+func (uc *ExportAccountUseCase) run(ctx context.Context, export *domain.AccountExport, user *domain.User) {
+	export.Start(uc.now())
+	if err := uc.exportRepo.Save(ctx, export); err != nil {
+		uc.fail(ctx, export, err)
+		return
+	}
+	data, err := uc.archive(ctx, user, nil)
+	if err != nil {
+		uc.fail(ctx, export, err)
+		return
+	}
+	if err := uc.blobs.Put(ctx, export.ArchiveKey(), "application/zip", data); err != nil {
+		uc.fail(ctx, export, err)
+		return
+	}
+	export.Complete(len(data), uc.now())
+	if err := uc.exportRepo.Save(ctx, export); err != nil {
+		uc.logger.ErrorContext(ctx, "saving account export failed", "export_id", export.ID, "error", err)
+	}
+}
+
+// archive builds the archive of user. tweets are the ones already read, if
+// they are all of them.
+func (uc *ExportAccountUseCase) archive(ctx context.Context, user *domain.User, tweets []*domain.Tweet) ([]byte, error) {
+	if tweets == nil {
+		for offset := 0; ; offset += exportPageSize {
+			page, err := uc.tweetRepo.FindByUserID(ctx, user.ID, exportPageSize, offset)
+			if err != nil {
+				return nil, err
+			}
+			tweets = append(tweets, page...)
+			if len(page) < exportPageSize {
+				break
+			}
+		}
+	}
+
+	var bookmarks []*domain.Bookmark
+	var after *domain.BookmarkCursor
+	for {
+		page, err := uc.bookmarkRepo.FindByUserID(ctx, user.ID, after, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, page...)
+		if len(page) < exportPageSize {
+			break
+		}
+		cursor := domain.NewBookmarkCursor(page[len(page)-1])
+		after = &cursor
+	}
+
+	archive := domain.AccountArchive{User: user, Tweets: tweets, Bookmarks: bookmarks, ExportedAt: uc.now()}
+	var buf bytes.Buffer
+	if err := archive.WriteZip(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (uc *ExportAccountUseCase) fail(ctx context.Context, export *domain.AccountExport, err error) {
+	uc.logger.ErrorContext(ctx, "exporting account failed", "user_id", export.UserID, "export_id", export.ID, "error", err)
+	export.Fail(err.Error(), uc.now())
+	if err := uc.exportRepo.Save(ctx, export); err != nil {
+		uc.logger.ErrorContext(ctx, "saving account export failed", "export_id", export.ID, "error", err)
+	}
+}
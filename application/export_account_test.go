@@ -0,0 +1,135 @@
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAccount(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	user := domain.NewUser("user1", "ana")
+
+	type repos struct {
+		exportRepo   *mocks.MockAccountExportRepository
+		userRepo     *mocks.MockUserRepository
+		tweetRepo    *mocks.MockTweetRepository
+		bookmarkRepo *mocks.MockBookmarkRepository
+		blobs        *mocks.MockBlobStore
+	}
+	setup := func(t *testing.T) (*ExportAccountUseCase, repos) {
+		ctrl := gomock.NewController(t)
+		r := repos{
+			exportRepo:   mocks.NewMockAccountExportRepository(ctrl),
+			userRepo:     mocks.NewMockUserRepository(ctrl),
+			tweetRepo:    mocks.NewMockTweetRepository(ctrl),
+			bookmarkRepo: mocks.NewMockBookmarkRepository(ctrl),
+			blobs:        mocks.NewMockBlobStore(ctrl),
+		}
+		exportAccountUseCase := NewExportAccountUseCase(r.exportRepo, r.userRepo, r.tweetRepo, r.bookmarkRepo, r.blobs, slog.New(slog.DiscardHandler))
+		exportAccountUseCase.now = func() time.Time { return now }
+		return exportAccountUseCase, r
+	}
+	tweets := func(n int) []*domain.Tweet {
+		tweets := make([]*domain.Tweet, n)
+		for i := range tweets {
+			tweets[i] = &domain.Tweet{ID: generateID(), UserID: "user1", Timestamp: now}
+		}
+		return tweets
+	}
+	zipFiles := func(t *testing.T, data []byte) []string {
+		reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		var names []string
+		for _, file := range reader.File {
+			names = append(names, file.Name)
+		}
+		return names
+	}
+
+	t.Run("small account right away", func(t *testing.T) {
+		exportAccountUseCase, r := setup(t)
+		r.userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", maxSyncExportTweets+1, 0).Return(tweets(2), nil).Times(1)
+		r.bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, exportPageSize).Return([]*domain.Bookmark{{UserID: "user1", TweetID: "tweet9"}}, nil).Times(1)
+
+		export, data, err := exportAccountUseCase.Execute(context.Background(), "user1")
+		require.NoError(t, err)
+		assert.Nil(t, export)
+		assert.Equal(t, []string{"profile.json", "tweets.json", "follows.json", "bookmarks.json", "media.json"}, zipFiles(t, data))
+	})
+
+	t.Run("large account in the background", func(t *testing.T) {
+		exportAccountUseCase, r := setup(t)
+		var run func()
+		exportAccountUseCase.start = func(f func()) { run = f }
+		var saved domain.AccountExport
+		r.exportRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Do(func(_ context.Context, e *domain.AccountExport) { saved = *e }).Return(nil).AnyTimes()
+		r.userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", maxSyncExportTweets+1, 0).Return(tweets(maxSyncExportTweets+1), nil).Times(1)
+
+		export, data, err := exportAccountUseCase.Execute(context.Background(), "user1")
+		require.NoError(t, err)
+		assert.Nil(t, data)
+		assert.Equal(t, domain.AccountExportPending, export.Status)
+		assert.Equal(t, domain.AccountExportPending, saved.Status)
+
+		gomock.InOrder(
+			r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", exportPageSize, 0).Return(tweets(exportPageSize), nil),
+			r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", exportPageSize, exportPageSize).Return(tweets(3), nil),
+		)
+		page := make([]*domain.Bookmark, exportPageSize)
+		for i := range page {
+			page[i] = &domain.Bookmark{UserID: "user1", TweetID: "tweet9", CreatedAt: now}
+		}
+		cursor := domain.NewBookmarkCursor(page[len(page)-1])
+		gomock.InOrder(
+			r.bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, exportPageSize).Return(page, nil),
+			r.bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", &cursor, exportPageSize).Return(nil, nil),
+		)
+		var archive []byte
+		r.blobs.EXPECT().Put(gomock.Any(), export.ArchiveKey(), "application/zip", gomock.Any()).Do(func(_ context.Context, _, _ string, data []byte) { archive = data }).Return(nil).Times(1)
+
+		run()
+		assert.Equal(t, domain.AccountExportPending, export.Status)
+		assert.Equal(t, domain.AccountExportCompleted, saved.Status)
+		assert.Equal(t, len(archive), saved.Size)
+		assert.Len(t, zipFiles(t, archive), 5)
+	})
+
+	t.Run("archive that cannot be stored", func(t *testing.T) {
+		exportAccountUseCase, r := setup(t)
+		var run func()
+		exportAccountUseCase.start = func(f func()) { run = f }
+		var saved domain.AccountExport
+		r.exportRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Do(func(_ context.Context, e *domain.AccountExport) { saved = *e }).Return(nil).AnyTimes()
+		r.userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", maxSyncExportTweets+1, 0).Return(tweets(maxSyncExportTweets+1), nil).Times(1)
+		r.tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user1", exportPageSize, gomock.Any()).Return(nil, nil).Times(1)
+		r.bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, exportPageSize).Return(nil, nil).Times(1)
+		r.blobs.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError).Times(1)
+
+		_, _, err := exportAccountUseCase.Execute(context.Background(), "user1")
+		require.NoError(t, err)
+		run()
+		assert.Equal(t, domain.AccountExportFailed, saved.Status)
+		assert.Equal(t, assert.AnError.Error(), saved.Error)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		exportAccountUseCase, r := setup(t)
+		r.userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, _, err := exportAccountUseCase.Execute(context.Background(), "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+	"io"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_export_users.go -package=mocks github.com/pedro00627/urblog/application ExportUsers
+type ExportUsers interface {
+	// Execute writes every user and whom they follow to w, in a format users
+	// can be imported from, and returns how many users it wrote.
+	Execute(ctx context.Context, w io.Writer, format domain.ImportFormat) (int, error)
+}
+
+type ExportUsersUseCase struct {
+	userRepo db.UserRepository
+}
+
+func NewExportUsersUseCase(userRepo db.UserRepository) ExportUsers {
+	return &ExportUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *ExportUsersUseCase) Execute(ctx context.Context, w io.Writer, format domain.ImportFormat) (int, error) {
+	writer, err := domain.NewUserWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for {
+		users, err := uc.userRepo.FindAll(ctx, exportPageSize, count)
+		if err != nil {
+			return count, err
+		}
+		for _, user := range users {
+			if err := writer.Write(user); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(users) < exportPageSize {
+			return count, writer.Close()
+		}
+	}
+}
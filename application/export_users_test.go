@@ -0,0 +1,57 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	exportUsersUseCase := NewExportUsersUseCase(userRepo)
+
+	t.Run("pages through every user", func(t *testing.T) {
+		page := make([]*domain.User, exportPageSize)
+		for i := range page {
+			page[i] = domain.NewUser(fmt.Sprintf("user%04d", i), fmt.Sprintf("user%04d", i))
+		}
+		last := domain.NewUser("zed", "zed")
+		last.Following["user0000"] = true
+		gomock.InOrder(
+			userRepo.EXPECT().FindAll(gomock.Any(), exportPageSize, 0).Return(page, nil),
+			userRepo.EXPECT().FindAll(gomock.Any(), exportPageSize, exportPageSize).Return([]*domain.User{last}, nil),
+		)
+
+		var buf bytes.Buffer
+		count, err := exportUsersUseCase.Execute(context.Background(), &buf, domain.ImportFormatNDJSON)
+		require.NoError(t, err)
+		assert.Equal(t, exportPageSize+1, count)
+
+		records, err := domain.ReadUserRecords(domain.ImportFormatNDJSON, &buf)
+		require.NoError(t, err)
+		require.Len(t, records, exportPageSize+1)
+		assert.Equal(t, []string{"user0000"}, records[exportPageSize].Following)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := exportUsersUseCase.Execute(context.Background(), &bytes.Buffer{}, "xml")
+		assert.ErrorIs(t, err, domain.ErrUnsupportedImportFormat)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		userRepo.EXPECT().FindAll(gomock.Any(), exportPageSize, 0).Return(nil, assert.AnError).Times(1)
+
+		_, err := exportUsersUseCase.Execute(context.Background(), &bytes.Buffer{}, domain.ImportFormatCSV)
+		assert.Equal(t, assert.AnError, err)
+	})
+}
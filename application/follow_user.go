@@ -1,48 +1,96 @@
 package application
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
 	"github.com/pedro00627/urblog/infrastructure"
 	"github.com/pedro00627/urblog/infrastructure/db"
 )
 
 //go:generate mockgen -destination=./mocks/mock_follow_user.go -package=mocks github.com/pedro00627/urblog/application FollowUser
 type FollowUser interface {
-	Execute(followerID, followeeID string) error
+	Execute(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error)
 }
 
 type FollowUserUseCase struct {
-	userRepo db.UserRepository
-	queue    infrastructure.Queue
+	userRepo          db.UserRepository
+	followRequestRepo db.FollowRequestRepository
+	queue             infrastructure.Queue
+	events            infrastructure.EventPublisher
 }
 
-func NewFollowUserUseCase(userRepo db.UserRepository, queue infrastructure.Queue) FollowUser {
+func NewFollowUserUseCase(userRepo db.UserRepository, followRequestRepo db.FollowRequestRepository, queue infrastructure.Queue, events infrastructure.EventPublisher) FollowUser {
 	return &FollowUserUseCase{
-		userRepo: userRepo,
-		queue:    queue,
+		userRepo:          userRepo,
+		followRequestRepo: followRequestRepo,
+		queue:             queue,
+		events:            events,
 	}
 }
 
-func (uc *FollowUserUseCase) Execute(followerID, followeeID string) error {
-	follower, err := uc.userRepo.FindByID(followerID)
+// Execute makes followerID follow followeeID. Following a protected account
+// instead creates a follow request, which is returned.
+func (uc *FollowUserUseCase) Execute(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	follower, err := uc.userRepo.FindByID(ctx, followerID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// find followee
-	followee, err := uc.userRepo.FindByID(followeeID)
+	followee, err := uc.userRepo.FindByID(ctx, followeeID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if domain.BlockedBetween(follower, followee) {
+		return nil, domain.ErrUserBlocked
+	}
+	if followee.Protected {
+		return uc.request(ctx, follower, followee)
 	}
 	err = follower.Follow(followee.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = uc.userRepo.Save(ctx, follower)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.queue.WriteMessage(ctx, []byte("User followed: "+followerID))
+	if err != nil {
+		return nil, err
 	}
-	err = uc.userRepo.Save(follower)
+
+	err = uc.events.Publish(ctx, domain.UserFollowed{FollowerID: follower.ID, FolloweeID: followee.ID, At: time.Now()})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (uc *FollowUserUseCase) request(ctx context.Context, follower, followee *domain.User) (*domain.FollowRequest, error) {
+	if err := follower.CanFollow(followee.ID); err != nil {
+		return nil, err
+	}
+	_, err := uc.followRequestRepo.FindPending(ctx, follower.ID, followee.ID)
+	if err == nil {
+		return nil, domain.ErrFollowRequestPending
+	}
+	if !errors.Is(err, domain.ErrFollowRequestNotFound) {
+		return nil, err
+	}
+
+	request := domain.NewFollowRequest(generateID(), follower.ID, followee.ID)
+	if err := uc.followRequestRepo.Save(ctx, request); err != nil {
+		return nil, err
+	}
+	if err := uc.queue.WriteMessage(ctx, []byte("Follow requested: "+follower.ID)); err != nil {
+		return nil, err
 	}
-	err = uc.queue.WriteMessage([]byte("User followed: " + followerID))
+	err = uc.events.Publish(ctx, domain.FollowRequested{RequestID: request.ID, FollowerID: follower.ID, FolloweeID: followee.ID, At: request.CreatedAt})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return request, nil
 }
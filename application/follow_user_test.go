@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -15,26 +16,40 @@ func TestFollowUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	userRepo := mocks.NewMockUserRepository(ctrl)
+	followRequestRepo := mocks.NewMockFollowRequestRepository(ctrl)
 	queue := mocks.NewMockQueue(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
 
-	followUserUseCase := NewFollowUserUseCase(userRepo, queue)
+	followUserUseCase := NewFollowUserUseCase(userRepo, followRequestRepo, queue, events)
+
+	protected := func() *domain.User {
+		user := domain.NewUser("user2", "user2")
+		user.Protected = true
+		return user
+	}
 
 	tests := []struct {
-		name     string
-		follower string
-		followee string
-		setup    func()
-		wantErr  error
+		name        string
+		follower    string
+		followee    string
+		setup       func()
+		wantRequest bool
+		wantErr     error
 	}{
 		{
 			name:     "success",
 			follower: "user1",
 			followee: "user2",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
-				userRepo.EXPECT().FindByID("user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
-				userRepo.EXPECT().Save(gomock.Any()).Times(1)
-				queue.EXPECT().WriteMessage(gomock.Any()).Return(nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				queue.EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.UserFollowed{})).DoAndReturn(func(_ context.Context, event domain.Event) error {
+					assert.Equal(t, "user1", event.(domain.UserFollowed).FollowerID)
+					assert.Equal(t, "user2", event.(domain.UserFollowed).FolloweeID)
+					return nil
+				}).Times(1)
 			},
 			wantErr: nil,
 		},
@@ -43,7 +58,7 @@ func TestFollowUser(t *testing.T) {
 			follower: "user1",
 			followee: "user2",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(nil, domain.ErrUserNotFound).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
 			},
 			wantErr: domain.ErrUserNotFound,
 		},
@@ -52,17 +67,70 @@ func TestFollowUser(t *testing.T) {
 			follower: "user1",
 			followee: "user1",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(domain.NewUser("user1", "user1"), nil).Times(2)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(2)
 			},
 			wantErr: domain.ErrInvalidFollowAction,
 		},
+		{
+			name:     "protected followee gets a follow request",
+			follower: "user1",
+			followee: "user2",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected(), nil).Times(1)
+				followRequestRepo.EXPECT().FindPending(gomock.Any(), "user1", "user2").Return(nil, domain.ErrFollowRequestNotFound).Times(1)
+				followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *domain.FollowRequest) error {
+					assert.NotEmpty(t, request.ID)
+					assert.Equal(t, domain.FollowRequestRequested, request.Status)
+					return nil
+				}).Times(1)
+				queue.EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.FollowRequested{})).Return(nil).Times(1)
+			},
+			wantRequest: true,
+		},
+		{
+			name:     "follow request already pending",
+			follower: "user1",
+			followee: "user2",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected(), nil).Times(1)
+				followRequestRepo.EXPECT().FindPending(gomock.Any(), "user1", "user2").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+			},
+			wantErr: domain.ErrFollowRequestPending,
+		},
+		{
+			name:     "already following protected followee",
+			follower: "user1",
+			followee: "user2",
+			setup: func() {
+				follower := domain.NewUser("user1", "user1")
+				follower.Following["user2"] = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected(), nil).Times(1)
+			},
+			wantErr: domain.ErrAlreadyFollowing,
+		},
+		{
+			name:     "followee has blocked follower",
+			follower: "user1",
+			followee: "user2",
+			setup: func() {
+				followee := domain.NewUser("user2", "user2")
+				followee.Blocked["user1"] = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(followee, nil).Times(1)
+			},
+			wantErr: domain.ErrUserBlocked,
+		},
 		{
 			name:     "followee not found",
 			follower: "user1",
 			followee: "user2",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
-				userRepo.EXPECT().FindByID("user2").Return(nil, domain.ErrUserNotFound).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
 			},
 			wantErr: domain.ErrUserNotFound,
 		},
@@ -71,9 +139,9 @@ func TestFollowUser(t *testing.T) {
 			follower: "user1",
 			followee: "user2",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
-				userRepo.EXPECT().FindByID("user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
-				userRepo.EXPECT().Save(gomock.Any()).Return(errors.New("error saving user")).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("error saving user")).Times(1)
 			},
 			wantErr: errors.New("error saving user"),
 		},
@@ -82,23 +150,37 @@ func TestFollowUser(t *testing.T) {
 			follower: "user1",
 			followee: "user2",
 			setup: func() {
-				userRepo.EXPECT().FindByID("user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
-				userRepo.EXPECT().FindByID("user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
-				userRepo.EXPECT().Save(gomock.Any()).Times(1)
-				queue.EXPECT().WriteMessage(gomock.Any()).Return(errors.New("error writing to queue")).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				queue.EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(errors.New("error writing to queue")).Times(1)
 			},
 			wantErr: errors.New("error writing to queue"),
 		},
+		{
+			name:     "error publishing event",
+			follower: "user1",
+			followee: "user2",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(1)
+				queue.EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("error publishing event")).Times(1)
+			},
+			wantErr: errors.New("error publishing event"),
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			err := followUserUseCase.Execute(tt.follower, tt.followee)
+			request, err := followUserUseCase.Execute(context.Background(), tt.follower, tt.followee)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRequest, request != nil)
 			}
 		})
 	}
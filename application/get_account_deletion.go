@@ -0,0 +1,27 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_account_deletion.go -package=mocks github.com/pedro00627/urblog/application GetAccountDeletion
+type GetAccountDeletion interface {
+	Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error)
+}
+
+type GetAccountDeletionUseCase struct {
+	deletionRepo db.AccountDeletionRepository
+}
+
+func NewGetAccountDeletionUseCase(deletionRepo db.AccountDeletionRepository) GetAccountDeletion {
+	return &GetAccountDeletionUseCase{
+		deletionRepo: deletionRepo,
+	}
+}
+
+// Execute returns the deletion of userID, with the progress of its purge.
+func (uc *GetAccountDeletionUseCase) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	return uc.deletionRepo.FindByUserID(ctx, userID)
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletionRepo := mocks.NewMockAccountDeletionRepository(ctrl)
+	getAccountDeletionUseCase := NewGetAccountDeletionUseCase(deletionRepo)
+	deletion := domain.NewAccountDeletion("user1", time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC), time.Hour)
+
+	t.Run("deletion", func(t *testing.T) {
+		deletionRepo.EXPECT().FindByUserID(gomock.Any(), "user1").Return(deletion, nil).Times(1)
+
+		got, err := getAccountDeletionUseCase.Execute(context.Background(), "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, deletion, got)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		deletionRepo.EXPECT().FindByUserID(gomock.Any(), "ghost").Return(nil, domain.ErrAccountDeletionNotFound).Times(1)
+
+		_, err := getAccountDeletionUseCase.Execute(context.Background(), "ghost")
+		assert.Equal(t, domain.ErrAccountDeletionNotFound, err)
+	})
+}
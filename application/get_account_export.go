@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_account_export.go -package=mocks github.com/pedro00627/urblog/application GetAccountExport
+type GetAccountExport interface {
+	Execute(ctx context.Context, id string, archive bool) (*domain.AccountExport, []byte, error)
+}
+
+type GetAccountExportUseCase struct {
+	exportRepo db.AccountExportRepository
+	blobs      infrastructure.BlobStore
+}
+
+func NewGetAccountExportUseCase(exportRepo db.AccountExportRepository, blobs infrastructure.BlobStore) GetAccountExport {
+	return &GetAccountExportUseCase{
+		exportRepo: exportRepo,
+		blobs:      blobs,
+	}
+}
+
+// Execute returns an export and, when archive is set, its archive, failing
+// with domain.ErrAccountExportNotReady until the export is completed.
+func (uc *GetAccountExportUseCase) Execute(ctx context.Context, id string, archive bool) (*domain.AccountExport, []byte, error) {
+	export, err := uc.exportRepo.FindByID(ctx, id)
+	if err != nil || !archive {
+		return export, nil, err
+	}
+	if export.Status != domain.AccountExportCompleted {
+		return nil, nil, domain.ErrAccountExportNotReady
+	}
+	data, err := uc.blobs.Get(ctx, export.ArchiveKey())
+	if errors.Is(err, domain.ErrMediaNotFound) {
+		return nil, nil, domain.ErrAccountExportNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, data, nil
+}
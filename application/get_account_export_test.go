@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exportRepo := mocks.NewMockAccountExportRepository(ctrl)
+	blobs := mocks.NewMockBlobStore(ctrl)
+	getAccountExportUseCase := NewGetAccountExportUseCase(exportRepo, blobs)
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	completed := domain.NewAccountExport("export1", "user1", now)
+	completed.Complete(4, now)
+	pending := domain.NewAccountExport("export2", "user1", now)
+
+	t.Run("export", func(t *testing.T) {
+		exportRepo.EXPECT().FindByID(gomock.Any(), "export2").Return(pending, nil).Times(1)
+
+		export, data, err := getAccountExportUseCase.Execute(context.Background(), "export2", false)
+		assert.NoError(t, err)
+		assert.Equal(t, pending, export)
+		assert.Nil(t, data)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		exportRepo.EXPECT().FindByID(gomock.Any(), "export1").Return(completed, nil).Times(1)
+		blobs.EXPECT().Get(gomock.Any(), "exports/export1.zip").Return([]byte("data"), nil).Times(1)
+
+		export, data, err := getAccountExportUseCase.Execute(context.Background(), "export1", true)
+		assert.NoError(t, err)
+		assert.Equal(t, completed, export)
+		assert.Equal(t, []byte("data"), data)
+	})
+
+	t.Run("archive not ready", func(t *testing.T) {
+		exportRepo.EXPECT().FindByID(gomock.Any(), "export2").Return(pending, nil).Times(1)
+
+		_, _, err := getAccountExportUseCase.Execute(context.Background(), "export2", true)
+		assert.Equal(t, domain.ErrAccountExportNotReady, err)
+	})
+
+	t.Run("archive gone", func(t *testing.T) {
+		exportRepo.EXPECT().FindByID(gomock.Any(), "export1").Return(completed, nil).Times(1)
+		blobs.EXPECT().Get(gomock.Any(), "exports/export1.zip").Return(nil, domain.ErrMediaNotFound).Times(1)
+
+		_, _, err := getAccountExportUseCase.Execute(context.Background(), "export1", true)
+		assert.Equal(t, domain.ErrAccountExportNotFound, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		exportRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrAccountExportNotFound).Times(1)
+
+		_, _, err := getAccountExportUseCase.Execute(context.Background(), "ghost", false)
+		assert.Equal(t, domain.ErrAccountExportNotFound, err)
+	})
+}
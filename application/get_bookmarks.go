@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_bookmarks.go -package=mocks github.com/pedro00627/urblog/application GetBookmarks
+type GetBookmarks interface {
+	Execute(ctx context.Context, userID string, limit int, cursor string) ([]*domain.Tweet, string, error)
+}
+
+type GetBookmarksUseCase struct {
+	bookmarkRepo db.BookmarkRepository
+	tweetRepo    db.TweetRepository
+	userRepo     db.UserRepository
+	pollVoteRepo db.PollVoteRepository
+}
+
+func NewGetBookmarksUseCase(bookmarkRepo db.BookmarkRepository, tweetRepo db.TweetRepository, userRepo db.UserRepository, pollVoteRepo db.PollVoteRepository) GetBookmarks {
+	return &GetBookmarksUseCase{
+		bookmarkRepo: bookmarkRepo,
+		tweetRepo:    tweetRepo,
+		userRepo:     userRepo,
+		pollVoteRepo: pollVoteRepo,
+	}
+}
+
+// Execute returns a page of userID's bookmarked tweets, most recently
+// bookmarked first, along with the cursor of the next page. Bookmarks of
+// deleted tweets, and of tweets userID may no longer see, as when their author
+// blocked them, protected their account or is being deleted, are skipped, so
+// a page is filled from further bookmarks.
+func (uc *GetBookmarksUseCase) Execute(ctx context.Context, userID string, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	viewer, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	var after *domain.BookmarkCursor
+	if cursor != "" {
+		after, err = domain.DecodeBookmarkCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	tweets, next, err := uc.page(ctx, viewer, after, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	tweets, err = attachPollResults(ctx, uc.pollVoteRepo, userID, tweets, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	return tweets, next, nil
+}
+
+func (uc *GetBookmarksUseCase) page(ctx context.Context, viewer *domain.User, after *domain.BookmarkCursor, limit int) ([]*domain.Tweet, string, error) {
+	tweets := make([]*domain.Tweet, 0, limit)
+	visible := make(map[string]bool)
+	for len(tweets) < limit {
+		want := limit - len(tweets)
+		bookmarks, err := uc.bookmarkRepo.FindByUserID(ctx, viewer.ID, after, want)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, bookmark := range bookmarks {
+			next := domain.NewBookmarkCursor(bookmark)
+			after = &next
+
+			tweet, err := uc.tweetRepo.FindByID(ctx, bookmark.TweetID)
+			if errors.Is(err, domain.ErrTweetNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			if _, ok := visible[tweet.UserID]; !ok {
+				author, err := uc.userRepo.FindByID(ctx, tweet.UserID)
+				if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+					return nil, "", err
+				}
+				visible[tweet.UserID] = author != nil && domain.CanSeeTweets(viewer, author)
+			}
+			if !visible[tweet.UserID] {
+				continue
+			}
+			tweets = append(tweets, tweet)
+		}
+		if len(bookmarks) < want {
+			// There are no bookmarks left.
+			return tweets, "", nil
+		}
+	}
+	if after == nil {
+		return tweets, "", nil
+	}
+	return tweets, after.Encode(), nil
+}
@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBookmarks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocks.NewMockBookmarkRepository(ctrl)
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	getBookmarksUseCase := NewGetBookmarksUseCase(bookmarkRepo, tweetRepo, userRepo, mocks.NewMockPollVoteRepository(ctrl))
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	bookmark := func(tweetID string, age time.Duration) *domain.Bookmark {
+		return &domain.Bookmark{UserID: "user1", TweetID: tweetID, CreatedAt: now.Add(-age)}
+	}
+	b1, b2, b3, b4 := bookmark("tweet1", 0), bookmark("tweet2", time.Minute), bookmark("tweet3", 2*time.Minute), bookmark("tweet4", 3*time.Minute)
+	tweet1 := &domain.Tweet{ID: "tweet1", UserID: "user2"}
+	tweet3 := &domain.Tweet{ID: "tweet3", UserID: "user2"}
+	tweet4 := &domain.Tweet{ID: "tweet4", UserID: "user3"}
+
+	t.Run("deleted tweets are skipped and the page refilled", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, 2).Return([]*domain.Bookmark{b1, b2}, nil).Times(1)
+		c2 := domain.NewBookmarkCursor(b2)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", &c2, 1).Return([]*domain.Bookmark{b3}, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet1, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet2").Return(nil, domain.ErrTweetNotFound).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet3").Return(tweet3, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		tweets, next, err := getBookmarksUseCase.Execute(context.Background(), "user1", 2, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Tweet{tweet1, tweet3}, tweets)
+		assert.Equal(t, domain.NewBookmarkCursor(b3).Encode(), next)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		c3 := domain.NewBookmarkCursor(b3)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", &c3, 2).Return([]*domain.Bookmark{b4}, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet4").Return(tweet4, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+
+		tweets, next, err := getBookmarksUseCase.Execute(context.Background(), "user1", 2, c3.Encode())
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Tweet{tweet4}, tweets)
+		assert.Empty(t, next)
+	})
+
+	t.Run("tweets of deleted users are skipped", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, 2).Return([]*domain.Bookmark{b1, b4}, nil).Times(1)
+		c4 := domain.NewBookmarkCursor(b4)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", &c4, 1).Return(nil, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet1, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet4").Return(tweet4, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+
+		tweets, next, err := getBookmarksUseCase.Execute(context.Background(), "user1", 2, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Tweet{tweet4}, tweets)
+		assert.Empty(t, next)
+	})
+
+	t.Run("tweets the user may no longer see are skipped", func(t *testing.T) {
+		viewer := domain.NewUser("user1", "user1")
+		viewer.Following["user4"] = true
+		blocking := domain.NewUser("user2", "user2")
+		blocking.Blocked["user1"] = true
+		protected := domain.NewUser("user3", "user3")
+		protected.Protected = true
+		followed := domain.NewUser("user4", "user4")
+		followed.Protected = true
+		tweet2 := &domain.Tweet{ID: "tweet2", UserID: "user4"}
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(viewer, nil).Times(1)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", nil, 3).Return([]*domain.Bookmark{b1, b2, b3}, nil).Times(1)
+		c3 := domain.NewBookmarkCursor(b3)
+		bookmarkRepo.EXPECT().FindByUserID(gomock.Any(), "user1", &c3, 2).Return([]*domain.Bookmark{b4}, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet1, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet2").Return(tweet2, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet3").Return(tweet3, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet4").Return(tweet4, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(blocking, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user4").Return(followed, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(protected, nil).Times(1)
+
+		tweets, next, err := getBookmarksUseCase.Execute(context.Background(), "user1", 3, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Tweet{tweet2}, tweets)
+		assert.Empty(t, next)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		_, _, err := getBookmarksUseCase.Execute(context.Background(), "user1", 2, "!")
+		assert.Equal(t, domain.ErrInvalidBookmarkCursor, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, _, err := getBookmarksUseCase.Execute(context.Background(), "ghost", 2, "")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_follow_requests.go -package=mocks github.com/pedro00627/urblog/application GetFollowRequests
+type GetFollowRequests interface {
+	Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.FollowRequest, error)
+}
+
+type GetFollowRequestsUseCase struct {
+	userRepo          db.UserRepository
+	followRequestRepo db.FollowRequestRepository
+}
+
+func NewGetFollowRequestsUseCase(userRepo db.UserRepository, followRequestRepo db.FollowRequestRepository) GetFollowRequests {
+	return &GetFollowRequestsUseCase{
+		userRepo:          userRepo,
+		followRequestRepo: followRequestRepo,
+	}
+}
+
+// Execute returns the pending follow requests userID has received, oldest
+// first.
+func (uc *GetFollowRequestsUseCase) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.followRequestRepo.FindPendingByFolloweeID(ctx, userID, limit, offset)
+}
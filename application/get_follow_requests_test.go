@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFollowRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	followRequestRepo := mocks.NewMockFollowRequestRepository(ctrl)
+
+	getFollowRequestsUseCase := NewGetFollowRequestsUseCase(userRepo, followRequestRepo)
+
+	t.Run("success", func(t *testing.T) {
+		requests := []*domain.FollowRequest{domain.NewFollowRequest("request1", "user1", "user2")}
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+		followRequestRepo.EXPECT().FindPendingByFolloweeID(gomock.Any(), "user2", 10, 5).Return(requests, nil).Times(1)
+
+		got, err := getFollowRequestsUseCase.Execute(context.Background(), "user2", 10, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, requests, got)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := getFollowRequestsUseCase.Execute(context.Background(), "ghost", 10, 0)
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
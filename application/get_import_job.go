@@ -0,0 +1,35 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_import_job.go -package=mocks github.com/pedro00627/urblog/application GetImportJob
+type GetImportJob interface {
+	// Execute returns a job with one page of its report.
+	Execute(ctx context.Context, id string, limit, offset int) (*domain.ImportJob, []domain.ImportRow, error)
+}
+
+type GetImportJobUseCase struct {
+	importJobRepo db.ImportJobRepository
+}
+
+func NewGetImportJobUseCase(importJobRepo db.ImportJobRepository) GetImportJob {
+	return &GetImportJobUseCase{
+		importJobRepo: importJobRepo,
+	}
+}
+
+func (uc *GetImportJobUseCase) Execute(ctx context.Context, id string, limit, offset int) (*domain.ImportJob, []domain.ImportRow, error) {
+	job, err := uc.importJobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := uc.importJobRepo.FindRows(ctx, job.ID, limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	return job, rows, nil
+}
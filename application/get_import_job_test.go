@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetImportJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	importJobRepo := mocks.NewMockImportJobRepository(ctrl)
+	getImportJobUseCase := NewGetImportJobUseCase(importJobRepo)
+
+	t.Run("success", func(t *testing.T) {
+		job := &domain.ImportJob{ID: "job1", Status: domain.ImportJobRunning}
+		rows := []domain.ImportRow{{JobID: "job1", Line: 3, Status: domain.ImportRowAccepted, UserID: "user1"}}
+		importJobRepo.EXPECT().FindByID(gomock.Any(), "job1").Return(job, nil).Times(1)
+		importJobRepo.EXPECT().FindRows(gomock.Any(), "job1", 10, 2).Return(rows, nil).Times(1)
+
+		gotJob, gotRows, err := getImportJobUseCase.Execute(context.Background(), "job1", 10, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, job, gotJob)
+		assert.Equal(t, rows, gotRows)
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		importJobRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrImportJobNotFound).Times(1)
+
+		_, _, err := getImportJobUseCase.Execute(context.Background(), "ghost", 10, 0)
+		assert.Equal(t, domain.ErrImportJobNotFound, err)
+	})
+}
@@ -0,0 +1,39 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_list.go -package=mocks github.com/pedro00627/urblog/application GetList
+type GetList interface {
+	Execute(ctx context.Context, viewerID, listID string) (*domain.List, error)
+}
+
+type GetListUseCase struct {
+	listRepo db.ListRepository
+}
+
+func NewGetListUseCase(listRepo db.ListRepository) GetList {
+	return &GetListUseCase{
+		listRepo: listRepo,
+	}
+}
+
+func (uc *GetListUseCase) Execute(ctx context.Context, viewerID, listID string) (*domain.List, error) {
+	return findVisibleList(ctx, uc.listRepo, viewerID, listID)
+}
+
+// findVisibleList loads a list viewerID may see. Private lists of other users
+// are reported as not found.
+func findVisibleList(ctx context.Context, listRepo db.ListRepository, viewerID, listID string) (*domain.List, error) {
+	list, err := listRepo.FindByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if !list.CanView(viewerID) {
+		return nil, domain.ErrListNotFound
+	}
+	return list, nil
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestList returns a list owned by user1 with the given members.
+func newTestList(private bool, members ...string) *domain.List {
+	list, _ := domain.NewList("list1", "user1", "friends", private)
+	for _, member := range members {
+		list.Members[member] = true
+	}
+	return list
+}
+
+func TestGetList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+
+	getListUseCase := NewGetListUseCase(listRepo)
+
+	tests := []struct {
+		name     string
+		viewerID string
+		list     *domain.List
+		wantErr  error
+	}{
+		{name: "public list", viewerID: "user2", list: newTestList(false)},
+		{name: "own private list", viewerID: "user1", list: newTestList(true)},
+		{name: "someone else's private list", viewerID: "user2", list: newTestList(true), wantErr: domain.ErrListNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(tt.list, nil).Times(1)
+			list, err := getListUseCase.Execute(context.Background(), tt.viewerID, "list1")
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.list, list)
+		})
+	}
+
+	t.Run("list not found", func(t *testing.T) {
+		listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(nil, domain.ErrListNotFound).Times(1)
+		_, err := getListUseCase.Execute(context.Background(), "user1", "list1")
+		assert.Equal(t, domain.ErrListNotFound, err)
+	})
+}
@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+	"log/slog"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_list_timeline.go -package=mocks github.com/pedro00627/urblog/application GetListTimeline
+type GetListTimeline interface {
+	Execute(ctx context.Context, viewerID, listID string, limit, offset int) ([]*domain.Tweet, error)
+}
+
+type GetListTimelineUseCase struct {
+	listRepo db.ListRepository
+	userRepo db.UserRepository
+	timeline *GetTimelineUseCase
+}
+
+func NewGetListTimelineUseCase(listRepo db.ListRepository, tweetRepo db.TweetRepository, userRepo db.UserRepository, pollVoteRepo db.PollVoteRepository, logger *slog.Logger) GetListTimeline {
+	return &GetListTimelineUseCase{
+		listRepo: listRepo,
+		userRepo: userRepo,
+		timeline: NewGetTimelineUseCase(tweetRepo, userRepo, pollVoteRepo, logger).(*GetTimelineUseCase),
+	}
+}
+
+// Execute merges the tweets of the list's members the same way the home
+// timeline merges followed users. Members viewerID has muted, or whose tweets
+// viewerID may not see, are left out.
+func (uc *GetListTimelineUseCase) Execute(ctx context.Context, viewerID, listID string, limit, offset int) ([]*domain.Tweet, error) {
+	list, err := findVisibleList(ctx, uc.listRepo, viewerID, listID)
+	if err != nil {
+		return nil, err
+	}
+	var viewer *domain.User
+	if viewerID != "" {
+		viewer, err = uc.userRepo.FindByID(ctx, viewerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return uc.timeline.merge(ctx, viewer, list.Members, limit, offset)
+}
@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListTimelineUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	useCase := NewGetListTimelineUseCase(listRepo, tweetRepo, userRepo, mocks.NewMockPollVoteRepository(ctrl), slog.New(slog.DiscardHandler))
+
+	now := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	tweet2 := &domain.Tweet{ID: "tweet2", UserID: "user2", Timestamp: now.Add(-time.Hour)}
+	tweet3 := &domain.Tweet{ID: "tweet3", UserID: "user3", Timestamp: now}
+
+	protected := domain.NewUser("user3", "user3")
+	protected.Protected = true
+
+	tests := []struct {
+		name       string
+		viewerID   string
+		list       *domain.List
+		setup      func()
+		wantTweets []*domain.Tweet
+		wantErr    error
+	}{
+		{
+			name:     "members' tweets, newest first",
+			viewerID: "user1",
+			list:     newTestList(false, "user2", "user3"),
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{tweet2}, nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user3", 10, 0).Return([]*domain.Tweet{tweet3}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet3, tweet2},
+		},
+		{
+			name:     "muted members are left out",
+			viewerID: "user1",
+			list:     newTestList(false, "user2", "user3"),
+			setup: func() {
+				viewer := domain.NewUser("user1", "user1")
+				viewer.Muted["user3"] = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(viewer, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{tweet2}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet2},
+		},
+		{
+			name:     "members are found by ID",
+			viewerID: "user1",
+			list:     newTestList(false, "id2"),
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "id2").Return(domain.NewUser("id2", "ana"), nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "id2", 10, 0).Return([]*domain.Tweet{tweet2}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet2},
+		},
+		{
+			name:     "members that cannot be found are left out",
+			viewerID: "user1",
+			list:     newTestList(false, "user2", "user3"),
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(nil, domain.ErrUserNotFound).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{tweet2}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet2},
+		},
+		{
+			name: "anonymous viewer does not see protected members",
+			list: newTestList(false, "user2", "user3"),
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(protected, nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{tweet2}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet2},
+		},
+		{
+			name:     "someone else's private list",
+			viewerID: "user2",
+			list:     newTestList(true, "user2"),
+			setup:    func() {},
+			wantErr:  domain.ErrListNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(tt.list, nil).Times(1)
+			tt.setup()
+
+			tweets, err := useCase.Execute(context.Background(), tt.viewerID, "list1", 10, 0)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTweets, tweets)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_media.go -package=mocks github.com/pedro00627/urblog/application GetMedia
+type GetMedia interface {
+	Execute(ctx context.Context, id string, thumbnail bool) (*domain.Media, []byte, error)
+}
+
+type GetMediaUseCase struct {
+	mediaRepo db.MediaRepository
+	blobs     infrastructure.BlobStore
+}
+
+func NewGetMediaUseCase(mediaRepo db.MediaRepository, blobs infrastructure.BlobStore) GetMedia {
+	return &GetMediaUseCase{
+		mediaRepo: mediaRepo,
+		blobs:     blobs,
+	}
+}
+
+// Execute returns a media and its image, or its thumbnail when thumbnail is
+// set.
+func (uc *GetMediaUseCase) Execute(ctx context.Context, id string, thumbnail bool) (*domain.Media, []byte, error) {
+	media, err := uc.mediaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := media.BlobKey()
+	if thumbnail {
+		key = media.ThumbnailKey()
+	}
+	data, err := uc.blobs.Get(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return media, data, nil
+}
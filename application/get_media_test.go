@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mediaRepo := mocks.NewMockMediaRepository(ctrl)
+	blobs := mocks.NewMockBlobStore(ctrl)
+	getMediaUseCase := NewGetMediaUseCase(mediaRepo, blobs)
+	media := &domain.Media{ID: "media1", UserID: "user1", ContentType: "image/png"}
+
+	tests := []struct {
+		name      string
+		thumbnail bool
+		wantKey   string
+	}{
+		{name: "image", wantKey: "media1"},
+		{name: "thumbnail", thumbnail: true, wantKey: "media1/thumbnail"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaRepo.EXPECT().FindByID(gomock.Any(), "media1").Return(media, nil).Times(1)
+			blobs.EXPECT().Get(gomock.Any(), tt.wantKey).Return([]byte("data"), nil).Times(1)
+
+			got, data, err := getMediaUseCase.Execute(context.Background(), "media1", tt.thumbnail)
+			assert.NoError(t, err)
+			assert.Equal(t, media, got)
+			assert.Equal(t, []byte("data"), data)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		mediaRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrMediaNotFound).Times(1)
+
+		_, _, err := getMediaUseCase.Execute(context.Background(), "ghost", false)
+		assert.Equal(t, domain.ErrMediaNotFound, err)
+	})
+}
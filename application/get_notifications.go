@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_notifications.go -package=mocks github.com/pedro00627/urblog/application GetNotifications
+type GetNotifications interface {
+	Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, int, error)
+}
+
+type GetNotificationsUseCase struct {
+	notificationRepo db.NotificationRepository
+	userRepo         db.UserRepository
+}
+
+func NewGetNotificationsUseCase(notificationRepo db.NotificationRepository, userRepo db.UserRepository) GetNotifications {
+	return &GetNotificationsUseCase{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// Execute returns a page of the user's notifications, most recently updated
+// first, along with the number of unread notifications.
+func (uc *GetNotificationsUseCase) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, int, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	notifications, err := uc.notificationRepo.FindByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unread, err := uc.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, unread, nil
+}
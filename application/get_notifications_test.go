@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotificationsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mocks.NewMockNotificationRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	useCase := NewGetNotificationsUseCase(notificationRepo, userRepo)
+
+	notification := domain.NewNotification("n1", "user1", domain.NotificationFollowed, "", "user2")
+
+	tests := []struct {
+		name       string
+		setup      func()
+		want       []*domain.Notification
+		wantUnread int
+		wantErr    error
+	}{
+		{
+			name: "success",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				notificationRepo.EXPECT().FindByUserID(gomock.Any(), "user1", 20, 0).Return([]*domain.Notification{notification}, nil).Times(1)
+				notificationRepo.EXPECT().CountUnread(gomock.Any(), "user1").Return(1, nil).Times(1)
+			},
+			want:       []*domain.Notification{notification},
+			wantUnread: 1,
+		},
+		{
+			name: "user not found",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "error finding notifications",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				notificationRepo.EXPECT().FindByUserID(gomock.Any(), "user1", 20, 0).Return(nil, errors.New("database error")).Times(1)
+			},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name: "error counting unread",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				notificationRepo.EXPECT().FindByUserID(gomock.Any(), "user1", 20, 0).Return(nil, nil).Times(1)
+				notificationRepo.EXPECT().CountUnread(gomock.Any(), "user1").Return(0, errors.New("database error")).Times(1)
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, unread, err := useCase.Execute(context.Background(), "user1", 20, 0)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantUnread, unread)
+			}
+		})
+	}
+}
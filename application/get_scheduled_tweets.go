@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_scheduled_tweets.go -package=mocks github.com/pedro00627/urblog/application GetScheduledTweets
+type GetScheduledTweets interface {
+	Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error)
+}
+
+type GetScheduledTweetsUseCase struct {
+	scheduledTweetRepo db.ScheduledTweetRepository
+	userRepo           db.UserRepository
+}
+
+func NewGetScheduledTweetsUseCase(scheduledTweetRepo db.ScheduledTweetRepository, userRepo db.UserRepository) GetScheduledTweets {
+	return &GetScheduledTweetsUseCase{
+		scheduledTweetRepo: scheduledTweetRepo,
+		userRepo:           userRepo,
+	}
+}
+
+// Execute returns userID's pending scheduled tweets, soonest first.
+func (uc *GetScheduledTweetsUseCase) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.scheduledTweetRepo.FindPendingByUserID(ctx, userID, limit, offset)
+}
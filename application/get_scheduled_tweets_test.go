@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScheduledTweets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledTweetRepo := mocks.NewMockScheduledTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	getScheduledTweetsUseCase := NewGetScheduledTweetsUseCase(scheduledTweetRepo, userRepo)
+
+	t.Run("success", func(t *testing.T) {
+		tweets := []*domain.ScheduledTweet{{ID: "scheduled1", UserID: "user1"}}
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		scheduledTweetRepo.EXPECT().FindPendingByUserID(gomock.Any(), "user1", 10, 0).Return(tweets, nil).Times(1)
+
+		got, err := getScheduledTweetsUseCase.Execute(context.Background(), "user1", 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, tweets, got)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := getScheduledTweetsUseCase.Execute(context.Background(), "ghost", 10, 0)
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
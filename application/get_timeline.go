@@ -1,46 +1,63 @@
 package application
 
 import (
+	"context"
 	"github.com/pedro00627/urblog/infrastructure/db"
-	"log"
+	"log/slog"
 	"sort"
+	"time"
 
 	"github.com/pedro00627/urblog/domain"
 )
 
 //go:generate mockgen -destination=./mocks/mock_get_timeline.go -package=mocks github.com/pedro00627/urblog/application GetTimeline
 type GetTimeline interface {
-	Execute(userID string, limit, offset int) ([]*domain.Tweet, error)
+	Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error)
 }
 
 type GetTimelineUseCase struct {
-	tweetRepo db.TweetRepository
-	userRepo  db.UserRepository
+	tweetRepo    db.TweetRepository
+	userRepo     db.UserRepository
+	pollVoteRepo db.PollVoteRepository
+	logger       *slog.Logger
 }
 
-func NewGetTimelineUseCase(tweetRepo db.TweetRepository, userRepo db.UserRepository) GetTimeline {
+func NewGetTimelineUseCase(tweetRepo db.TweetRepository, userRepo db.UserRepository, pollVoteRepo db.PollVoteRepository, logger *slog.Logger) GetTimeline {
 	return &GetTimelineUseCase{
-		tweetRepo: tweetRepo,
-		userRepo:  userRepo,
+		tweetRepo:    tweetRepo,
+		userRepo:     userRepo,
+		pollVoteRepo: pollVoteRepo,
+		logger:       logger,
 	}
 }
 
-func (uc *GetTimelineUseCase) Execute(userID string, limit, offset int) ([]*domain.Tweet, error) {
-	user, err := uc.userRepo.FindByID(userID)
+func (uc *GetTimelineUseCase) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	return uc.merge(ctx, user, user.Following, limit, offset)
+}
 
+// merge collects the tweets of authorIDs that viewer can see into a single
+// page, newest first. Authors that cannot be found and muted authors are left
+// out; a nil viewer is anonymous.
+func (uc *GetTimelineUseCase) merge(ctx context.Context, viewer *domain.User, authorIDs map[string]bool, limit, offset int) ([]*domain.Tweet, error) {
 	var allTweets []*domain.Tweet
-	for followedUserID := range user.Following {
-		// get user name
-		followedUserId, err := uc.userRepo.FindByName(followedUserID)
+	for authorID := range authorIDs {
+		author, err := uc.userRepo.FindByID(ctx, authorID)
 		if err != nil {
-			log.Printf("Error finding user by name: %s", followedUserID)
+			uc.logger.WarnContext(ctx, "finding timeline author failed", "user_id", authorID, "error", err)
+			continue
+		}
+		if viewer != nil && viewer.HasMuted(author.ID) {
+			continue
+		}
+		if !domain.CanSeeTweets(viewer, author) {
 			continue
 		}
 
-		tweets, err := uc.tweetRepo.FindByUserID(followedUserId.ID, limit, offset)
+		tweets, err := uc.tweetRepo.FindByUserID(ctx, author.ID, limit, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -53,7 +70,11 @@ func (uc *GetTimelineUseCase) Execute(userID string, limit, offset int) ([]*doma
 	// Apply pagination
 	paginatedTweets := paginateTweets(offset, limit, allTweets)
 
-	return paginatedTweets, nil
+	viewerID := ""
+	if viewer != nil {
+		viewerID = viewer.ID
+	}
+	return attachPollResults(ctx, uc.pollVoteRepo, viewerID, paginatedTweets, time.Now())
 }
 
 func paginateTweets(offset int, limit int, allTweets []*domain.Tweet) []*domain.Tweet {
@@ -1,7 +1,9 @@
 package application
 
 import (
+	"context"
 	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -17,8 +19,9 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 
 	mockTweetRepo := mocks.NewMockTweetRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPollVoteRepo := mocks.NewMockPollVoteRepository(ctrl)
 
-	useCase := NewGetTimelineUseCase(mockTweetRepo, mockUserRepo)
+	useCase := NewGetTimelineUseCase(mockTweetRepo, mockUserRepo, mockPollVoteRepo, slog.New(slog.DiscardHandler))
 
 	inputDate := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
 
@@ -57,9 +60,9 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 					Timestamp: inputDate.Add(-2 * time.Hour),
 				}
 
-				mockUserRepo.EXPECT().FindByID("user1").Return(user, nil)
-				mockUserRepo.EXPECT().FindByName("user2").Return(&domain.User{ID: "user2"}, nil)
-				mockTweetRepo.EXPECT().FindByUserID("user2", 10, 0).Return([]*domain.Tweet{tweet1, tweet2}, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(&domain.User{ID: "user2"}, nil)
+				mockTweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{tweet1, tweet2}, nil)
 			},
 			wantTweets: []*domain.Tweet{
 				{
@@ -77,13 +80,48 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name:   "followed users are found by ID",
+			userID: "user1",
+			limit:  10,
+			offset: 0,
+			setupMocks: func() {
+				user := &domain.User{
+					ID:        "user1",
+					Username:  "user1",
+					Following: map[string]bool{"id2": true},
+				}
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "id2").Return(domain.NewUser("id2", "ana"), nil)
+				mockTweetRepo.EXPECT().FindByUserID(gomock.Any(), "id2", 10, 0).Return([]*domain.Tweet{{ID: "tweet3", UserID: "id2"}}, nil)
+			},
+			wantTweets: []*domain.Tweet{{ID: "tweet3", UserID: "id2"}},
+		},
+		{
+			name:   "muted user is hidden",
+			userID: "user1",
+			limit:  10,
+			offset: 0,
+			setupMocks: func() {
+				user := &domain.User{
+					ID:        "user1",
+					Username:  "user1",
+					Following: map[string]bool{"user2": true},
+					Muted:     map[string]bool{"user2": true},
+				}
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(&domain.User{ID: "user2"}, nil)
+			},
+			wantTweets: nil,
+			wantErr:    nil,
+		},
 		{
 			name:   "user not found",
 			userID: "user1",
 			limit:  10,
 			offset: 0,
 			setupMocks: func() {
-				mockUserRepo.EXPECT().FindByID("user1").Return(nil, domain.ErrUserNotFound)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound)
 			},
 			wantTweets: nil,
 			wantErr:    domain.ErrUserNotFound,
@@ -101,8 +139,8 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 						"user2": true,
 					},
 				}
-				mockUserRepo.EXPECT().FindByID("user1").Return(user, nil)
-				mockUserRepo.EXPECT().FindByName("user2").Return(nil, errors.New("error finding user"))
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, errors.New("error finding user"))
 			},
 			wantTweets: nil,
 			wantErr:    nil, // No error is returned in this case, just logs
@@ -120,9 +158,9 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 						"user2": true,
 					},
 				}
-				mockUserRepo.EXPECT().FindByID("user1").Return(user, nil)
-				mockUserRepo.EXPECT().FindByName("user2").Return(&domain.User{ID: "user2"}, nil)
-				mockTweetRepo.EXPECT().FindByUserID("user2", 10, 0).Return(nil, errors.New("error finding tweets"))
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil)
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(&domain.User{ID: "user2"}, nil)
+				mockTweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return(nil, errors.New("error finding tweets"))
 			},
 			wantTweets: nil,
 			wantErr:    errors.New("error finding tweets"),
@@ -132,7 +170,7 @@ func TestGetTimelineUseCase_Execute(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks()
-			tweets, err := useCase.Execute(tt.userID, tt.limit, tt.offset)
+			tweets, err := useCase.Execute(context.Background(), tt.userID, tt.limit, tt.offset)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr, err)
 			} else {
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_user_lists.go -package=mocks github.com/pedro00627/urblog/application GetUserLists
+type GetUserLists interface {
+	Execute(ctx context.Context, viewerID, ownerID string) ([]*domain.List, error)
+}
+
+type GetUserListsUseCase struct {
+	listRepo db.ListRepository
+	userRepo db.UserRepository
+}
+
+func NewGetUserListsUseCase(listRepo db.ListRepository, userRepo db.UserRepository) GetUserLists {
+	return &GetUserListsUseCase{
+		listRepo: listRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the lists ownerID owns that viewerID may see, oldest first.
+func (uc *GetUserListsUseCase) Execute(ctx context.Context, viewerID, ownerID string) ([]*domain.List, error) {
+	if _, err := uc.userRepo.FindByID(ctx, ownerID); err != nil {
+		return nil, err
+	}
+	lists, err := uc.listRepo.FindByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*domain.List, 0, len(lists))
+	for _, list := range lists {
+		if list.CanView(viewerID) {
+			visible = append(visible, list)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserLists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	getUserListsUseCase := NewGetUserListsUseCase(listRepo, userRepo)
+
+	public := newTestList(false)
+	private := newTestList(true)
+
+	tests := []struct {
+		name      string
+		viewerID  string
+		wantLists []*domain.List
+	}{
+		{name: "owner sees every list", viewerID: "user1", wantLists: []*domain.List{public, private}},
+		{name: "others see public lists", viewerID: "user2", wantLists: []*domain.List{public}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+			listRepo.EXPECT().FindByOwnerID(gomock.Any(), "user1").Return([]*domain.List{public, private}, nil).Times(1)
+
+			lists, err := getUserListsUseCase.Execute(context.Background(), tt.viewerID, "user1")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLists, lists)
+		})
+	}
+
+	t.Run("owner not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := getUserListsUseCase.Execute(context.Background(), "user1", "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
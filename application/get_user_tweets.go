@@ -0,0 +1,67 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_user_tweets.go -package=mocks github.com/pedro00627/urblog/application GetUserTweets
+type GetUserTweets interface {
+	Execute(ctx context.Context, viewerID, userID string, limit, offset int) ([]*domain.Tweet, error)
+}
+
+type GetUserTweetsUseCase struct {
+	tweetRepo    db.TweetRepository
+	userRepo     db.UserRepository
+	pollVoteRepo db.PollVoteRepository
+}
+
+func NewGetUserTweetsUseCase(tweetRepo db.TweetRepository, userRepo db.UserRepository, pollVoteRepo db.PollVoteRepository) GetUserTweets {
+	return &GetUserTweetsUseCase{
+		tweetRepo:    tweetRepo,
+		userRepo:     userRepo,
+		pollVoteRepo: pollVoteRepo,
+	}
+}
+
+// Execute returns userID's tweets as seen by viewerID, who may be empty for an
+// anonymous reader. Protected tweets are only shown to approved followers.
+func (uc *GetUserTweetsUseCase) Execute(ctx context.Context, viewerID, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	author, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanSeeTweets(ctx, uc.userRepo, viewerID, author); err != nil {
+		return nil, err
+	}
+
+	tweets, err := uc.tweetRepo.FindByUserID(ctx, author.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	sortTweetsByNewest(tweets)
+	return attachPollResults(ctx, uc.pollVoteRepo, viewerID, tweets, time.Now())
+}
+
+// checkCanSeeTweets returns why viewerID may not read author's tweets, if
+// they may not.
+func checkCanSeeTweets(ctx context.Context, userRepo db.UserRepository, viewerID string, author *domain.User) error {
+	var viewer *domain.User
+	if viewerID != "" {
+		var err error
+		viewer, err = userRepo.FindByID(ctx, viewerID)
+		if err != nil {
+			return err
+		}
+		if domain.BlockedBetween(viewer, author) {
+			return domain.ErrUserBlocked
+		}
+	}
+	if !domain.CanSeeTweets(viewer, author) {
+		return domain.ErrProtectedAccount
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserTweetsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	pollVoteRepo := mocks.NewMockPollVoteRepository(ctrl)
+
+	useCase := NewGetUserTweetsUseCase(tweetRepo, userRepo, pollVoteRepo)
+
+	now := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	older := &domain.Tweet{ID: "tweet1", UserID: "user2", Timestamp: now.Add(-time.Hour)}
+	newer := &domain.Tweet{ID: "tweet2", UserID: "user2", Timestamp: now}
+
+	protected := domain.NewUser("user2", "user2")
+	protected.Protected = true
+	follower := domain.NewUser("user1", "user1")
+	follower.Following["user2"] = true
+
+	tests := []struct {
+		name       string
+		viewerID   string
+		setup      func()
+		wantTweets []*domain.Tweet
+		wantErr    error
+	}{
+		{
+			name: "public account, newest first",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{older, newer}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{newer, older},
+		},
+		{
+			name:     "protected account seen by a follower",
+			viewerID: "user1",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower, nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{newer}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{newer},
+		},
+		{
+			name:     "protected account seen by someone else",
+			viewerID: "user3",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+			},
+			wantErr: domain.ErrProtectedAccount,
+		},
+		{
+			name: "protected account seen anonymously",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(protected, nil).Times(1)
+			},
+			wantErr: domain.ErrProtectedAccount,
+		},
+		{
+			name:     "blocked viewer",
+			viewerID: "user3",
+			setup: func() {
+				author := domain.NewUser("user2", "user2")
+				author.Blocked["user3"] = true
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+			},
+			wantErr: domain.ErrUserBlocked,
+		},
+		{
+			name:     "poll results for the viewer",
+			viewerID: "user1",
+			setup: func() {
+				poll := &domain.Tweet{ID: "tweet3", UserID: "user2", Timestamp: now, Poll: &domain.Poll{Options: []string{"yes", "no"}, EndsAt: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}}
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower, nil).Times(1)
+				tweetRepo.EXPECT().FindByUserID(gomock.Any(), "user2", 10, 0).Return([]*domain.Tweet{poll, older}, nil).Times(1)
+				pollVoteRepo.EXPECT().Tally(gomock.Any(), []string{"tweet3"}).Return(map[string]map[int]int{"tweet3": {1: 2}}, nil).Times(1)
+				pollVoteRepo.EXPECT().FindByUserID(gomock.Any(), "user1", []string{"tweet3"}).Return(map[string]*domain.PollVote{"tweet3": {TweetID: "tweet3", UserID: "user1", Option: 1}}, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{
+				{ID: "tweet3", UserID: "user2", Timestamp: now, Poll: &domain.Poll{
+					Options: []string{"yes", "no"},
+					EndsAt:  time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+					Results: &domain.PollResults{Votes: []int{0, 2}, TotalVotes: 2, ViewerVote: 1},
+				}},
+				older,
+			},
+		},
+		{
+			name: "user not found",
+			setup: func() {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			tweets, err := useCase.Execute(context.Background(), tt.viewerID, "user2", 10, 0)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTweets, tweets)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// MaxUserImportSize is the largest file, in bytes, that an import reads.
+const MaxUserImportSize = 10 << 20
+
+// importProgressInterval is how many rows are imported between saves of a
+// job's progress and report.
+const importProgressInterval = 100
+
+//go:generate mockgen -destination=./mocks/mock_import_users.go -package=mocks github.com/pedro00627/urblog/application ImportUsers
+type ImportUsers interface {
+	// Execute reads a file of users and imports it in the background,
+	// returning the pending job.
+	Execute(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, error)
+}
+
+type ImportUsersUseCase struct {
+	importJobRepo db.ImportJobRepository
+	userRepo      db.UserRepository
+	logger        *slog.Logger
+	now           func() time.Time
+	// start runs an import in the background.
+	start func(func())
+}
+
+func NewImportUsersUseCase(importJobRepo db.ImportJobRepository, userRepo db.UserRepository, logger *slog.Logger) *ImportUsersUseCase {
+	return &ImportUsersUseCase{
+		importJobRepo: importJobRepo,
+		userRepo:      userRepo,
+		logger:        logger,
+		now:           time.Now,
+		start:         func(run func()) { go run() },
+	}
+}
+
+func (uc *ImportUsersUseCase) Execute(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, error) {
+	job, records, err := uc.newJob(ctx, r, options)
+	if err != nil {
+		return nil, err
+	}
+	// The job keeps changing as it runs, so it runs on a copy. It outlives the
+	// request, so it keeps the request's values but not its cancellation.
+	running := *job
+	uc.start(func() { uc.run(context.WithoutCancel(ctx), &running, records) })
+	return job, nil
+}
+
+// Import imports a file of users and returns the job once it is finished.
+func (uc *ImportUsersUseCase) Import(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, error) {
+	job, records, err := uc.newJob(ctx, r, options)
+	if err != nil {
+		return nil, err
+	}
+	uc.run(ctx, job, records)
+	return job, nil
+}
+
+// newJob reads the whole file, so that the import can outlive the request it
+// came with and a file that cannot be read is refused right away, and saves a
+// pending job for it.
+func (uc *ImportUsersUseCase) newJob(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, []domain.UserRecord, error) {
+	records, err := domain.ReadUserRecords(options.Format, &sizeLimitReader{r: r, n: MaxUserImportSize})
+	if err != nil {
+		return nil, nil, err
+	}
+	job := domain.NewImportJob(generateID(), options, len(records), uc.now())
+	if err := uc.importJobRepo.Save(ctx, job); err != nil {
+		return nil, nil, err
+	}
+	return job, records, nil
+}
+
+// run checks the whole file before saving any user, as a user may follow
+// users further down the file, and then saves either every user or, if any
+// row failed, none of them.
+func (uc *ImportUsersUseCase) run(ctx context.Context, job *domain.ImportJob, records []domain.UserRecord) {
+	job.Start(uc.now())
+	if err := uc.importJobRepo.Save(ctx, job); err != nil {
+		uc.fail(ctx, job, err)
+		return
+	}
+
+	plan := newImportPlan()
+	users := make([]*domain.User, len(records))
+	rows := make([]domain.ImportRow, len(records))
+	for i, record := range records {
+		users[i], rows[i] = uc.prepare(ctx, job, record, plan)
+	}
+
+	saved := 0
+	for i, record := range records {
+		if users[i] != nil {
+			rows[i] = uc.link(ctx, job, users[i], record, plan)
+		}
+		job.Record(rows[i])
+		if i+1-saved == importProgressInterval {
+			if err := uc.saveProgress(ctx, job, rows[saved:i+1]); err != nil {
+				uc.fail(ctx, job, err)
+				return
+			}
+			saved = i + 1
+		}
+	}
+	job.Created = len(plan.created)
+	if err := uc.saveProgress(ctx, job, rows[saved:]); err != nil {
+		uc.fail(ctx, job, err)
+		return
+	}
+
+	if err := uc.commit(ctx, job, plan); err != nil {
+		uc.fail(ctx, job, err)
+		return
+	}
+	job.Complete(uc.now())
+	if err := uc.importJobRepo.Save(ctx, job); err != nil {
+		uc.logger.ErrorContext(ctx, "saving import job failed", "job_id", job.ID, "error", err)
+	}
+}
+
+// importPlan holds what an import is going to save.
+type importPlan struct {
+	// imported holds the IDs of the users in the file, and of those created
+	// for it, and usernames the ID of each of their usernames.
+	imported  map[string]bool
+	usernames map[string]string
+	// found caches whether users outside the file exist.
+	found   map[string]bool
+	users   []*domain.User
+	created []*domain.User
+}
+
+func newImportPlan() *importPlan {
+	return &importPlan{
+		imported:  make(map[string]bool),
+		usernames: make(map[string]string),
+		found:     make(map[string]bool),
+	}
+}
+
+// prepare checks a record on its own and returns the user it imports, or
+// no user and the row when the record is not imported.
+func (uc *ImportUsersUseCase) prepare(ctx context.Context, job *domain.ImportJob, record domain.UserRecord, plan *importPlan) (*domain.User, domain.ImportRow) {
+	row := domain.ImportRow{JobID: job.ID, Line: record.Line, Status: domain.ImportRowFailed}
+	switch {
+	case record.Err != nil:
+		row.Reason = record.Err.Error()
+		return nil, row
+	case record.Username == "":
+		row.Reason = "username is empty"
+		return nil, row
+	case plan.imported[record.ID]:
+		row.Reason = fmt.Sprintf("user %s was already imported earlier in the file", record.ID)
+		return nil, row
+	}
+
+	existing, err := uc.userRepo.FindByID(ctx, record.ID)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		row.Reason = err.Error()
+		return nil, row
+	}
+	if existing != nil && job.Options.Merge == domain.ImportSkipExisting {
+		plan.imported[existing.ID] = true
+		plan.usernames[existing.Username] = existing.ID
+		row.Status, row.UserID, row.Reason = domain.ImportRowSkipped, existing.ID, "user already exists"
+		return nil, row
+	}
+	if err := uc.checkUsername(ctx, record.ID, record.Username, plan); err != nil {
+		row.Reason = err.Error()
+		return nil, row
+	}
+	plan.imported[record.ID] = true
+	plan.usernames[record.Username] = record.ID
+	return mergeUser(existing, record, job.Options.Merge), row
+}
+
+// mergeUser returns the user a record imports, given the user with its ID
+// that already exists, if any. The follows of the record are left to link.
+func mergeUser(existing *domain.User, record domain.UserRecord, strategy domain.ImportMergeStrategy) *domain.User {
+	if existing == nil {
+		user := domain.NewUser(record.ID, record.Username)
+		user.DisplayName = record.DisplayName
+		return user
+	}
+	user := existing.Clone()
+	user.Username = record.Username
+	if strategy == domain.ImportMergeReplace {
+		user.DisplayName = record.DisplayName
+		user.Following = make(map[string]bool)
+		return user
+	}
+	if record.DisplayName != "" {
+		user.DisplayName = record.DisplayName
+	}
+	if user.Following == nil {
+		user.Following = make(map[string]bool)
+	}
+	return user
+}
+
+// link makes user follow the users its record follows, which must exist or
+// be imported as well.
+func (uc *ImportUsersUseCase) link(ctx context.Context, job *domain.ImportJob, user *domain.User, record domain.UserRecord, plan *importPlan) domain.ImportRow {
+	row := domain.ImportRow{JobID: job.ID, Line: record.Line, Status: domain.ImportRowFailed}
+	for i, following := range record.Following {
+		if following == "" {
+			row.Reason = fmt.Sprintf("followed user %d is empty", i+1)
+			return row
+		}
+		if err := user.Follow(following); errors.Is(err, domain.ErrAlreadyFollowing) {
+			continue
+		} else if err != nil {
+			row.Reason = fmt.Sprintf("cannot follow %s: %v", following, err)
+			return row
+		}
+		if err := uc.resolve(ctx, job, following, plan); err != nil {
+			row.Reason = err.Error()
+			return row
+		}
+	}
+	plan.users = append(plan.users, user)
+	row.Status, row.UserID = domain.ImportRowAccepted, user.ID
+	return row
+}
+
+// resolve checks that a followed user exists or is imported, or creates it
+// when the import creates dangling followees.
+func (uc *ImportUsersUseCase) resolve(ctx context.Context, job *domain.ImportJob, userID string, plan *importPlan) error {
+	if plan.imported[userID] {
+		return nil
+	}
+	found, cached := plan.found[userID]
+	if !cached {
+		_, err := uc.userRepo.FindByID(ctx, userID)
+		if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+			return err
+		}
+		found = err == nil
+		plan.found[userID] = found
+	}
+	if found {
+		return nil
+	}
+
+	if job.Options.Dangling != domain.ImportDanglingCreate {
+		return fmt.Errorf("followed user %s does not exist", userID)
+	}
+	if err := uc.checkUsername(ctx, userID, userID, plan); err != nil {
+		return fmt.Errorf("cannot create followed user %s: %w", userID, err)
+	}
+	plan.imported[userID] = true
+	plan.usernames[userID] = userID
+	plan.created = append(plan.created, domain.NewUser(userID, userID))
+	return nil
+}
+
+// checkUsername fails if username belongs to a user other than userID, either
+// saved or earlier in the file.
+func (uc *ImportUsersUseCase) checkUsername(ctx context.Context, userID, username string, plan *importPlan) error {
+	if owner, ok := plan.usernames[username]; ok && owner != userID {
+		return fmt.Errorf("username %s is already taken by user %s", username, owner)
+	}
+	owner, err := uc.userRepo.FindByName(ctx, username)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if owner.ID != userID {
+		return fmt.Errorf("username %s is already taken by user %s", username, owner.ID)
+	}
+	return nil
+}
+
+// commit saves the users of an import all at once, unless a row failed.
+func (uc *ImportUsersUseCase) commit(ctx context.Context, job *domain.ImportJob, plan *importPlan) error {
+	if job.Options.DryRun {
+		return nil
+	}
+	if job.Failed > 0 {
+		return fmt.Errorf("%d of %d rows failed, so no user was imported", job.Failed, job.Processed)
+	}
+	return uc.userRepo.SaveAll(ctx, append(plan.users, plan.created...))
+}
+
+func (uc *ImportUsersUseCase) saveProgress(ctx context.Context, job *domain.ImportJob, rows []domain.ImportRow) error {
+	if len(rows) > 0 {
+		if err := uc.importJobRepo.SaveRows(ctx, rows); err != nil {
+			return err
+		}
+	}
+	job.UpdatedAt = uc.now()
+	return uc.importJobRepo.Save(ctx, job)
+}
+
+func (uc *ImportUsersUseCase) fail(ctx context.Context, job *domain.ImportJob, err error) {
+	uc.logger.ErrorContext(ctx, "importing users failed", "job_id", job.ID, "error", err)
+	job.Fail(err.Error(), uc.now())
+	if err := uc.importJobRepo.Save(ctx, job); err != nil {
+		uc.logger.ErrorContext(ctx, "saving import job failed", "job_id", job.ID, "error", err)
+	}
+}
+
+// sizeLimitReader reads up to n bytes from r and fails with
+// domain.ErrImportTooLarge if r holds more.
+type sizeLimitReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, domain.ErrImportTooLarge
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, domain.ErrImportTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,335 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportUsers(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	// setup returns a use case that records the last state of its job and
+	// the report of its rows.
+	setup := func(t *testing.T) (*ImportUsersUseCase, *mocks.MockImportJobRepository, *mocks.MockUserRepository, *domain.ImportJob, *[]domain.ImportRow) {
+		ctrl := gomock.NewController(t)
+		importJobRepo := mocks.NewMockImportJobRepository(ctrl)
+		userRepo := mocks.NewMockUserRepository(ctrl)
+		importUsersUseCase := NewImportUsersUseCase(importJobRepo, userRepo, slog.New(slog.DiscardHandler))
+		importUsersUseCase.now = func() time.Time { return now }
+
+		var job domain.ImportJob
+		var rows []domain.ImportRow
+		importJobRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Do(func(_ context.Context, j *domain.ImportJob) { job = *j }).Return(nil).AnyTimes()
+		importJobRepo.EXPECT().SaveRows(gomock.Any(), gomock.Any()).Do(func(_ context.Context, r []domain.ImportRow) { rows = append(rows, r...) }).Return(nil).AnyTimes()
+		return importUsersUseCase, importJobRepo, userRepo, &job, &rows
+	}
+
+	// existing makes userRepo find the given users, and only them.
+	existing := func(userRepo *mocks.MockUserRepository, users ...*domain.User) {
+		userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*domain.User, error) {
+			for _, user := range users {
+				if user.ID == id {
+					return user, nil
+				}
+			}
+			return nil, domain.ErrUserNotFound
+		}).AnyTimes()
+		userRepo.EXPECT().FindByName(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, username string) (*domain.User, error) {
+			for _, user := range users {
+				if user.Username == username {
+					return user, nil
+				}
+			}
+			return nil, domain.ErrUserNotFound
+		}).AnyTimes()
+	}
+
+	// saved makes userRepo record the users it saves.
+	saved := func(userRepo *mocks.MockUserRepository) *[]*domain.User {
+		var users []*domain.User
+		userRepo.EXPECT().SaveAll(gomock.Any(), gomock.Any()).Do(func(_ context.Context, u []*domain.User) { users = u }).Return(nil).Times(1)
+		return &users
+	}
+
+	t.Run("reports each row", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, rows := setup(t)
+		existing(userRepo)
+
+		csv := "user1,user2\n\nuser3\n,user1\nuser1,user3\nuser4,\nuser2,\"user1\"\nuser5,\"user1\n"
+		pending, err := importUsersUseCase.Import(context.Background(), strings.NewReader(csv), domain.ImportOptions{Format: domain.ImportFormatCSV})
+		require.NoError(t, err)
+
+		assert.Equal(t, pending.ID, job.ID)
+		assert.Equal(t, domain.ImportJobFailed, job.Status)
+		assert.Equal(t, "4 of 7 rows failed, so no user was imported", job.Error)
+		assert.Equal(t, 7, job.TotalRows)
+		assert.Equal(t, 7, job.Processed)
+		assert.Equal(t, 3, job.Accepted)
+		assert.Equal(t, 0, job.Skipped)
+		assert.Equal(t, 4, job.Failed)
+		assert.Equal(t, now, job.FinishedAt)
+
+		want := []struct {
+			line   int
+			status domain.ImportRowStatus
+			userID string
+			reason string
+		}{
+			{1, domain.ImportRowAccepted, "user1", ""},
+			{3, domain.ImportRowAccepted, "user3", ""},
+			{4, domain.ImportRowFailed, "", "username is empty"},
+			{5, domain.ImportRowFailed, "", "user user1 was already imported earlier in the file"},
+			{6, domain.ImportRowFailed, "", "followed user 1 is empty"},
+			{7, domain.ImportRowAccepted, "user2", ""},
+			{8, domain.ImportRowFailed, "", `extraneous or missing " in quoted-field`},
+		}
+		require.Len(t, *rows, len(want))
+		for i, row := range *rows {
+			assert.Equal(t, job.ID, row.JobID)
+			assert.Equal(t, want[i].line, row.Line)
+			assert.Equal(t, want[i].status, row.Status, "line %d", row.Line)
+			assert.Equal(t, want[i].userID, row.UserID, "line %d", row.Line)
+			assert.Equal(t, want[i].reason, row.Reason, "line %d", row.Line)
+		}
+	})
+
+	t.Run("json with display names", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, _ := setup(t)
+		existing(userRepo, domain.NewUser("2", "bea"))
+		users := saved(userRepo)
+
+		data := `[{"id":"1","username":"ana","display_name":"Ana","following":["2"]}]`
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader(data), domain.ImportOptions{Format: domain.ImportFormatJSON})
+		require.NoError(t, err)
+		assert.Equal(t, domain.ImportJobCompleted, job.Status)
+		assert.Equal(t, 1, job.Accepted)
+		assert.Equal(t, domain.ImportFormatJSON, job.Options.Format)
+		assert.Equal(t, domain.ImportMergeFollows, job.Options.Merge)
+		assert.Equal(t, domain.ImportDanglingReject, job.Options.Dangling)
+
+		require.Len(t, *users, 1)
+		assert.Equal(t, "1", (*users)[0].ID)
+		assert.Equal(t, "Ana", (*users)[0].DisplayName)
+		assert.Equal(t, map[string]bool{"2": true}, (*users)[0].Following)
+	})
+
+	t.Run("existing users", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			merge         domain.ImportMergeStrategy
+			wantStatus    domain.ImportRowStatus
+			wantSaved     bool
+			wantFollowing map[string]bool
+			wantName      string
+		}{
+			{"merge follows", domain.ImportMergeFollows, domain.ImportRowAccepted, true, map[string]bool{"2": true, "3": true}, "Ana"},
+			{"replace", domain.ImportMergeReplace, domain.ImportRowAccepted, true, map[string]bool{"3": true}, ""},
+			{"skip existing", domain.ImportSkipExisting, domain.ImportRowSkipped, false, nil, ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				importUsersUseCase, _, userRepo, job, rows := setup(t)
+				ana := domain.NewUser("1", "ana")
+				ana.DisplayName = "Ana"
+				ana.Following["2"] = true
+				ana.Muted["3"] = true
+				existing(userRepo, ana, domain.NewUser("2", "bea"), domain.NewUser("3", "cid"))
+				users := saved(userRepo)
+
+				data := `[{"id":"1","username":"anita","following":["3","2"]}]`
+				if tt.merge == domain.ImportMergeReplace {
+					data = `[{"id":"1","username":"anita","following":["3"]}]`
+				}
+				_, err := importUsersUseCase.Import(context.Background(), strings.NewReader(data), domain.ImportOptions{Format: domain.ImportFormatJSON, Merge: tt.merge})
+				require.NoError(t, err)
+
+				assert.Equal(t, domain.ImportJobCompleted, job.Status)
+				require.Len(t, *rows, 1)
+				assert.Equal(t, tt.wantStatus, (*rows)[0].Status)
+				assert.Equal(t, "1", (*rows)[0].UserID)
+				assert.Equal(t, map[string]bool{"2": true}, ana.Following, "the saved user is only changed by saving")
+				if !tt.wantSaved {
+					assert.Empty(t, *users)
+					return
+				}
+				require.Len(t, *users, 1)
+				user := (*users)[0]
+				assert.Equal(t, "anita", user.Username)
+				assert.Equal(t, tt.wantName, user.DisplayName)
+				assert.Equal(t, tt.wantFollowing, user.Following)
+				assert.Equal(t, map[string]bool{"3": true}, user.Muted)
+			})
+		}
+	})
+
+	t.Run("dangling followees", func(t *testing.T) {
+		t.Run("rejected", func(t *testing.T) {
+			importUsersUseCase, _, userRepo, job, rows := setup(t)
+			existing(userRepo, domain.NewUser("user3", "user3"))
+
+			_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user3,user2\nuser4,user1\n"), domain.ImportOptions{Format: domain.ImportFormatCSV})
+			require.NoError(t, err)
+
+			assert.Equal(t, domain.ImportJobFailed, job.Status)
+			assert.Equal(t, "followed user user2 does not exist", (*rows)[0].Reason)
+			assert.Equal(t, domain.ImportRowAccepted, (*rows)[1].Status)
+		})
+
+		t.Run("created", func(t *testing.T) {
+			importUsersUseCase, _, userRepo, job, _ := setup(t)
+			existing(userRepo, domain.NewUser("user3", "user3"))
+			users := saved(userRepo)
+
+			_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user3,user2\nuser4,user2\n"), domain.ImportOptions{Format: domain.ImportFormatCSV, Dangling: domain.ImportDanglingCreate})
+			require.NoError(t, err)
+
+			assert.Equal(t, domain.ImportJobCompleted, job.Status)
+			assert.Equal(t, 2, job.Accepted)
+			assert.Equal(t, 1, job.Created)
+			require.Len(t, *users, 3)
+			assert.Equal(t, domain.NewUser("user2", "user2"), (*users)[2])
+		})
+
+		t.Run("created with a username that is taken", func(t *testing.T) {
+			importUsersUseCase, _, userRepo, _, rows := setup(t)
+			existing(userRepo, domain.NewUser("7", "user2"))
+
+			_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user2\n"), domain.ImportOptions{Format: domain.ImportFormatCSV, Dangling: domain.ImportDanglingCreate})
+			require.NoError(t, err)
+
+			assert.Equal(t, "cannot create followed user user2: username user2 is already taken by user 7", (*rows)[0].Reason)
+		})
+	})
+
+	t.Run("self follows are rejected", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, _, rows := setup(t)
+		existing(userRepo)
+
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user1\n"), domain.ImportOptions{Format: domain.ImportFormatCSV})
+		require.NoError(t, err)
+
+		assert.Equal(t, "cannot follow user1: invalid follow action", (*rows)[0].Reason)
+	})
+
+	t.Run("usernames are unique", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, _, rows := setup(t)
+		existing(userRepo, domain.NewUser("7", "ana"))
+
+		data := `[{"id":"1","username":"ana"},{"id":"2","username":"bea"},{"id":"3","username":"bea"}]`
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader(data), domain.ImportOptions{Format: domain.ImportFormatJSON})
+		require.NoError(t, err)
+
+		assert.Equal(t, "username ana is already taken by user 7", (*rows)[0].Reason)
+		assert.Equal(t, domain.ImportRowAccepted, (*rows)[1].Status)
+		assert.Equal(t, "username bea is already taken by user 2", (*rows)[2].Reason)
+	})
+
+	t.Run("file that cannot be read", func(t *testing.T) {
+		importUsersUseCase, _, _, _, _ := setup(t)
+
+		_, err := importUsersUseCase.Execute(context.Background(), strings.NewReader(`{"username":"ana"}`), domain.ImportOptions{Format: domain.ImportFormatJSON})
+		assert.ErrorIs(t, err, domain.ErrInvalidImport)
+	})
+
+	t.Run("wrapped not found errors", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, _ := setup(t)
+		notFound := fmt.Errorf("finding user: %w", domain.ErrUserNotFound)
+		userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, notFound).AnyTimes()
+		userRepo.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, notFound).AnyTimes()
+		users := saved(userRepo)
+
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user2\nuser2,user1\n"), domain.ImportOptions{Format: domain.ImportFormatCSV})
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.ImportJobCompleted, job.Status)
+		assert.Equal(t, 2, job.Accepted)
+		assert.Len(t, *users, 2)
+	})
+
+	t.Run("users that cannot be saved fail the job", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, _ := setup(t)
+		existing(userRepo)
+		userRepo.EXPECT().SaveAll(gomock.Any(), gomock.Len(2)).Return(assert.AnError).Times(1)
+
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user2\nuser2,user1\n"), domain.ImportOptions{Format: domain.ImportFormatCSV})
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.ImportJobFailed, job.Status)
+		assert.Equal(t, assert.AnError.Error(), job.Error)
+		assert.Equal(t, 2, job.Accepted)
+	})
+
+	t.Run("dry run saves no users", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, _ := setup(t)
+		existing(userRepo)
+
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader("user1,user2\nuser2,user1\n"), domain.ImportOptions{Format: domain.ImportFormatCSV, DryRun: true})
+		require.NoError(t, err)
+
+		assert.True(t, job.Options.DryRun)
+		assert.Equal(t, 2, job.Accepted)
+	})
+
+	t.Run("progress is saved as the import goes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		importJobRepo := mocks.NewMockImportJobRepository(ctrl)
+		userRepo := mocks.NewMockUserRepository(ctrl)
+		existing(userRepo)
+		importUsersUseCase := NewImportUsersUseCase(importJobRepo, userRepo, slog.New(slog.DiscardHandler))
+		var processed []int
+		importJobRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Do(func(_ context.Context, j *domain.ImportJob) { processed = append(processed, j.Processed) }).Return(nil).AnyTimes()
+		importJobRepo.EXPECT().SaveRows(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+		csv := strings.Repeat("user1,user2\n", 2*importProgressInterval+1)
+		_, err := importUsersUseCase.Import(context.Background(), strings.NewReader(csv), domain.ImportOptions{Format: domain.ImportFormatCSV, DryRun: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{0, 0, importProgressInterval, 2 * importProgressInterval, 2*importProgressInterval + 1, 2*importProgressInterval + 1}, processed)
+	})
+
+	t.Run("runs in the background", func(t *testing.T) {
+		importUsersUseCase, _, userRepo, job, _ := setup(t)
+		existing(userRepo)
+		var run func()
+		importUsersUseCase.start = func(f func()) { run = f }
+
+		pending, err := importUsersUseCase.Execute(context.Background(), strings.NewReader("user1,user2\n"), domain.ImportOptions{Format: domain.ImportFormatCSV, DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, domain.ImportJobPending, pending.Status)
+		assert.Equal(t, domain.ImportJobPending, job.Status)
+
+		run()
+		assert.Equal(t, domain.ImportJobPending, pending.Status)
+		assert.Equal(t, domain.ImportJobCompleted, job.Status)
+	})
+
+	t.Run("import too large", func(t *testing.T) {
+		importUsersUseCase, _, _, _, _ := setup(t)
+		data := bytes.Repeat([]byte("user1,user2\n"), MaxUserImportSize/12+1)
+
+		_, err := importUsersUseCase.Execute(context.Background(), bytes.NewReader(data), domain.ImportOptions{Format: domain.ImportFormatCSV})
+		assert.ErrorIs(t, err, domain.ErrImportTooLarge)
+	})
+}
+
+func TestSizeLimitReader(t *testing.T) {
+	data, err := io.ReadAll(&sizeLimitReader{r: strings.NewReader("user1,user2"), n: 11})
+	assert.NoError(t, err)
+	assert.Equal(t, "user1,user2", string(data))
+
+	_, err = io.ReadAll(&sizeLimitReader{r: strings.NewReader("user1,user2"), n: 10})
+	assert.ErrorIs(t, err, domain.ErrImportTooLarge)
+}
@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+)
+
+//go:generate mockgen -destination=./mocks/mock_index_tweets.go -package=mocks github.com/pedro00627/urblog/application IndexTweets
+type IndexTweets interface {
+	Execute(ctx context.Context, event domain.Event) error
+}
+
+type IndexTweetsUseCase struct {
+	index infrastructure.SearchIndex
+}
+
+func NewIndexTweetsUseCase(index infrastructure.SearchIndex) IndexTweets {
+	return &IndexTweetsUseCase{
+		index: index,
+	}
+}
+
+// Execute adds newly created tweets to the search index, and removes those
+// of deleted users.
+func (uc *IndexTweetsUseCase) Execute(ctx context.Context, event domain.Event) error {
+	switch e := event.(type) {
+	case domain.TweetCreated:
+		return uc.index.Index(ctx, e.Tweet)
+	case domain.UserDeleted:
+		return uc.index.RemoveByUserID(ctx, e.UserID)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexTweetsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockSearchIndex(ctrl)
+
+	useCase := NewIndexTweetsUseCase(index)
+
+	tweet := &domain.Tweet{ID: "tweet1", UserID: "user1", Content: "Hola mundo"}
+	index.EXPECT().Index(gomock.Any(), tweet).Return(nil).Times(1)
+	index.EXPECT().RemoveByUserID(gomock.Any(), "user2").Return(nil).Times(1)
+
+	assert.NoError(t, useCase.Execute(context.Background(), domain.TweetCreated{Tweet: tweet}))
+	assert.NoError(t, useCase.Execute(context.Background(), domain.UserFollowed{FollowerID: "user1", FolloweeID: "user2"}))
+	assert.NoError(t, useCase.Execute(context.Background(), domain.UserDeleted{UserID: "user2"}))
+}
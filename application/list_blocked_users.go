@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_list_blocked_users.go -package=mocks github.com/pedro00627/urblog/application ListBlockedUsers
+type ListBlockedUsers interface {
+	Execute(ctx context.Context, userID string) ([]string, error)
+}
+
+type ListBlockedUsersUseCase struct {
+	userRepo db.UserRepository
+}
+
+func NewListBlockedUsersUseCase(userRepo db.UserRepository) ListBlockedUsers {
+	return &ListBlockedUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the IDs of the users userID has blocked, sorted.
+func (uc *ListBlockedUsersUseCase) Execute(ctx context.Context, userID string) ([]string, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return sortedKeys(user.Blocked), nil
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key, ok := range set {
+		if ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_list_muted_users.go -package=mocks github.com/pedro00627/urblog/application ListMutedUsers
+type ListMutedUsers interface {
+	Execute(ctx context.Context, userID string) ([]string, error)
+}
+
+type ListMutedUsersUseCase struct {
+	userRepo db.UserRepository
+}
+
+func NewListMutedUsersUseCase(userRepo db.UserRepository) ListMutedUsers {
+	return &ListMutedUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the IDs of the users userID has muted, sorted.
+func (uc *ListMutedUsersUseCase) Execute(ctx context.Context, userID string) ([]string, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return sortedKeys(user.Muted), nil
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMutedUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	listMutedUsersUseCase := NewListMutedUsersUseCase(userRepo)
+
+	t.Run("sorted", func(t *testing.T) {
+		user := domain.NewUser("user1", "user1")
+		user.Muted["user3"] = true
+		user.Muted["user2"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+
+		userIDs, err := listMutedUsersUseCase.Execute(context.Background(), "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"user2", "user3"}, userIDs)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(&domain.User{ID: "user1"}, nil).Times(1)
+
+		userIDs, err := listMutedUsersUseCase.Execute(context.Background(), "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, userIDs)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := listMutedUsersUseCase.Execute(context.Background(), "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_live_updates.go -package=mocks github.com/pedro00627/urblog/application LiveUpdates
+type LiveUpdates interface {
+	Authenticate(ctx context.Context, userID string) error
+	SubscribeUserTweets(ctx context.Context, viewerID, userID string) (*LiveSubscription, error)
+	SubscribeNotifications(ctx context.Context, userID string) (*LiveSubscription, error)
+}
+
+// LiveMessage carries either a tweet or a notification to a live subscriber.
+type LiveMessage struct {
+	Tweet        *domain.Tweet
+	Notification *domain.NotificationTarget
+}
+
+// LiveSubscription receives the messages of one topic. Messages is closed when
+// the subscription ends, either because Close was called or because the
+// subscriber fell too far behind.
+type LiveSubscription struct {
+	Messages <-chan LiveMessage
+
+	hub       *LiveHub
+	topic     string
+	messages  chan LiveMessage
+	closeOnce sync.Once
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (s *LiveSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// LiveHub fans domain events out to subscribers of a user's tweets or of a
+// user's notifications, dropping subscribers whose buffer fills up.
+type LiveHub struct {
+	userRepo   db.UserRepository
+	bufferSize int
+
+	mu     sync.Mutex
+	topics map[string]map[*LiveSubscription]struct{}
+}
+
+func NewLiveHub(userRepo db.UserRepository, bufferSize int) *LiveHub {
+	return &LiveHub{
+		userRepo:   userRepo,
+		bufferSize: bufferSize,
+		topics:     make(map[string]map[*LiveSubscription]struct{}),
+	}
+}
+
+// Authenticate checks that userID identifies an existing user.
+func (h *LiveHub) Authenticate(ctx context.Context, userID string) error {
+	_, err := h.userRepo.FindByID(ctx, userID)
+	return err
+}
+
+// SubscribeUserTweets follows userID's new tweets on behalf of viewerID, as
+// long as viewerID may read them.
+func (h *LiveHub) SubscribeUserTweets(ctx context.Context, viewerID, userID string) (*LiveSubscription, error) {
+	author, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanSeeTweets(ctx, h.userRepo, viewerID, author); err != nil {
+		return nil, err
+	}
+	return h.subscribe("tweets:" + userID), nil
+}
+
+func (h *LiveHub) SubscribeNotifications(ctx context.Context, userID string) (*LiveSubscription, error) {
+	if _, err := h.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return h.subscribe("notifications:" + userID), nil
+}
+
+// Handle feeds domain events into the hub.
+func (h *LiveHub) Handle(_ context.Context, event domain.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch e := event.(type) {
+	case domain.TweetCreated:
+		h.publish("tweets:"+e.Tweet.UserID, LiveMessage{Tweet: e.Tweet})
+	case domain.UserDeleted:
+		for _, topic := range []string{"tweets:" + e.UserID, "notifications:" + e.UserID} {
+			for sub := range h.topics[topic] {
+				h.remove(sub)
+			}
+		}
+	}
+	for _, target := range domain.NotificationTargetsFor(event) {
+		h.publish("notifications:"+target.UserID, LiveMessage{Notification: &target})
+	}
+}
+
+func (h *LiveHub) subscribe(topic string) *LiveSubscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	messages := make(chan LiveMessage, h.bufferSize)
+	sub := &LiveSubscription{
+		Messages: messages,
+		hub:      h,
+		topic:    topic,
+		messages: messages,
+	}
+	if h.topics[topic] == nil {
+		h.topics[topic] = make(map[*LiveSubscription]struct{})
+	}
+	h.topics[topic][sub] = struct{}{}
+	return sub
+}
+
+func (h *LiveHub) publish(topic string, message LiveMessage) {
+	for sub := range h.topics[topic] {
+		select {
+		case sub.messages <- message:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+func (h *LiveHub) unsubscribe(sub *LiveSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+func (h *LiveHub) remove(sub *LiveSubscription) {
+	if subs, ok := h.topics[sub.topic]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(h.topics, sub.topic)
+		}
+	}
+	sub.closeOnce.Do(func() {
+		close(sub.messages)
+	})
+}
@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveHub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	t.Run("delivers tweets of the subscribed user", func(t *testing.T) {
+		hub := NewLiveHub(userRepo, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		sub, err := hub.SubscribeUserTweets(context.Background(), "", "user2")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet1", UserID: "user2"}})
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet2", UserID: "user3"}})
+
+		message := <-sub.Messages
+		assert.Equal(t, "tweet1", message.Tweet.ID)
+		assert.Len(t, sub.Messages, 0)
+	})
+
+	t.Run("delivers notifications of the subscribed user", func(t *testing.T) {
+		hub := NewLiveHub(userRepo, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		sub, err := hub.SubscribeNotifications(context.Background(), "user2")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		hub.Handle(context.Background(), domain.UserFollowed{FollowerID: "user1", FolloweeID: "user2"})
+
+		message := <-sub.Messages
+		assert.Equal(t, &domain.NotificationTarget{UserID: "user2", Type: domain.NotificationFollowed, ActorID: "user1"}, message.Notification)
+	})
+
+	t.Run("slow subscribers are dropped", func(t *testing.T) {
+		hub := NewLiveHub(userRepo, 1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		sub, err := hub.SubscribeUserTweets(context.Background(), "", "user2")
+		assert.NoError(t, err)
+
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet1", UserID: "user2"}})
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet2", UserID: "user2"}})
+
+		_, ok := <-sub.Messages
+		assert.True(t, ok)
+		_, ok = <-sub.Messages
+		assert.False(t, ok)
+		sub.Close()
+	})
+
+	t.Run("protected tweets need an approved follower", func(t *testing.T) {
+		hub := NewLiveHub(userRepo, 10)
+		author := domain.NewUser("user2", "user2")
+		author.Protected = true
+		follower := domain.NewUser("user1", "user1")
+		follower.Following["user2"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(3)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user3").Return(domain.NewUser("user3", "user3"), nil).Times(1)
+
+		_, err := hub.SubscribeUserTweets(context.Background(), "", "user2")
+		assert.Equal(t, domain.ErrProtectedAccount, err)
+		_, err = hub.SubscribeUserTweets(context.Background(), "user3", "user2")
+		assert.Equal(t, domain.ErrProtectedAccount, err)
+		sub, err := hub.SubscribeUserTweets(context.Background(), "user1", "user2")
+		assert.NoError(t, err)
+		sub.Close()
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		hub := NewLiveHub(userRepo, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(3)
+
+		_, err := hub.SubscribeUserTweets(context.Background(), "", "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+		_, err = hub.SubscribeNotifications(context.Background(), "ghost")
+		assert.Equal(t, domain.ErrUserNotFound, err)
+		assert.Equal(t, domain.ErrUserNotFound, hub.Authenticate(context.Background(), "ghost"))
+	})
+}
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_mark_notifications_read.go -package=mocks github.com/pedro00627/urblog/application MarkNotificationsRead
+type MarkNotificationsRead interface {
+	Execute(ctx context.Context, userID string, ids []string) error
+}
+
+type MarkNotificationsReadUseCase struct {
+	notificationRepo db.NotificationRepository
+	userRepo         db.UserRepository
+}
+
+func NewMarkNotificationsReadUseCase(notificationRepo db.NotificationRepository, userRepo db.UserRepository) MarkNotificationsRead {
+	return &MarkNotificationsReadUseCase{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// Execute marks the given notifications as read, or all of the user's
+// notifications when ids is empty.
+func (uc *MarkNotificationsReadUseCase) Execute(ctx context.Context, userID string, ids []string) error {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return err
+	}
+	return uc.notificationRepo.MarkRead(ctx, userID, ids)
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkNotificationsReadUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mocks.NewMockNotificationRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	useCase := NewMarkNotificationsReadUseCase(notificationRepo, userRepo)
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		notificationRepo.EXPECT().MarkRead(gomock.Any(), "user1", []string{"n1", "n2"}).Return(nil).Times(1)
+
+		err := useCase.Execute(context.Background(), "user1", []string{"n1", "n2"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		err := useCase.Execute(context.Background(), "user1", nil)
+
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
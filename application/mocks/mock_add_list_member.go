@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: AddListMember)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddListMember is a mock of AddListMember interface.
+type MockAddListMember struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddListMemberMockRecorder
+}
+
+// MockAddListMemberMockRecorder is the mock recorder for MockAddListMember.
+type MockAddListMemberMockRecorder struct {
+	mock *MockAddListMember
+}
+
+// NewMockAddListMember creates a new mock instance.
+func NewMockAddListMember(ctrl *gomock.Controller) *MockAddListMember {
+	mock := &MockAddListMember{ctrl: ctrl}
+	mock.recorder = &MockAddListMemberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddListMember) EXPECT() *MockAddListMemberMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAddListMember) Execute(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAddListMemberMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddListMember)(nil).Execute), arg0, arg1, arg2, arg3)
+}
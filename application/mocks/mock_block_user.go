@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: BlockUser)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlockUser is a mock of BlockUser interface.
+type MockBlockUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockUserMockRecorder
+}
+
+// MockBlockUserMockRecorder is the mock recorder for MockBlockUser.
+type MockBlockUserMockRecorder struct {
+	mock *MockBlockUser
+}
+
+// NewMockBlockUser creates a new mock instance.
+func NewMockBlockUser(ctrl *gomock.Controller) *MockBlockUser {
+	mock := &MockBlockUser{ctrl: ctrl}
+	mock.recorder = &MockBlockUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockUser) EXPECT() *MockBlockUserMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBlockUser) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBlockUserMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBlockUser)(nil).Execute), arg0, arg1, arg2)
+}
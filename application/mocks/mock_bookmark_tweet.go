@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: BookmarkTweet)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookmarkTweet is a mock of BookmarkTweet interface.
+type MockBookmarkTweet struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkTweetMockRecorder
+}
+
+// MockBookmarkTweetMockRecorder is the mock recorder for MockBookmarkTweet.
+type MockBookmarkTweetMockRecorder struct {
+	mock *MockBookmarkTweet
+}
+
+// NewMockBookmarkTweet creates a new mock instance.
+func NewMockBookmarkTweet(ctrl *gomock.Controller) *MockBookmarkTweet {
+	mock := &MockBookmarkTweet{ctrl: ctrl}
+	mock.recorder = &MockBookmarkTweetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkTweet) EXPECT() *MockBookmarkTweetMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBookmarkTweet) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBookmarkTweetMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBookmarkTweet)(nil).Execute), arg0, arg1, arg2)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: BuildNotifications)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockBuildNotifications is a mock of BuildNotifications interface.
+type MockBuildNotifications struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuildNotificationsMockRecorder
+}
+
+// MockBuildNotificationsMockRecorder is the mock recorder for MockBuildNotifications.
+type MockBuildNotificationsMockRecorder struct {
+	mock *MockBuildNotifications
+}
+
+// NewMockBuildNotifications creates a new mock instance.
+func NewMockBuildNotifications(ctrl *gomock.Controller) *MockBuildNotifications {
+	mock := &MockBuildNotifications{ctrl: ctrl}
+	mock.recorder = &MockBuildNotificationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuildNotifications) EXPECT() *MockBuildNotificationsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBuildNotifications) Execute(arg0 context.Context, arg1 domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBuildNotificationsMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBuildNotifications)(nil).Execute), arg0, arg1)
+}
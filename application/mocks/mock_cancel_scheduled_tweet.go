@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: CancelScheduledTweet)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCancelScheduledTweet is a mock of CancelScheduledTweet interface.
+type MockCancelScheduledTweet struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelScheduledTweetMockRecorder
+}
+
+// MockCancelScheduledTweetMockRecorder is the mock recorder for MockCancelScheduledTweet.
+type MockCancelScheduledTweetMockRecorder struct {
+	mock *MockCancelScheduledTweet
+}
+
+// NewMockCancelScheduledTweet creates a new mock instance.
+func NewMockCancelScheduledTweet(ctrl *gomock.Controller) *MockCancelScheduledTweet {
+	mock := &MockCancelScheduledTweet{ctrl: ctrl}
+	mock.recorder = &MockCancelScheduledTweetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelScheduledTweet) EXPECT() *MockCancelScheduledTweetMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCancelScheduledTweet) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCancelScheduledTweetMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCancelScheduledTweet)(nil).Execute), arg0, arg1, arg2)
+}
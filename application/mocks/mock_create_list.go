@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: CreateList)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockCreateList is a mock of CreateList interface.
+type MockCreateList struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateListMockRecorder
+}
+
+// MockCreateListMockRecorder is the mock recorder for MockCreateList.
+type MockCreateListMockRecorder struct {
+	mock *MockCreateList
+}
+
+// NewMockCreateList creates a new mock instance.
+func NewMockCreateList(ctrl *gomock.Controller) *MockCreateList {
+	mock := &MockCreateList{ctrl: ctrl}
+	mock.recorder = &MockCreateListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateList) EXPECT() *MockCreateListMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateList) Execute(arg0 context.Context, arg1, arg2 string, arg3 bool) (*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateListMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateList)(nil).Execute), arg0, arg1, arg2, arg3)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,16 +36,16 @@ func (m *MockCreateTweet) EXPECT() *MockCreateTweetMockRecorder {
 }
 
 // Execute mocks base method.
-func (m *MockCreateTweet) Execute(arg0, arg1 string) (*domain.Tweet, error) {
+func (m *MockCreateTweet) Execute(arg0 context.Context, arg1, arg2 string, arg3 domain.TweetAttachments) (*domain.Tweet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*domain.Tweet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockCreateTweetMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCreateTweetMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateTweet)(nil).Execute), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateTweet)(nil).Execute), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: DeleteList)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteList is a mock of DeleteList interface.
+type MockDeleteList struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteListMockRecorder
+}
+
+// MockDeleteListMockRecorder is the mock recorder for MockDeleteList.
+type MockDeleteListMockRecorder struct {
+	mock *MockDeleteList
+}
+
+// NewMockDeleteList creates a new mock instance.
+func NewMockDeleteList(ctrl *gomock.Controller) *MockDeleteList {
+	mock := &MockDeleteList{ctrl: ctrl}
+	mock.recorder = &MockDeleteListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteList) EXPECT() *MockDeleteListMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteList) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteListMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteList)(nil).Execute), arg0, arg1, arg2)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: DeleteUser)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockDeleteUser is a mock of DeleteUser interface.
+type MockDeleteUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserMockRecorder
+}
+
+// MockDeleteUserMockRecorder is the mock recorder for MockDeleteUser.
+type MockDeleteUserMockRecorder struct {
+	mock *MockDeleteUser
+}
+
+// NewMockDeleteUser creates a new mock instance.
+func NewMockDeleteUser(ctrl *gomock.Controller) *MockDeleteUser {
+	mock := &MockDeleteUser{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUser) EXPECT() *MockDeleteUserMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteUser) Execute(arg0 context.Context, arg1 string) (*domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteUserMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteUser)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ExportAccount)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockExportAccount is a mock of ExportAccount interface.
+type MockExportAccount struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportAccountMockRecorder
+}
+
+// MockExportAccountMockRecorder is the mock recorder for MockExportAccount.
+type MockExportAccountMockRecorder struct {
+	mock *MockExportAccount
+}
+
+// NewMockExportAccount creates a new mock instance.
+func NewMockExportAccount(ctrl *gomock.Controller) *MockExportAccount {
+	mock := &MockExportAccount{ctrl: ctrl}
+	mock.recorder = &MockExportAccountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportAccount) EXPECT() *MockExportAccountMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockExportAccount) Execute(arg0 context.Context, arg1 string) (*domain.AccountExport, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AccountExport)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockExportAccountMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExportAccount)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ExportUsers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockExportUsers is a mock of ExportUsers interface.
+type MockExportUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportUsersMockRecorder
+}
+
+// MockExportUsersMockRecorder is the mock recorder for MockExportUsers.
+type MockExportUsersMockRecorder struct {
+	mock *MockExportUsers
+}
+
+// NewMockExportUsers creates a new mock instance.
+func NewMockExportUsers(ctrl *gomock.Controller) *MockExportUsers {
+	mock := &MockExportUsers{ctrl: ctrl}
+	mock.recorder = &MockExportUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUsers) EXPECT() *MockExportUsersMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockExportUsers) Execute(arg0 context.Context, arg1 io.Writer, arg2 domain.ImportFormat) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockExportUsersMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExportUsers)(nil).Execute), arg0, arg1, arg2)
+}
@@ -5,9 +5,11 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
 )
 
 // MockFollowUser is a mock of FollowUser interface.
@@ -34,15 +36,16 @@ func (m *MockFollowUser) EXPECT() *MockFollowUserMockRecorder {
 }
 
 // Execute mocks base method.
-func (m *MockFollowUser) Execute(arg0, arg1 string) error {
+func (m *MockFollowUser) Execute(arg0 context.Context, arg1, arg2 string) (*domain.FollowRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockFollowUserMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockFollowUserMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFollowUser)(nil).Execute), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFollowUser)(nil).Execute), arg0, arg1, arg2)
 }
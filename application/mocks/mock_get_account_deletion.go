@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetAccountDeletion)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetAccountDeletion is a mock of GetAccountDeletion interface.
+type MockGetAccountDeletion struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountDeletionMockRecorder
+}
+
+// MockGetAccountDeletionMockRecorder is the mock recorder for MockGetAccountDeletion.
+type MockGetAccountDeletionMockRecorder struct {
+	mock *MockGetAccountDeletion
+}
+
+// NewMockGetAccountDeletion creates a new mock instance.
+func NewMockGetAccountDeletion(ctrl *gomock.Controller) *MockGetAccountDeletion {
+	mock := &MockGetAccountDeletion{ctrl: ctrl}
+	mock.recorder = &MockGetAccountDeletionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountDeletion) EXPECT() *MockGetAccountDeletionMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetAccountDeletion) Execute(arg0 context.Context, arg1 string) (*domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetAccountDeletionMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetAccountDeletion)(nil).Execute), arg0, arg1)
+}
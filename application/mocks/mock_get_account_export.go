@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetAccountExport)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetAccountExport is a mock of GetAccountExport interface.
+type MockGetAccountExport struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountExportMockRecorder
+}
+
+// MockGetAccountExportMockRecorder is the mock recorder for MockGetAccountExport.
+type MockGetAccountExportMockRecorder struct {
+	mock *MockGetAccountExport
+}
+
+// NewMockGetAccountExport creates a new mock instance.
+func NewMockGetAccountExport(ctrl *gomock.Controller) *MockGetAccountExport {
+	mock := &MockGetAccountExport{ctrl: ctrl}
+	mock.recorder = &MockGetAccountExportMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountExport) EXPECT() *MockGetAccountExportMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetAccountExport) Execute(arg0 context.Context, arg1 string, arg2 bool) (*domain.AccountExport, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.AccountExport)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetAccountExportMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetAccountExport)(nil).Execute), arg0, arg1, arg2)
+}
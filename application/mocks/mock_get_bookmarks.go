@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetBookmarks)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetBookmarks is a mock of GetBookmarks interface.
+type MockGetBookmarks struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBookmarksMockRecorder
+}
+
+// MockGetBookmarksMockRecorder is the mock recorder for MockGetBookmarks.
+type MockGetBookmarksMockRecorder struct {
+	mock *MockGetBookmarks
+}
+
+// NewMockGetBookmarks creates a new mock instance.
+func NewMockGetBookmarks(ctrl *gomock.Controller) *MockGetBookmarks {
+	mock := &MockGetBookmarks{ctrl: ctrl}
+	mock.recorder = &MockGetBookmarksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBookmarks) EXPECT() *MockGetBookmarksMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetBookmarks) Execute(arg0 context.Context, arg1 string, arg2 int, arg3 string) ([]*domain.Tweet, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Tweet)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetBookmarksMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetBookmarks)(nil).Execute), arg0, arg1, arg2, arg3)
+}
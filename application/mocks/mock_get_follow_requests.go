@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetFollowRequests)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetFollowRequests is a mock of GetFollowRequests interface.
+type MockGetFollowRequests struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFollowRequestsMockRecorder
+}
+
+// MockGetFollowRequestsMockRecorder is the mock recorder for MockGetFollowRequests.
+type MockGetFollowRequestsMockRecorder struct {
+	mock *MockGetFollowRequests
+}
+
+// NewMockGetFollowRequests creates a new mock instance.
+func NewMockGetFollowRequests(ctrl *gomock.Controller) *MockGetFollowRequests {
+	mock := &MockGetFollowRequests{ctrl: ctrl}
+	mock.recorder = &MockGetFollowRequestsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFollowRequests) EXPECT() *MockGetFollowRequestsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetFollowRequests) Execute(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetFollowRequestsMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetFollowRequests)(nil).Execute), arg0, arg1, arg2, arg3)
+}
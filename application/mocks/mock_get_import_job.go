@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetImportJob)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetImportJob is a mock of GetImportJob interface.
+type MockGetImportJob struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetImportJobMockRecorder
+}
+
+// MockGetImportJobMockRecorder is the mock recorder for MockGetImportJob.
+type MockGetImportJobMockRecorder struct {
+	mock *MockGetImportJob
+}
+
+// NewMockGetImportJob creates a new mock instance.
+func NewMockGetImportJob(ctrl *gomock.Controller) *MockGetImportJob {
+	mock := &MockGetImportJob{ctrl: ctrl}
+	mock.recorder = &MockGetImportJobMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetImportJob) EXPECT() *MockGetImportJobMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetImportJob) Execute(arg0 context.Context, arg1 string, arg2, arg3 int) (*domain.ImportJob, []domain.ImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.ImportJob)
+	ret1, _ := ret[1].([]domain.ImportRow)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetImportJobMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetImportJob)(nil).Execute), arg0, arg1, arg2, arg3)
+}
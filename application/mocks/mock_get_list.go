@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetList)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetList is a mock of GetList interface.
+type MockGetList struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetListMockRecorder
+}
+
+// MockGetListMockRecorder is the mock recorder for MockGetList.
+type MockGetListMockRecorder struct {
+	mock *MockGetList
+}
+
+// NewMockGetList creates a new mock instance.
+func NewMockGetList(ctrl *gomock.Controller) *MockGetList {
+	mock := &MockGetList{ctrl: ctrl}
+	mock.recorder = &MockGetListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetList) EXPECT() *MockGetListMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetList) Execute(arg0 context.Context, arg1, arg2 string) (*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetListMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetList)(nil).Execute), arg0, arg1, arg2)
+}
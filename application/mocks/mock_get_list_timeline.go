@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetListTimeline)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetListTimeline is a mock of GetListTimeline interface.
+type MockGetListTimeline struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetListTimelineMockRecorder
+}
+
+// MockGetListTimelineMockRecorder is the mock recorder for MockGetListTimeline.
+type MockGetListTimelineMockRecorder struct {
+	mock *MockGetListTimeline
+}
+
+// NewMockGetListTimeline creates a new mock instance.
+func NewMockGetListTimeline(ctrl *gomock.Controller) *MockGetListTimeline {
+	mock := &MockGetListTimeline{ctrl: ctrl}
+	mock.recorder = &MockGetListTimelineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetListTimeline) EXPECT() *MockGetListTimelineMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetListTimeline) Execute(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) ([]*domain.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetListTimelineMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetListTimeline)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetMedia)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetMedia is a mock of GetMedia interface.
+type MockGetMedia struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMediaMockRecorder
+}
+
+// MockGetMediaMockRecorder is the mock recorder for MockGetMedia.
+type MockGetMediaMockRecorder struct {
+	mock *MockGetMedia
+}
+
+// NewMockGetMedia creates a new mock instance.
+func NewMockGetMedia(ctrl *gomock.Controller) *MockGetMedia {
+	mock := &MockGetMedia{ctrl: ctrl}
+	mock.recorder = &MockGetMediaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMedia) EXPECT() *MockGetMediaMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetMedia) Execute(arg0 context.Context, arg1 string, arg2 bool) (*domain.Media, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Media)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetMediaMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetMedia)(nil).Execute), arg0, arg1, arg2)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetNotifications)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetNotifications is a mock of GetNotifications interface.
+type MockGetNotifications struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetNotificationsMockRecorder
+}
+
+// MockGetNotificationsMockRecorder is the mock recorder for MockGetNotifications.
+type MockGetNotificationsMockRecorder struct {
+	mock *MockGetNotifications
+}
+
+// NewMockGetNotifications creates a new mock instance.
+func NewMockGetNotifications(ctrl *gomock.Controller) *MockGetNotifications {
+	mock := &MockGetNotifications{ctrl: ctrl}
+	mock.recorder = &MockGetNotificationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetNotifications) EXPECT() *MockGetNotificationsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetNotifications) Execute(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.Notification, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetNotificationsMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetNotifications)(nil).Execute), arg0, arg1, arg2, arg3)
+}
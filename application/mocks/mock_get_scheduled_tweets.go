@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetScheduledTweets)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetScheduledTweets is a mock of GetScheduledTweets interface.
+type MockGetScheduledTweets struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetScheduledTweetsMockRecorder
+}
+
+// MockGetScheduledTweetsMockRecorder is the mock recorder for MockGetScheduledTweets.
+type MockGetScheduledTweetsMockRecorder struct {
+	mock *MockGetScheduledTweets
+}
+
+// NewMockGetScheduledTweets creates a new mock instance.
+func NewMockGetScheduledTweets(ctrl *gomock.Controller) *MockGetScheduledTweets {
+	mock := &MockGetScheduledTweets{ctrl: ctrl}
+	mock.recorder = &MockGetScheduledTweetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetScheduledTweets) EXPECT() *MockGetScheduledTweetsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetScheduledTweets) Execute(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetScheduledTweetsMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetScheduledTweets)(nil).Execute), arg0, arg1, arg2, arg3)
+}
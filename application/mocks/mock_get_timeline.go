@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,16 +36,16 @@ func (m *MockGetTimeline) EXPECT() *MockGetTimelineMockRecorder {
 }
 
 // Execute mocks base method.
-func (m *MockGetTimeline) Execute(arg0 string, arg1, arg2 int) ([]*domain.Tweet, error) {
+func (m *MockGetTimeline) Execute(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.Tweet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*domain.Tweet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockGetTimelineMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockGetTimelineMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTimeline)(nil).Execute), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTimeline)(nil).Execute), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetTrends)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetTrends is a mock of GetTrends interface.
+type MockGetTrends struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTrendsMockRecorder
+}
+
+// MockGetTrendsMockRecorder is the mock recorder for MockGetTrends.
+type MockGetTrendsMockRecorder struct {
+	mock *MockGetTrends
+}
+
+// NewMockGetTrends creates a new mock instance.
+func NewMockGetTrends(ctrl *gomock.Controller) *MockGetTrends {
+	mock := &MockGetTrends{ctrl: ctrl}
+	mock.recorder = &MockGetTrendsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTrends) EXPECT() *MockGetTrendsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTrends) Execute(arg0 context.Context, arg1 string, arg2 int) ([]domain.Trend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.Trend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTrendsMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTrends)(nil).Execute), arg0, arg1, arg2)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetUserLists)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetUserLists is a mock of GetUserLists interface.
+type MockGetUserLists struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserListsMockRecorder
+}
+
+// MockGetUserListsMockRecorder is the mock recorder for MockGetUserLists.
+type MockGetUserListsMockRecorder struct {
+	mock *MockGetUserLists
+}
+
+// NewMockGetUserLists creates a new mock instance.
+func NewMockGetUserLists(ctrl *gomock.Controller) *MockGetUserLists {
+	mock := &MockGetUserLists{ctrl: ctrl}
+	mock.recorder = &MockGetUserListsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserLists) EXPECT() *MockGetUserListsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserLists) Execute(arg0 context.Context, arg1, arg2 string) ([]*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserListsMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserLists)(nil).Execute), arg0, arg1, arg2)
+}
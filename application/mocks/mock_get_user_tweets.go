@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: GetUserTweets)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockGetUserTweets is a mock of GetUserTweets interface.
+type MockGetUserTweets struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserTweetsMockRecorder
+}
+
+// MockGetUserTweetsMockRecorder is the mock recorder for MockGetUserTweets.
+type MockGetUserTweetsMockRecorder struct {
+	mock *MockGetUserTweets
+}
+
+// NewMockGetUserTweets creates a new mock instance.
+func NewMockGetUserTweets(ctrl *gomock.Controller) *MockGetUserTweets {
+	mock := &MockGetUserTweets{ctrl: ctrl}
+	mock.recorder = &MockGetUserTweetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserTweets) EXPECT() *MockGetUserTweetsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserTweets) Execute(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) ([]*domain.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserTweetsMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserTweets)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
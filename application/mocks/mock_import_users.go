@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ImportUsers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockImportUsers is a mock of ImportUsers interface.
+type MockImportUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportUsersMockRecorder
+}
+
+// MockImportUsersMockRecorder is the mock recorder for MockImportUsers.
+type MockImportUsersMockRecorder struct {
+	mock *MockImportUsers
+}
+
+// NewMockImportUsers creates a new mock instance.
+func NewMockImportUsers(ctrl *gomock.Controller) *MockImportUsers {
+	mock := &MockImportUsers{ctrl: ctrl}
+	mock.recorder = &MockImportUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportUsers) EXPECT() *MockImportUsersMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockImportUsers) Execute(arg0 context.Context, arg1 io.Reader, arg2 domain.ImportOptions) (*domain.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockImportUsersMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockImportUsers)(nil).Execute), arg0, arg1, arg2)
+}
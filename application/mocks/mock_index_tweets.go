@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: IndexTweets)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockIndexTweets is a mock of IndexTweets interface.
+type MockIndexTweets struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexTweetsMockRecorder
+}
+
+// MockIndexTweetsMockRecorder is the mock recorder for MockIndexTweets.
+type MockIndexTweetsMockRecorder struct {
+	mock *MockIndexTweets
+}
+
+// NewMockIndexTweets creates a new mock instance.
+func NewMockIndexTweets(ctrl *gomock.Controller) *MockIndexTweets {
+	mock := &MockIndexTweets{ctrl: ctrl}
+	mock.recorder = &MockIndexTweetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexTweets) EXPECT() *MockIndexTweetsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIndexTweets) Execute(arg0 context.Context, arg1 domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIndexTweetsMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIndexTweets)(nil).Execute), arg0, arg1)
+}
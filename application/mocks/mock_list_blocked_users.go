@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ListBlockedUsers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListBlockedUsers is a mock of ListBlockedUsers interface.
+type MockListBlockedUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBlockedUsersMockRecorder
+}
+
+// MockListBlockedUsersMockRecorder is the mock recorder for MockListBlockedUsers.
+type MockListBlockedUsersMockRecorder struct {
+	mock *MockListBlockedUsers
+}
+
+// NewMockListBlockedUsers creates a new mock instance.
+func NewMockListBlockedUsers(ctrl *gomock.Controller) *MockListBlockedUsers {
+	mock := &MockListBlockedUsers{ctrl: ctrl}
+	mock.recorder = &MockListBlockedUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBlockedUsers) EXPECT() *MockListBlockedUsersMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListBlockedUsers) Execute(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListBlockedUsersMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListBlockedUsers)(nil).Execute), arg0, arg1)
+}
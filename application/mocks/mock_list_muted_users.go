@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ListMutedUsers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListMutedUsers is a mock of ListMutedUsers interface.
+type MockListMutedUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMutedUsersMockRecorder
+}
+
+// MockListMutedUsersMockRecorder is the mock recorder for MockListMutedUsers.
+type MockListMutedUsersMockRecorder struct {
+	mock *MockListMutedUsers
+}
+
+// NewMockListMutedUsers creates a new mock instance.
+func NewMockListMutedUsers(ctrl *gomock.Controller) *MockListMutedUsers {
+	mock := &MockListMutedUsers{ctrl: ctrl}
+	mock.recorder = &MockListMutedUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMutedUsers) EXPECT() *MockListMutedUsersMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListMutedUsers) Execute(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListMutedUsersMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListMutedUsers)(nil).Execute), arg0, arg1)
+}
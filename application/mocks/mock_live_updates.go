@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: LiveUpdates)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	application "github.com/pedro00627/urblog/application"
+)
+
+// MockLiveUpdates is a mock of LiveUpdates interface.
+type MockLiveUpdates struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveUpdatesMockRecorder
+}
+
+// MockLiveUpdatesMockRecorder is the mock recorder for MockLiveUpdates.
+type MockLiveUpdatesMockRecorder struct {
+	mock *MockLiveUpdates
+}
+
+// NewMockLiveUpdates creates a new mock instance.
+func NewMockLiveUpdates(ctrl *gomock.Controller) *MockLiveUpdates {
+	mock := &MockLiveUpdates{ctrl: ctrl}
+	mock.recorder = &MockLiveUpdatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveUpdates) EXPECT() *MockLiveUpdatesMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockLiveUpdates) Authenticate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockLiveUpdatesMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockLiveUpdates)(nil).Authenticate), arg0, arg1)
+}
+
+// SubscribeNotifications mocks base method.
+func (m *MockLiveUpdates) SubscribeNotifications(arg0 context.Context, arg1 string) (*application.LiveSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNotifications", arg0, arg1)
+	ret0, _ := ret[0].(*application.LiveSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNotifications indicates an expected call of SubscribeNotifications.
+func (mr *MockLiveUpdatesMockRecorder) SubscribeNotifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNotifications", reflect.TypeOf((*MockLiveUpdates)(nil).SubscribeNotifications), arg0, arg1)
+}
+
+// SubscribeUserTweets mocks base method.
+func (m *MockLiveUpdates) SubscribeUserTweets(arg0 context.Context, arg1, arg2 string) (*application.LiveSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeUserTweets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*application.LiveSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeUserTweets indicates an expected call of SubscribeUserTweets.
+func (mr *MockLiveUpdatesMockRecorder) SubscribeUserTweets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeUserTweets", reflect.TypeOf((*MockLiveUpdates)(nil).SubscribeUserTweets), arg0, arg1, arg2)
+}
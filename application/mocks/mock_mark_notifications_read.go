@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: MarkNotificationsRead)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMarkNotificationsRead is a mock of MarkNotificationsRead interface.
+type MockMarkNotificationsRead struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarkNotificationsReadMockRecorder
+}
+
+// MockMarkNotificationsReadMockRecorder is the mock recorder for MockMarkNotificationsRead.
+type MockMarkNotificationsReadMockRecorder struct {
+	mock *MockMarkNotificationsRead
+}
+
+// NewMockMarkNotificationsRead creates a new mock instance.
+func NewMockMarkNotificationsRead(ctrl *gomock.Controller) *MockMarkNotificationsRead {
+	mock := &MockMarkNotificationsRead{ctrl: ctrl}
+	mock.recorder = &MockMarkNotificationsReadMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkNotificationsRead) EXPECT() *MockMarkNotificationsReadMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockMarkNotificationsRead) Execute(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockMarkNotificationsReadMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockMarkNotificationsRead)(nil).Execute), arg0, arg1, arg2)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: MuteUser)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMuteUser is a mock of MuteUser interface.
+type MockMuteUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockMuteUserMockRecorder
+}
+
+// MockMuteUserMockRecorder is the mock recorder for MockMuteUser.
+type MockMuteUserMockRecorder struct {
+	mock *MockMuteUser
+}
+
+// NewMockMuteUser creates a new mock instance.
+func NewMockMuteUser(ctrl *gomock.Controller) *MockMuteUser {
+	mock := &MockMuteUser{ctrl: ctrl}
+	mock.recorder = &MockMuteUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMuteUser) EXPECT() *MockMuteUserMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockMuteUser) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockMuteUserMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockMuteUser)(nil).Execute), arg0, arg1, arg2)
+}
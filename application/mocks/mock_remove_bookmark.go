@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: RemoveBookmark)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveBookmark is a mock of RemoveBookmark interface.
+type MockRemoveBookmark struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveBookmarkMockRecorder
+}
+
+// MockRemoveBookmarkMockRecorder is the mock recorder for MockRemoveBookmark.
+type MockRemoveBookmarkMockRecorder struct {
+	mock *MockRemoveBookmark
+}
+
+// NewMockRemoveBookmark creates a new mock instance.
+func NewMockRemoveBookmark(ctrl *gomock.Controller) *MockRemoveBookmark {
+	mock := &MockRemoveBookmark{ctrl: ctrl}
+	mock.recorder = &MockRemoveBookmarkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveBookmark) EXPECT() *MockRemoveBookmarkMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveBookmark) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveBookmarkMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveBookmark)(nil).Execute), arg0, arg1, arg2)
+}
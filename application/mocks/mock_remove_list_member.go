@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: RemoveListMember)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveListMember is a mock of RemoveListMember interface.
+type MockRemoveListMember struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveListMemberMockRecorder
+}
+
+// MockRemoveListMemberMockRecorder is the mock recorder for MockRemoveListMember.
+type MockRemoveListMemberMockRecorder struct {
+	mock *MockRemoveListMember
+}
+
+// NewMockRemoveListMember creates a new mock instance.
+func NewMockRemoveListMember(ctrl *gomock.Controller) *MockRemoveListMember {
+	mock := &MockRemoveListMember{ctrl: ctrl}
+	mock.recorder = &MockRemoveListMemberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveListMember) EXPECT() *MockRemoveListMemberMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveListMember) Execute(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveListMemberMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveListMember)(nil).Execute), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ResolveFollowRequest)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockResolveFollowRequest is a mock of ResolveFollowRequest interface.
+type MockResolveFollowRequest struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolveFollowRequestMockRecorder
+}
+
+// MockResolveFollowRequestMockRecorder is the mock recorder for MockResolveFollowRequest.
+type MockResolveFollowRequestMockRecorder struct {
+	mock *MockResolveFollowRequest
+}
+
+// NewMockResolveFollowRequest creates a new mock instance.
+func NewMockResolveFollowRequest(ctrl *gomock.Controller) *MockResolveFollowRequest {
+	mock := &MockResolveFollowRequest{ctrl: ctrl}
+	mock.recorder = &MockResolveFollowRequestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResolveFollowRequest) EXPECT() *MockResolveFollowRequestMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResolveFollowRequest) Execute(arg0 context.Context, arg1, arg2 string, arg3 domain.FollowRequestStatus) (*domain.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResolveFollowRequestMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResolveFollowRequest)(nil).Execute), arg0, arg1, arg2, arg3)
+}
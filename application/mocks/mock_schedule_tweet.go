@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: ScheduleTweet)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockScheduleTweet is a mock of ScheduleTweet interface.
+type MockScheduleTweet struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleTweetMockRecorder
+}
+
+// MockScheduleTweetMockRecorder is the mock recorder for MockScheduleTweet.
+type MockScheduleTweetMockRecorder struct {
+	mock *MockScheduleTweet
+}
+
+// NewMockScheduleTweet creates a new mock instance.
+func NewMockScheduleTweet(ctrl *gomock.Controller) *MockScheduleTweet {
+	mock := &MockScheduleTweet{ctrl: ctrl}
+	mock.recorder = &MockScheduleTweetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleTweet) EXPECT() *MockScheduleTweetMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockScheduleTweet) Execute(arg0 context.Context, arg1, arg2 string, arg3 domain.TweetAttachments, arg4 time.Time) (*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockScheduleTweetMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockScheduleTweet)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: SearchTweets)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockSearchTweets is a mock of SearchTweets interface.
+type MockSearchTweets struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchTweetsMockRecorder
+}
+
+// MockSearchTweetsMockRecorder is the mock recorder for MockSearchTweets.
+type MockSearchTweetsMockRecorder struct {
+	mock *MockSearchTweets
+}
+
+// NewMockSearchTweets creates a new mock instance.
+func NewMockSearchTweets(ctrl *gomock.Controller) *MockSearchTweets {
+	mock := &MockSearchTweets{ctrl: ctrl}
+	mock.recorder = &MockSearchTweetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchTweets) EXPECT() *MockSearchTweetsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSearchTweets) Execute(arg0 context.Context, arg1 string, arg2 domain.SearchSort, arg3 int, arg4 string) ([]*domain.Tweet, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.Tweet)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSearchTweetsMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchTweets)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: SetAccountPrivacy)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSetAccountPrivacy is a mock of SetAccountPrivacy interface.
+type MockSetAccountPrivacy struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetAccountPrivacyMockRecorder
+}
+
+// MockSetAccountPrivacyMockRecorder is the mock recorder for MockSetAccountPrivacy.
+type MockSetAccountPrivacyMockRecorder struct {
+	mock *MockSetAccountPrivacy
+}
+
+// NewMockSetAccountPrivacy creates a new mock instance.
+func NewMockSetAccountPrivacy(ctrl *gomock.Controller) *MockSetAccountPrivacy {
+	mock := &MockSetAccountPrivacy{ctrl: ctrl}
+	mock.recorder = &MockSetAccountPrivacyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetAccountPrivacy) EXPECT() *MockSetAccountPrivacyMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSetAccountPrivacy) Execute(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSetAccountPrivacyMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetAccountPrivacy)(nil).Execute), arg0, arg1, arg2)
+}
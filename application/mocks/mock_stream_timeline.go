@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: StreamTimeline)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	application "github.com/pedro00627/urblog/application"
+)
+
+// MockStreamTimeline is a mock of StreamTimeline interface.
+type MockStreamTimeline struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamTimelineMockRecorder
+}
+
+// MockStreamTimelineMockRecorder is the mock recorder for MockStreamTimeline.
+type MockStreamTimelineMockRecorder struct {
+	mock *MockStreamTimeline
+}
+
+// NewMockStreamTimeline creates a new mock instance.
+func NewMockStreamTimeline(ctrl *gomock.Controller) *MockStreamTimeline {
+	mock := &MockStreamTimeline{ctrl: ctrl}
+	mock.recorder = &MockStreamTimelineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamTimeline) EXPECT() *MockStreamTimelineMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockStreamTimeline) Subscribe(arg0 context.Context, arg1, arg2 string) (*application.TimelineSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*application.TimelineSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockStreamTimelineMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockStreamTimeline)(nil).Subscribe), arg0, arg1, arg2)
+}
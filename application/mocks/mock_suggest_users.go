@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: SuggestUsers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockSuggestUsers is a mock of SuggestUsers interface.
+type MockSuggestUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestUsersMockRecorder
+}
+
+// MockSuggestUsersMockRecorder is the mock recorder for MockSuggestUsers.
+type MockSuggestUsersMockRecorder struct {
+	mock *MockSuggestUsers
+}
+
+// NewMockSuggestUsers creates a new mock instance.
+func NewMockSuggestUsers(ctrl *gomock.Controller) *MockSuggestUsers {
+	mock := &MockSuggestUsers{ctrl: ctrl}
+	mock.recorder = &MockSuggestUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestUsers) EXPECT() *MockSuggestUsersMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSuggestUsers) Execute(arg0 context.Context, arg1 string, arg2 int) ([]domain.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSuggestUsersMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSuggestUsers)(nil).Execute), arg0, arg1, arg2)
+}
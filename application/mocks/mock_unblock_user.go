@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: UnblockUser)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUnblockUser is a mock of UnblockUser interface.
+type MockUnblockUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnblockUserMockRecorder
+}
+
+// MockUnblockUserMockRecorder is the mock recorder for MockUnblockUser.
+type MockUnblockUserMockRecorder struct {
+	mock *MockUnblockUser
+}
+
+// NewMockUnblockUser creates a new mock instance.
+func NewMockUnblockUser(ctrl *gomock.Controller) *MockUnblockUser {
+	mock := &MockUnblockUser{ctrl: ctrl}
+	mock.recorder = &MockUnblockUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnblockUser) EXPECT() *MockUnblockUserMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnblockUser) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnblockUserMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnblockUser)(nil).Execute), arg0, arg1, arg2)
+}
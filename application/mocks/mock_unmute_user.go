@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: UnmuteUser)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUnmuteUser is a mock of UnmuteUser interface.
+type MockUnmuteUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnmuteUserMockRecorder
+}
+
+// MockUnmuteUserMockRecorder is the mock recorder for MockUnmuteUser.
+type MockUnmuteUserMockRecorder struct {
+	mock *MockUnmuteUser
+}
+
+// NewMockUnmuteUser creates a new mock instance.
+func NewMockUnmuteUser(ctrl *gomock.Controller) *MockUnmuteUser {
+	mock := &MockUnmuteUser{ctrl: ctrl}
+	mock.recorder = &MockUnmuteUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnmuteUser) EXPECT() *MockUnmuteUserMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnmuteUser) Execute(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnmuteUserMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnmuteUser)(nil).Execute), arg0, arg1, arg2)
+}
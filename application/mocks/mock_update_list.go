@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: UpdateList)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockUpdateList is a mock of UpdateList interface.
+type MockUpdateList struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateListMockRecorder
+}
+
+// MockUpdateListMockRecorder is the mock recorder for MockUpdateList.
+type MockUpdateListMockRecorder struct {
+	mock *MockUpdateList
+}
+
+// NewMockUpdateList creates a new mock instance.
+func NewMockUpdateList(ctrl *gomock.Controller) *MockUpdateList {
+	mock := &MockUpdateList{ctrl: ctrl}
+	mock.recorder = &MockUpdateListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateList) EXPECT() *MockUpdateListMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateList) Execute(arg0 context.Context, arg1, arg2 string, arg3 *string, arg4 *bool) (*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateListMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateList)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: UpdateScheduledTweet)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockUpdateScheduledTweet is a mock of UpdateScheduledTweet interface.
+type MockUpdateScheduledTweet struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateScheduledTweetMockRecorder
+}
+
+// MockUpdateScheduledTweetMockRecorder is the mock recorder for MockUpdateScheduledTweet.
+type MockUpdateScheduledTweetMockRecorder struct {
+	mock *MockUpdateScheduledTweet
+}
+
+// NewMockUpdateScheduledTweet creates a new mock instance.
+func NewMockUpdateScheduledTweet(ctrl *gomock.Controller) *MockUpdateScheduledTweet {
+	mock := &MockUpdateScheduledTweet{ctrl: ctrl}
+	mock.recorder = &MockUpdateScheduledTweetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateScheduledTweet) EXPECT() *MockUpdateScheduledTweetMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateScheduledTweet) Execute(arg0 context.Context, arg1, arg2 string, arg3 *string, arg4 *time.Time) (*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateScheduledTweetMockRecorder) Execute(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateScheduledTweet)(nil).Execute), arg0, arg1, arg2, arg3, arg4)
+}
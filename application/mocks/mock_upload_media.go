@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: UploadMedia)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockUploadMedia is a mock of UploadMedia interface.
+type MockUploadMedia struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadMediaMockRecorder
+}
+
+// MockUploadMediaMockRecorder is the mock recorder for MockUploadMedia.
+type MockUploadMediaMockRecorder struct {
+	mock *MockUploadMedia
+}
+
+// NewMockUploadMedia creates a new mock instance.
+func NewMockUploadMedia(ctrl *gomock.Controller) *MockUploadMedia {
+	mock := &MockUploadMedia{ctrl: ctrl}
+	mock.recorder = &MockUploadMediaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadMedia) EXPECT() *MockUploadMediaMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUploadMedia) Execute(arg0 context.Context, arg1 string, arg2 []byte) (*domain.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUploadMediaMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUploadMedia)(nil).Execute), arg0, arg1, arg2)
+}
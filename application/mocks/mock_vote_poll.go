@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/application (interfaces: VotePoll)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockVotePoll is a mock of VotePoll interface.
+type MockVotePoll struct {
+	ctrl     *gomock.Controller
+	recorder *MockVotePollMockRecorder
+}
+
+// MockVotePollMockRecorder is the mock recorder for MockVotePoll.
+type MockVotePollMockRecorder struct {
+	mock *MockVotePoll
+}
+
+// NewMockVotePoll creates a new mock instance.
+func NewMockVotePoll(ctrl *gomock.Controller) *MockVotePoll {
+	mock := &MockVotePoll{ctrl: ctrl}
+	mock.recorder = &MockVotePollMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVotePoll) EXPECT() *MockVotePollMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVotePoll) Execute(arg0 context.Context, arg1, arg2 string, arg3 int) (*domain.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVotePollMockRecorder) Execute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVotePoll)(nil).Execute), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_mute_user.go -package=mocks github.com/pedro00627/urblog/application MuteUser
+type MuteUser interface {
+	Execute(ctx context.Context, userID, targetID string) error
+}
+
+type MuteUserUseCase struct {
+	userRepo db.UserRepository
+	events   infrastructure.EventPublisher
+}
+
+func NewMuteUserUseCase(userRepo db.UserRepository, events infrastructure.EventPublisher) MuteUser {
+	return &MuteUserUseCase{
+		userRepo: userRepo,
+		events:   events,
+	}
+}
+
+func (uc *MuteUserUseCase) Execute(ctx context.Context, userID, targetID string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	target, err := uc.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if err := user.Mute(target.ID); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+	return uc.events.Publish(ctx, domain.UserMuted{MuterID: user.ID, MutedID: target.ID, At: time.Now()})
+}
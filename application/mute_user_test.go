@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
+
+	muteUserUseCase := NewMuteUserUseCase(userRepo, events)
+
+	t.Run("success keeps following", func(t *testing.T) {
+		user := domain.NewUser("user1", "user1")
+		user.Following["user2"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+		userRepo.EXPECT().Save(gomock.Any(), user).Return(nil).Times(1)
+		events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.UserMuted{})).DoAndReturn(func(_ context.Context, event domain.Event) error {
+			assert.Equal(t, "user1", event.(domain.UserMuted).MuterID)
+			assert.Equal(t, "user2", event.(domain.UserMuted).MutedID)
+			return nil
+		}).Times(1)
+
+		assert.NoError(t, muteUserUseCase.Execute(context.Background(), "user1", "user2"))
+		assert.True(t, user.Muted["user2"])
+		assert.True(t, user.Following["user2"])
+	})
+
+	t.Run("already muted", func(t *testing.T) {
+		user := domain.NewUser("user1", "user1")
+		user.Muted["user2"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		assert.Equal(t, domain.ErrAlreadyMuted, muteUserUseCase.Execute(context.Background(), "user1", "user2"))
+	})
+
+	t.Run("target not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		assert.Equal(t, domain.ErrUserNotFound, muteUserUseCase.Execute(context.Background(), "user1", "ghost"))
+	})
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// attachPollResults returns tweets with the results of their polls as
+// viewerID, who may be empty for an anonymous reader, may see them. Tweets
+// without a poll are returned as they are.
+func attachPollResults(ctx context.Context, pollVoteRepo db.PollVoteRepository, viewerID string, tweets []*domain.Tweet, now time.Time) ([]*domain.Tweet, error) {
+	var pollTweetIDs []string
+	for _, tweet := range tweets {
+		if tweet.Poll != nil {
+			pollTweetIDs = append(pollTweetIDs, tweet.ID)
+		}
+	}
+	if len(pollTweetIDs) == 0 {
+		return tweets, nil
+	}
+
+	tally, err := pollVoteRepo.Tally(ctx, pollTweetIDs)
+	if err != nil {
+		return nil, err
+	}
+	votes := map[string]*domain.PollVote{}
+	if viewerID != "" {
+		votes, err = pollVoteRepo.FindByUserID(ctx, viewerID, pollTweetIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	withResults := make([]*domain.Tweet, len(tweets))
+	for i, tweet := range tweets {
+		if tweet.Poll == nil {
+			withResults[i] = tweet
+			continue
+		}
+		viewerVote := -1
+		if vote, ok := votes[tweet.ID]; ok {
+			viewerVote = vote.Option
+		}
+		withResults[i] = tweet.WithPollResults(domain.NewPollResults(tweet.Poll, tally[tweet.ID], viewerVote, now))
+	}
+	return withResults, nil
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachPollResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pollVoteRepo := mocks.NewMockPollVoteRepository(ctrl)
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	open := &domain.Tweet{ID: "open", Poll: &domain.Poll{Options: []string{"yes", "no"}, EndsAt: now.Add(time.Hour)}}
+	closed := &domain.Tweet{ID: "closed", Poll: &domain.Poll{Options: []string{"yes", "no"}, EndsAt: now}}
+	plain := &domain.Tweet{ID: "plain"}
+
+	t.Run("anonymous reader", func(t *testing.T) {
+		pollVoteRepo.EXPECT().Tally(gomock.Any(), []string{"open", "closed"}).Return(map[string]map[int]int{
+			"open":   {0: 1},
+			"closed": {0: 2, 1: 3},
+		}, nil).Times(1)
+
+		tweets, err := attachPollResults(context.Background(), pollVoteRepo, "", []*domain.Tweet{open, plain, closed}, now)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.PollResults{TotalVotes: 1, ViewerVote: -1}, tweets[0].Poll.Results)
+		assert.Same(t, plain, tweets[1])
+		assert.Equal(t, &domain.PollResults{Votes: []int{2, 3}, TotalVotes: 5, ViewerVote: -1, Closed: true}, tweets[2].Poll.Results)
+	})
+
+	t.Run("no polls", func(t *testing.T) {
+		tweets, err := attachPollResults(context.Background(), pollVoteRepo, "user1", []*domain.Tweet{plain}, now)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Tweet{plain}, tweets)
+	})
+}
@@ -0,0 +1,27 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_remove_bookmark.go -package=mocks github.com/pedro00627/urblog/application RemoveBookmark
+type RemoveBookmark interface {
+	Execute(ctx context.Context, userID, tweetID string) error
+}
+
+type RemoveBookmarkUseCase struct {
+	bookmarkRepo db.BookmarkRepository
+}
+
+func NewRemoveBookmarkUseCase(bookmarkRepo db.BookmarkRepository) RemoveBookmark {
+	return &RemoveBookmarkUseCase{
+		bookmarkRepo: bookmarkRepo,
+	}
+}
+
+// Execute removes tweetID from userID's bookmarks, whether or not the tweet
+// still exists.
+func (uc *RemoveBookmarkUseCase) Execute(ctx context.Context, userID, tweetID string) error {
+	return uc.bookmarkRepo.Delete(ctx, userID, tweetID)
+}
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveBookmark(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocks.NewMockBookmarkRepository(ctrl)
+
+	removeBookmarkUseCase := NewRemoveBookmarkUseCase(bookmarkRepo)
+
+	bookmarkRepo.EXPECT().Delete(gomock.Any(), "user1", "tweet1").Return(nil).Times(1)
+	assert.NoError(t, removeBookmarkUseCase.Execute(context.Background(), "user1", "tweet1"))
+
+	bookmarkRepo.EXPECT().Delete(gomock.Any(), "user1", "tweet2").Return(domain.ErrBookmarkNotFound).Times(1)
+	assert.Equal(t, domain.ErrBookmarkNotFound, removeBookmarkUseCase.Execute(context.Background(), "user1", "tweet2"))
+}
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_remove_list_member.go -package=mocks github.com/pedro00627/urblog/application RemoveListMember
+type RemoveListMember interface {
+	Execute(ctx context.Context, userID, listID, memberID string) error
+}
+
+type RemoveListMemberUseCase struct {
+	listRepo db.ListRepository
+}
+
+func NewRemoveListMemberUseCase(listRepo db.ListRepository) RemoveListMember {
+	return &RemoveListMemberUseCase{
+		listRepo: listRepo,
+	}
+}
+
+func (uc *RemoveListMemberUseCase) Execute(ctx context.Context, userID, listID, memberID string) error {
+	list, err := findOwnedList(ctx, uc.listRepo, userID, listID)
+	if err != nil {
+		return err
+	}
+	if err := list.RemoveMember(memberID); err != nil {
+		return err
+	}
+	return uc.listRepo.Save(ctx, list)
+}
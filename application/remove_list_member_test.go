@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveListMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+
+	removeListMemberUseCase := NewRemoveListMemberUseCase(listRepo)
+
+	t.Run("success", func(t *testing.T) {
+		listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false, "user2"), nil).Times(1)
+		listRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, list *domain.List) error {
+			assert.False(t, list.Members["user2"])
+			return nil
+		}).Times(1)
+
+		assert.NoError(t, removeListMemberUseCase.Execute(context.Background(), "user1", "list1", "user2"))
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+
+		assert.Equal(t, domain.ErrNotListMember, removeListMemberUseCase.Execute(context.Background(), "user1", "list1", "user2"))
+	})
+}
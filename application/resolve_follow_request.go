@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_resolve_follow_request.go -package=mocks github.com/pedro00627/urblog/application ResolveFollowRequest
+type ResolveFollowRequest interface {
+	Execute(ctx context.Context, userID, requestID string, status domain.FollowRequestStatus) (*domain.FollowRequest, error)
+}
+
+type ResolveFollowRequestUseCase struct {
+	userRepo          db.UserRepository
+	followRequestRepo db.FollowRequestRepository
+	events            infrastructure.EventPublisher
+}
+
+func NewResolveFollowRequestUseCase(userRepo db.UserRepository, followRequestRepo db.FollowRequestRepository, events infrastructure.EventPublisher) ResolveFollowRequest {
+	return &ResolveFollowRequestUseCase{
+		userRepo:          userRepo,
+		followRequestRepo: followRequestRepo,
+		events:            events,
+	}
+}
+
+// Execute approves, rejects or cancels a pending follow request on behalf of
+// userID. Approving it makes the requester a follower. A copy of the request
+// is resolved, so that the stored one stays pending when approving it fails.
+// The request is saved before the follower; if the follower cannot be saved,
+// the request is put back as it was, so that approving it can be retried.
+func (uc *ResolveFollowRequestUseCase) Execute(ctx context.Context, userID, requestID string, status domain.FollowRequestStatus) (*domain.FollowRequest, error) {
+	stored, err := uc.followRequestRepo.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	request := *stored
+	if err := request.Resolve(userID, status); err != nil {
+		return nil, err
+	}
+
+	var follower *domain.User
+	if status == domain.FollowRequestApproved {
+		follower, err = uc.userRepo.FindByID(ctx, request.FollowerID)
+		if err != nil {
+			return nil, err
+		}
+		followee, err := uc.userRepo.FindByID(ctx, request.FolloweeID)
+		if err != nil {
+			return nil, err
+		}
+		if domain.BlockedBetween(follower, followee) {
+			return nil, domain.ErrUserBlocked
+		}
+		if err := follower.Follow(followee.ID); err != nil && !errors.Is(err, domain.ErrAlreadyFollowing) {
+			return nil, err
+		}
+	}
+
+	if err := uc.followRequestRepo.Save(ctx, &request); err != nil {
+		return nil, err
+	}
+	if status == domain.FollowRequestApproved {
+		if err := uc.userRepo.Save(ctx, follower); err != nil {
+			if restoreErr := uc.followRequestRepo.Save(ctx, stored); restoreErr != nil {
+				return nil, errors.Join(err, restoreErr)
+			}
+			return nil, err
+		}
+		err = uc.events.Publish(ctx, domain.UserFollowed{FollowerID: request.FollowerID, FolloweeID: request.FolloweeID, At: time.Now()})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &request, nil
+}
@@ -0,0 +1,176 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db/in_memory"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveFollowRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	followRequestRepo := mocks.NewMockFollowRequestRepository(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
+
+	resolveFollowRequestUseCase := NewResolveFollowRequestUseCase(userRepo, followRequestRepo, events)
+
+	tests := []struct {
+		name       string
+		userID     string
+		status     domain.FollowRequestStatus
+		setup      func()
+		wantStatus domain.FollowRequestStatus
+		wantErr    error
+	}{
+		{
+			name:   "approve makes the requester a follower",
+			userID: "user2",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				follower := domain.NewUser("user1", "user1")
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				userRepo.EXPECT().Save(gomock.Any(), follower).DoAndReturn(func(_ context.Context, u *domain.User) error {
+					assert.True(t, u.Following["user2"])
+					return nil
+				}).Times(1)
+				followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.UserFollowed{})).DoAndReturn(func(_ context.Context, event domain.Event) error {
+					assert.Equal(t, "user1", event.(domain.UserFollowed).FollowerID)
+					assert.Equal(t, "user2", event.(domain.UserFollowed).FolloweeID)
+					return nil
+				}).Times(1)
+			},
+			wantStatus: domain.FollowRequestApproved,
+		},
+		{
+			name:   "approving does not follow when the request cannot be saved",
+			userID: "user2",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database error")).Times(1)
+			},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name:   "the request is put back when the follower cannot be saved",
+			userID: "user2",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				stored := domain.NewFollowRequest("request1", "user1", "user2")
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(stored, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+				gomock.InOrder(
+					followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *domain.FollowRequest) error {
+						assert.Equal(t, domain.FollowRequestApproved, r.Status)
+						return nil
+					}).Times(1),
+					userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database error")).Times(1),
+					followRequestRepo.EXPECT().Save(gomock.Any(), stored).DoAndReturn(func(_ context.Context, r *domain.FollowRequest) error {
+						assert.Equal(t, domain.FollowRequestRequested, r.Status)
+						return nil
+					}).Times(1),
+				)
+			},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name:   "reject",
+			userID: "user2",
+			status: domain.FollowRequestRejected,
+			setup: func() {
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+				followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantStatus: domain.FollowRequestRejected,
+		},
+		{
+			name:   "cancel",
+			userID: "user1",
+			status: domain.FollowRequestCancelled,
+			setup: func() {
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+				followRequestRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantStatus: domain.FollowRequestCancelled,
+		},
+		{
+			name:   "approve across a block",
+			userID: "user2",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				followee := domain.NewUser("user2", "user2")
+				followee.Blocked["user1"] = true
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(followee, nil).Times(1)
+			},
+			wantErr: domain.ErrUserBlocked,
+		},
+		{
+			name:   "someone else's request",
+			userID: "user3",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(domain.NewFollowRequest("request1", "user1", "user2"), nil).Times(1)
+			},
+			wantErr: domain.ErrFollowRequestNotFound,
+		},
+		{
+			name:   "request not found",
+			userID: "user2",
+			status: domain.FollowRequestApproved,
+			setup: func() {
+				followRequestRepo.EXPECT().FindByID(gomock.Any(), "request1").Return(nil, domain.ErrFollowRequestNotFound).Times(1)
+			},
+			wantErr: domain.ErrFollowRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			request, err := resolveFollowRequestUseCase.Execute(context.Background(), tt.userID, "request1", tt.status)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, request.Status)
+		})
+	}
+}
+
+func TestResolveFollowRequestAcrossABlockStaysPending(t *testing.T) {
+	ctx := context.Background()
+	userRepo := in_memory.NewInMemoryUserRepository()
+	followRequestRepo := in_memory.NewInMemoryFollowRequestRepository()
+	follower := domain.NewUser("user1", "user1")
+	followee := domain.NewUser("user2", "user2")
+	followee.Blocked["user1"] = true
+	assert.NoError(t, userRepo.Save(ctx, follower))
+	assert.NoError(t, userRepo.Save(ctx, followee))
+	assert.NoError(t, followRequestRepo.Save(ctx, domain.NewFollowRequest("request1", "user1", "user2")))
+
+	resolveFollowRequestUseCase := NewResolveFollowRequestUseCase(userRepo, followRequestRepo, nil)
+	_, err := resolveFollowRequestUseCase.Execute(ctx, "user2", "request1", domain.FollowRequestApproved)
+	assert.Equal(t, domain.ErrUserBlocked, err)
+
+	request, err := followRequestRepo.FindByID(ctx, "request1")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.FollowRequestRequested, request.Status)
+	assert.Empty(t, follower.Following)
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_schedule_tweet.go -package=mocks github.com/pedro00627/urblog/application ScheduleTweet
+type ScheduleTweet interface {
+	Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments, publishAt time.Time) (*domain.ScheduledTweet, error)
+}
+
+type ScheduleTweetUseCase struct {
+	scheduledTweetRepo db.ScheduledTweetRepository
+	userRepo           db.UserRepository
+	mediaRepo          db.MediaRepository
+	now                func() time.Time
+}
+
+func NewScheduleTweetUseCase(scheduledTweetRepo db.ScheduledTweetRepository, userRepo db.UserRepository, mediaRepo db.MediaRepository) ScheduleTweet {
+	return &ScheduleTweetUseCase{
+		scheduledTweetRepo: scheduledTweetRepo,
+		userRepo:           userRepo,
+		mediaRepo:          mediaRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute stores a tweet to be published by the TweetScheduler at publishAt.
+// Attached media are checked now, so that mistakes show up before the tweet
+// is due.
+func (uc *ScheduleTweetUseCase) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments, publishAt time.Time) (*domain.ScheduledTweet, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if _, err := findAttachedMedia(ctx, uc.mediaRepo, userID, attachments); err != nil {
+		return nil, err
+	}
+	tweet, err := domain.NewScheduledTweetWithAttachments(generateID(), userID, content, attachments, publishAt, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.scheduledTweetRepo.Save(ctx, tweet); err != nil {
+		return nil, err
+	}
+	return tweet, nil
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleTweet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledTweetRepo := mocks.NewMockScheduledTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	mediaRepo := mocks.NewMockMediaRepository(ctrl)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	scheduleTweetUseCase := NewScheduleTweetUseCase(scheduledTweetRepo, userRepo, mediaRepo).(*ScheduleTweetUseCase)
+	scheduleTweetUseCase.now = func() time.Time { return now }
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		scheduledTweetRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		tweet, err := scheduleTweetUseCase.Execute(context.Background(), "user1", "hello", domain.TweetAttachments{}, now.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tweet.ID)
+		assert.Equal(t, domain.ScheduledTweetPending, tweet.Status)
+		assert.Equal(t, now.Add(time.Hour), tweet.PublishAt)
+	})
+
+	t.Run("publish time in the past", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		_, err := scheduleTweetUseCase.Execute(context.Background(), "user1", "hello", domain.TweetAttachments{}, now.Add(-time.Hour))
+		assert.Equal(t, domain.ErrInvalidPublishTime, err)
+	})
+
+	t.Run("empty content", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		_, err := scheduleTweetUseCase.Execute(context.Background(), "user1", "", domain.TweetAttachments{}, now.Add(time.Hour))
+		assert.True(t, errors.Is(err, domain.ErrInvalidTweetContent))
+	})
+
+	t.Run("with media", func(t *testing.T) {
+		attachments := domain.TweetAttachments{MediaIDs: []string{"media1"}}
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		mediaRepo.EXPECT().FindByID(gomock.Any(), "media1").Return(&domain.Media{ID: "media1", UserID: "user1"}, nil).Times(1)
+		scheduledTweetRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		tweet, err := scheduleTweetUseCase.Execute(context.Background(), "user1", "", attachments, now.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, attachments, tweet.Attachments)
+	})
+
+	t.Run("media not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		mediaRepo.EXPECT().FindByID(gomock.Any(), "media1").Return(nil, domain.ErrMediaNotFound).Times(1)
+
+		_, err := scheduleTweetUseCase.Execute(context.Background(), "user1", "hello", domain.TweetAttachments{MediaIDs: []string{"media1"}}, now.Add(time.Hour))
+		assert.Equal(t, domain.ErrMediaNotFound, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := scheduleTweetUseCase.Execute(context.Background(), "ghost", "hello", domain.TweetAttachments{}, now.Add(time.Hour))
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
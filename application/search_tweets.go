@@ -0,0 +1,104 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_search_tweets.go -package=mocks github.com/pedro00627/urblog/application SearchTweets
+type SearchTweets interface {
+	Execute(ctx context.Context, q string, sort domain.SearchSort, limit int, cursor string) ([]*domain.Tweet, string, error)
+}
+
+type SearchTweetsUseCase struct {
+	index        infrastructure.SearchIndex
+	userRepo     db.UserRepository
+	pollVoteRepo db.PollVoteRepository
+}
+
+func NewSearchTweetsUseCase(index infrastructure.SearchIndex, userRepo db.UserRepository, pollVoteRepo db.PollVoteRepository) SearchTweets {
+	return &SearchTweetsUseCase{
+		index:        index,
+		userRepo:     userRepo,
+		pollVoteRepo: pollVoteRepo,
+	}
+}
+
+// Execute runs the search q and returns a page of tweets along with the cursor
+// of the next page, which is empty when there are no more results.
+func (uc *SearchTweetsUseCase) Execute(ctx context.Context, q string, sort domain.SearchSort, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	query, err := domain.ParseSearchQuery(q)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch sort {
+	case "":
+		query.Sort = domain.SearchByRecency
+	case domain.SearchByRecency, domain.SearchByRelevance:
+		query.Sort = sort
+	default:
+		return nil, "", fmt.Errorf("%w: unknown sort %q", domain.ErrInvalidSearchQuery, sort)
+	}
+	query.Limit = limit
+
+	if cursor != "" {
+		query.After, err = domain.DecodeSearchCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if query.From != "" {
+		user, err := uc.userRepo.FindByName(ctx, query.From)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return []*domain.Tweet{}, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		query.FromUserID = user.ID
+	}
+
+	results, err := uc.index.Search(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Protected tweets and the tweets of deleted users, which cannot be found
+	// from the moment they are deleted, are left out of search. The cursor
+	// still points past them so that pages stay contiguous.
+	visible := make(map[string]bool)
+	tweets := make([]*domain.Tweet, 0, len(results))
+	for _, result := range results {
+		userID := result.Tweet.UserID
+		if _, ok := visible[userID]; !ok {
+			author, err := uc.userRepo.FindByID(ctx, userID)
+			if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+				return nil, "", err
+			}
+			visible[userID] = author != nil && domain.CanSeeTweets(nil, author)
+		}
+		if visible[userID] {
+			tweets = append(tweets, result.Tweet)
+		}
+	}
+
+	next := ""
+	if limit > 0 && len(results) == limit {
+		last := results[len(results)-1]
+		next = domain.SearchCursor{Score: last.Score, Timestamp: last.Tweet.Timestamp, TweetID: last.Tweet.ID}.Encode()
+	}
+	// Search is anonymous, so poll results show once polls close.
+	tweets, err = attachPollResults(ctx, uc.pollVoteRepo, "", tweets, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	return tweets, next, nil
+}
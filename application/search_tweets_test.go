@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db/in_memory"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	inmemorysearch "github.com/pedro00627/urblog/infrastructure/search/in_memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTweetsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockSearchIndex(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	useCase := NewSearchTweetsUseCase(index, userRepo, mocks.NewMockPollVoteRepository(ctrl))
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet1 := &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "Hola mundo", Timestamp: now}
+	tweet2 := &domain.Tweet{ID: "tweet2", UserID: "user2", Content: "Hola a todos", Timestamp: now.Add(-time.Minute)}
+	cursor := domain.SearchCursor{Score: 0.5, Timestamp: tweet2.Timestamp, TweetID: "tweet2"}
+
+	tests := []struct {
+		name       string
+		q          string
+		sort       domain.SearchSort
+		limit      int
+		cursor     string
+		setup      func()
+		wantTweets []*domain.Tweet
+		wantNext   string
+		wantErr    error
+	}{
+		{
+			name:  "full page returns next cursor",
+			q:     "hola from:user2",
+			sort:  domain.SearchByRelevance,
+			limit: 2,
+			setup: func() {
+				userRepo.EXPECT().FindByName(gomock.Any(), "user2").Return(&domain.User{ID: "user2", Username: "user2"}, nil).Times(1)
+				index.EXPECT().Search(gomock.Any(), domain.SearchQuery{
+					Terms:      []string{"hola"},
+					From:       "user2",
+					FromUserID: "user2",
+					Sort:       domain.SearchByRelevance,
+					Limit:      2,
+				}).Return([]domain.SearchResult{{Tweet: tweet1, Score: 1}, {Tweet: tweet2, Score: 0.5}}, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet1, tweet2},
+			wantNext:   cursor.Encode(),
+		},
+		{
+			name:   "last page has no next cursor",
+			q:      "hola",
+			limit:  2,
+			cursor: cursor.Encode(),
+			setup: func() {
+				index.EXPECT().Search(gomock.Any(), domain.SearchQuery{
+					Terms: []string{"hola"},
+					Sort:  domain.SearchByRecency,
+					Limit: 2,
+					After: &cursor,
+				}).Return([]domain.SearchResult{{Tweet: tweet1}}, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{tweet1},
+		},
+		{
+			name:  "protected tweets are left out",
+			q:     "hola",
+			limit: 2,
+			setup: func() {
+				author := domain.NewUser("user2", "user2")
+				author.Protected = true
+				index.EXPECT().Search(gomock.Any(), gomock.Any()).Return([]domain.SearchResult{{Tweet: tweet1}, {Tweet: tweet2}}, nil).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(1)
+			},
+			wantTweets: []*domain.Tweet{},
+			wantNext:   domain.SearchCursor{Timestamp: tweet2.Timestamp, TweetID: "tweet2"}.Encode(),
+		},
+		{
+			name:  "unknown from user",
+			q:     "from:ghost",
+			limit: 2,
+			setup: func() {
+				userRepo.EXPECT().FindByName(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantTweets: []*domain.Tweet{},
+		},
+		{
+			name:    "invalid query",
+			q:       "since:2025-03-01",
+			setup:   func() {},
+			wantErr: domain.ErrInvalidSearchQuery,
+		},
+		{
+			name:    "unknown sort",
+			q:       "hola",
+			sort:    "popular",
+			setup:   func() {},
+			wantErr: domain.ErrInvalidSearchQuery,
+		},
+		{
+			name:    "invalid cursor",
+			q:       "hola",
+			cursor:  "garbage",
+			setup:   func() {},
+			wantErr: domain.ErrInvalidSearchCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			tweets, next, err := useCase.Execute(context.Background(), tt.q, tt.sort, tt.limit, tt.cursor)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTweets, tweets)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func TestSearchTweetsAfterDeleteUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := in_memory.NewInMemoryUserRepository()
+	index := inmemorysearch.NewIndex()
+	assert.NoError(t, userRepo.Save(ctx, domain.NewUser("user1", "ana")))
+	assert.NoError(t, userRepo.Save(ctx, domain.NewUser("user2", "bea")))
+	tweet1 := &domain.Tweet{ID: "tweet1", UserID: "user1", Content: "Hola mundo", Timestamp: time.Now()}
+	tweet2 := &domain.Tweet{ID: "tweet2", UserID: "user2", Content: "Hola a todos", Timestamp: time.Now()}
+	assert.NoError(t, index.Index(ctx, tweet1))
+	assert.NoError(t, index.Index(ctx, tweet2))
+
+	deleteUser := NewDeleteUserUseCase(userRepo, in_memory.NewInMemoryAccountDeletionRepository(), 30*24*time.Hour)
+	_, err := deleteUser.Execute(ctx, "user1")
+	assert.NoError(t, err)
+
+	// The purge, which removes the tweets from the index, is weeks away.
+	searchTweets := NewSearchTweetsUseCase(index, userRepo, in_memory.NewInMemoryPollVoteRepository())
+	tweets, _, err := searchTweets.Execute(ctx, "hola", domain.SearchByRecency, 10, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Tweet{tweet2}, tweets)
+}
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_set_account_privacy.go -package=mocks github.com/pedro00627/urblog/application SetAccountPrivacy
+type SetAccountPrivacy interface {
+	Execute(ctx context.Context, userID string, protected bool) error
+}
+
+type SetAccountPrivacyUseCase struct {
+	userRepo db.UserRepository
+}
+
+func NewSetAccountPrivacyUseCase(userRepo db.UserRepository) SetAccountPrivacy {
+	return &SetAccountPrivacyUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute protects or unprotects userID's account. Current followers keep
+// following it either way.
+func (uc *SetAccountPrivacyUseCase) Execute(ctx context.Context, userID string, protected bool) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	user.Protected = protected
+	return uc.userRepo.Save(ctx, user)
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAccountPrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	setAccountPrivacyUseCase := NewSetAccountPrivacyUseCase(userRepo)
+
+	t.Run("success", func(t *testing.T) {
+		user := domain.NewUser("user1", "user1")
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		userRepo.EXPECT().Save(gomock.Any(), user).Return(nil).Times(1)
+
+		assert.NoError(t, setAccountPrivacyUseCase.Execute(context.Background(), "user1", true))
+		assert.True(t, user.Protected)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		assert.Equal(t, domain.ErrUserNotFound, setAccountPrivacyUseCase.Execute(context.Background(), "ghost", true))
+	})
+}
@@ -0,0 +1,215 @@
+package application
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+const (
+	maxSuggestions       = 50
+	maxSuggestionMutuals = 3
+)
+
+//go:generate mockgen -destination=./mocks/mock_suggest_users.go -package=mocks github.com/pedro00627/urblog/application SuggestUsers
+type SuggestUsers interface {
+	Execute(ctx context.Context, userID string, limit int) ([]domain.Suggestion, error)
+}
+
+type cachedSuggestions struct {
+	userID      string
+	following   map[string]bool
+	suggestions []domain.Suggestion
+}
+
+// SuggestUsersUseCase suggests friends of friends, ranked by how many of the
+// user's followed accounts follow them, leaving out blocked users. Suggestions
+// are cached per user until a follow event changes the graph they were
+// computed from, or until they are the least recently used of more than
+// cacheSize users.
+type SuggestUsersUseCase struct {
+	userRepo  db.UserRepository
+	cacheSize int
+
+	mu sync.Mutex
+	// cache holds an element of recent per user, whose value is their
+	// *cachedSuggestions; recent is ordered from the most recently used.
+	cache  map[string]*list.Element
+	recent *list.List
+	// changes counts graph changes, so that suggestions computed while one
+	// arrived are not cached.
+	changes int
+}
+
+func NewSuggestUsersUseCase(userRepo db.UserRepository, cacheSize int) *SuggestUsersUseCase {
+	return &SuggestUsersUseCase{
+		userRepo:  userRepo,
+		cacheSize: cacheSize,
+		cache:     make(map[string]*list.Element),
+		recent:    list.New(),
+	}
+}
+
+func (uc *SuggestUsersUseCase) Execute(ctx context.Context, userID string, limit int) ([]domain.Suggestion, error) {
+	uc.mu.Lock()
+	var cached *cachedSuggestions
+	element, ok := uc.cache[userID]
+	if ok {
+		uc.recent.MoveToFront(element)
+		cached = element.Value.(*cachedSuggestions)
+	}
+	changes := uc.changes
+	uc.mu.Unlock()
+
+	if !ok {
+		var err error
+		cached, err = uc.compute(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		uc.mu.Lock()
+		if uc.changes == changes {
+			uc.store(cached)
+		}
+		uc.mu.Unlock()
+	}
+
+	suggestions := cached.suggestions
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// Handle drops the cached suggestions that a follow or a block makes stale:
+// those of the users involved, and those of every user following them, whose
+// friends of friends just changed. A deleted user may be suggested to
+// anyone, so deleting a user drops every cached suggestion.
+func (uc *SuggestUsersUseCase) Handle(_ context.Context, event domain.Event) {
+	var changed []string
+	switch e := event.(type) {
+	case domain.UserDeleted:
+		uc.mu.Lock()
+		defer uc.mu.Unlock()
+		uc.changes++
+		clear(uc.cache)
+		uc.recent.Init()
+		return
+	case domain.UserFollowed:
+		changed = []string{e.FollowerID}
+	case domain.UserBlocked:
+		changed = []string{e.BlockerID, e.BlockedID}
+	case domain.UserUnblocked:
+		changed = []string{e.BlockerID, e.BlockedID}
+	default:
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.changes++
+	for element := uc.recent.Front(); element != nil; {
+		next := element.Next()
+		cached := element.Value.(*cachedSuggestions)
+		for _, changedID := range changed {
+			if cached.userID == changedID || cached.following[changedID] {
+				uc.remove(element)
+				break
+			}
+		}
+		element = next
+	}
+}
+
+// store caches the suggestions of a user as the most recently used, dropping
+// the least recently used ones beyond cacheSize. uc.mu must be held.
+func (uc *SuggestUsersUseCase) store(cached *cachedSuggestions) {
+	if element, ok := uc.cache[cached.userID]; ok {
+		uc.remove(element)
+	}
+	uc.cache[cached.userID] = uc.recent.PushFront(cached)
+	for uc.recent.Len() > uc.cacheSize {
+		uc.remove(uc.recent.Back())
+	}
+}
+
+// remove drops element from the cache. uc.mu must be held.
+func (uc *SuggestUsersUseCase) remove(element *list.Element) {
+	uc.recent.Remove(element)
+	delete(uc.cache, element.Value.(*cachedSuggestions).userID)
+}
+
+func (uc *SuggestUsersUseCase) compute(ctx context.Context, userID string) (*cachedSuggestions, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string]*domain.Suggestion)
+	for followedID := range user.Following {
+		followed, err := uc.userRepo.FindByID(ctx, followedID)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for candidateID := range followed.Following {
+			if candidateID == user.ID || user.Following[candidateID] || user.HasBlocked(candidateID) {
+				continue
+			}
+			candidate, ok := candidates[candidateID]
+			if !ok {
+				candidate = &domain.Suggestion{UserID: candidateID}
+				candidates[candidateID] = candidate
+			}
+			candidate.MutualCount++
+			candidate.Mutuals = append(candidate.Mutuals, followed.ID)
+		}
+	}
+
+	ranked := make([]*domain.Suggestion, 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, candidate)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].MutualCount != ranked[j].MutualCount {
+			return ranked[i].MutualCount > ranked[j].MutualCount
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+
+	suggestions := make([]domain.Suggestion, 0, maxSuggestions)
+	for _, candidate := range ranked {
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+		suggested, err := uc.userRepo.FindByID(ctx, candidate.UserID)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if suggested.HasBlocked(user.ID) {
+			continue
+		}
+		candidate.Username = suggested.Username
+		sort.Strings(candidate.Mutuals)
+		if len(candidate.Mutuals) > maxSuggestionMutuals {
+			candidate.Mutuals = candidate.Mutuals[:maxSuggestionMutuals]
+		}
+		suggestions = append(suggestions, *candidate)
+	}
+
+	following := make(map[string]bool, len(user.Following))
+	for followedID := range user.Following {
+		following[followedID] = true
+	}
+	return &cachedSuggestions{userID: user.ID, following: following, suggestions: suggestions}, nil
+}
@@ -0,0 +1,139 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestUsersUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	userWith := func(id string, following ...string) *domain.User {
+		user := domain.NewUser(id, id)
+		for _, followedID := range following {
+			user.Following[followedID] = true
+		}
+		return user
+	}
+	// user1 follows user2 and user3; both follow user4, only user3 follows
+	// user5, and user2 follows user1 back.
+	users := map[string]*domain.User{
+		"user1": userWith("user1", "user2", "user3"),
+		"user2": userWith("user2", "user1", "user3", "user4"),
+		"user3": userWith("user3", "user4", "user5"),
+		"user4": userWith("user4"),
+		"user5": userWith("user5"),
+	}
+	userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*domain.User, error) {
+		user, ok := users[id]
+		if !ok {
+			return nil, domain.ErrUserNotFound
+		}
+		return user, nil
+	}).AnyTimes()
+
+	useCase := NewSuggestUsersUseCase(userRepo, 10)
+
+	t.Run("ranks friends of friends by mutual connections", func(t *testing.T) {
+		suggestions, err := useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Suggestion{
+			{UserID: "user4", Username: "user4", MutualCount: 2, Mutuals: []string{"user2", "user3"}},
+			{UserID: "user5", Username: "user5", MutualCount: 1, Mutuals: []string{"user3"}},
+		}, suggestions)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		suggestions, err := useCase.Execute(context.Background(), "user1", 1)
+		assert.NoError(t, err)
+		assert.Len(t, suggestions, 1)
+		assert.Equal(t, "user4", suggestions[0].UserID)
+	})
+
+	t.Run("cached until a follow event", func(t *testing.T) {
+		users["user1"].Following["user4"] = true
+
+		suggestions, err := useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+		assert.Len(t, suggestions, 2)
+
+		useCase.Handle(context.Background(), domain.UserFollowed{FollowerID: "user1", FolloweeID: "user4"})
+
+		suggestions, err = useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"user5"}, suggestionIDs(suggestions))
+	})
+
+	t.Run("follows by followed users invalidate the cache", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+
+		users["user6"] = userWith("user6")
+		users["user2"].Following["user6"] = true
+		useCase.Handle(context.Background(), domain.UserFollowed{FollowerID: "user2", FolloweeID: "user6"})
+
+		suggestions, err := useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"user5", "user6"}, suggestionIDs(suggestions))
+	})
+
+	t.Run("blocked users are left out", func(t *testing.T) {
+		users["user1"].Blocked["user5"] = true
+		users["user6"].Blocked["user1"] = true
+		useCase.Handle(context.Background(), domain.UserBlocked{BlockerID: "user1", BlockedID: "user5"})
+
+		suggestions, err := useCase.Execute(context.Background(), "user1", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, suggestions)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), "ghost", 10)
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
+
+func TestSuggestUsersUseCase_CacheSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	lookups := make(map[string]int)
+	userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*domain.User, error) {
+		lookups[id]++
+		return domain.NewUser(id, id), nil
+	}).AnyTimes()
+
+	useCase := NewSuggestUsersUseCase(userRepo, 2)
+	execute := func(userID string) {
+		_, err := useCase.Execute(context.Background(), userID, 10)
+		assert.NoError(t, err)
+	}
+
+	execute("user1")
+	execute("user2")
+	execute("user1")
+	execute("user3")
+	assert.Equal(t, map[string]int{"user1": 1, "user2": 1, "user3": 1}, lookups)
+
+	// user2 was the least recently used when user3 was cached.
+	execute("user1")
+	execute("user2")
+	assert.Equal(t, map[string]int{"user1": 1, "user2": 2, "user3": 1}, lookups)
+}
+
+func suggestionIDs(suggestions []domain.Suggestion) []string {
+	var ids []string
+	for _, suggestion := range suggestions {
+		ids = append(ids, suggestion.UserID)
+	}
+	return ids
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_stream_timeline.go -package=mocks github.com/pedro00627/urblog/application StreamTimeline
+type StreamTimeline interface {
+	Subscribe(ctx context.Context, userID, lastEventID string) (*TimelineSubscription, error)
+}
+
+// TimelineEvent is a tweet delivered to a live timeline. IDs increase
+// monotonically and can be used to resume a subscription.
+type TimelineEvent struct {
+	ID    string
+	Tweet *domain.Tweet
+}
+
+// TimelineSubscription receives the tweets of the accounts a user follows as
+// they are created. Events is closed when the subscription ends, either
+// because Close was called or because the subscriber fell too far behind.
+type TimelineSubscription struct {
+	Events <-chan TimelineEvent
+
+	hub       *TimelineHub
+	userID    string
+	following map[string]bool
+	muted     map[string]bool
+	events    chan TimelineEvent
+	closeOnce sync.Once
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (s *TimelineSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+type timelineEntry struct {
+	seq   uint64
+	event TimelineEvent
+}
+
+// TimelineHub fans tweet-created events out to live timeline subscribers. It
+// keeps the most recent events so that subscribers can resume after a
+// disconnect, and drops subscribers whose buffer fills up rather than
+// blocking publishers.
+type TimelineHub struct {
+	userRepo   db.UserRepository
+	bufferSize int
+
+	mu          sync.Mutex
+	seq         uint64
+	history     []timelineEntry
+	historySize int
+	subscribers map[*TimelineSubscription]struct{}
+}
+
+func NewTimelineHub(userRepo db.UserRepository, historySize, bufferSize int) *TimelineHub {
+	return &TimelineHub{
+		userRepo:    userRepo,
+		bufferSize:  bufferSize,
+		historySize: historySize,
+		subscribers: make(map[*TimelineSubscription]struct{}),
+	}
+}
+
+// Subscribe starts a live timeline for userID. When lastEventID is set, the
+// retained events after it are replayed first.
+func (h *TimelineHub) Subscribe(ctx context.Context, userID, lastEventID string) (*TimelineSubscription, error) {
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	following := make(map[string]bool, len(user.Following))
+	for followedID := range user.Following {
+		following[followedID] = true
+	}
+	muted := make(map[string]bool, len(user.Muted))
+	for mutedID := range user.Muted {
+		muted[mutedID] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var replay []TimelineEvent
+	if last, err := strconv.ParseUint(lastEventID, 10, 64); err == nil {
+		for _, entry := range h.history {
+			if entry.seq > last && following[entry.event.Tweet.UserID] && !muted[entry.event.Tweet.UserID] {
+				replay = append(replay, entry.event)
+			}
+		}
+	}
+
+	events := make(chan TimelineEvent, h.bufferSize+len(replay))
+	for _, event := range replay {
+		events <- event
+	}
+	sub := &TimelineSubscription{
+		Events:    events,
+		hub:       h,
+		userID:    userID,
+		following: following,
+		muted:     muted,
+		events:    events,
+	}
+	h.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// Handle feeds domain events into the hub.
+func (h *TimelineHub) Handle(_ context.Context, event domain.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch e := event.(type) {
+	case domain.TweetCreated:
+		h.seq++
+		entry := timelineEntry{
+			seq:   h.seq,
+			event: TimelineEvent{ID: strconv.FormatUint(h.seq, 10), Tweet: e.Tweet},
+		}
+		h.history = append(h.history, entry)
+		if len(h.history) > h.historySize {
+			h.history = h.history[len(h.history)-h.historySize:]
+		}
+		for sub := range h.subscribers {
+			if !sub.following[e.Tweet.UserID] || sub.muted[e.Tweet.UserID] {
+				continue
+			}
+			select {
+			case sub.events <- entry.event:
+			default:
+				h.remove(sub)
+			}
+		}
+	case domain.UserFollowed:
+		for sub := range h.subscribers {
+			if sub.userID == e.FollowerID {
+				sub.following[e.FolloweeID] = true
+			}
+		}
+	case domain.UserBlocked:
+		for sub := range h.subscribers {
+			switch sub.userID {
+			case e.BlockerID:
+				delete(sub.following, e.BlockedID)
+			case e.BlockedID:
+				delete(sub.following, e.BlockerID)
+			}
+		}
+	case domain.UserMuted:
+		for sub := range h.subscribers {
+			if sub.userID == e.MuterID {
+				sub.muted[e.MutedID] = true
+			}
+		}
+	case domain.UserUnmuted:
+		for sub := range h.subscribers {
+			if sub.userID == e.MuterID {
+				delete(sub.muted, e.MutedID)
+			}
+		}
+	case domain.UserDeleted:
+		h.history = slices.DeleteFunc(h.history, func(entry timelineEntry) bool {
+			return entry.event.Tweet.UserID == e.UserID
+		})
+		for sub := range h.subscribers {
+			if sub.userID == e.UserID {
+				h.remove(sub)
+				continue
+			}
+			delete(sub.following, e.UserID)
+		}
+	}
+}
+
+func (h *TimelineHub) unsubscribe(sub *TimelineSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+func (h *TimelineHub) remove(sub *TimelineSubscription) {
+	delete(h.subscribers, sub)
+	sub.closeOnce.Do(func() {
+		close(sub.events)
+	})
+}
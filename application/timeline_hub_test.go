@@ -0,0 +1,179 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineHub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	follower := func() *domain.User {
+		user := domain.NewUser("user1", "user1")
+		user.Following["user2"] = true
+		return user
+	}
+	tweetBy := func(id, userID string) domain.Event {
+		return domain.TweetCreated{Tweet: &domain.Tweet{ID: id, UserID: userID}}
+	}
+
+	t.Run("delivers tweets from followed users only", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet2", "user3"))
+
+		event := <-sub.Events
+		assert.Equal(t, "1", event.ID)
+		assert.Equal(t, "tweet1", event.Tweet.ID)
+		assert.Len(t, sub.Events, 0)
+	})
+
+	t.Run("new follows are picked up", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		hub.Handle(context.Background(), domain.UserFollowed{FollowerID: "user1", FolloweeID: "user3"})
+		hub.Handle(context.Background(), tweetBy("tweet1", "user3"))
+
+		event := <-sub.Events
+		assert.Equal(t, "tweet1", event.Tweet.ID)
+	})
+
+	t.Run("resumes after last event id", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet2", "user3"))
+		hub.Handle(context.Background(), tweetBy("tweet3", "user2"))
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "1")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		event := <-sub.Events
+		assert.Equal(t, "3", event.ID)
+		assert.Equal(t, "tweet3", event.Tweet.ID)
+		assert.Len(t, sub.Events, 0)
+	})
+
+	t.Run("history is bounded", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 2, 10)
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet2", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet3", "user2"))
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "0")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		assert.Len(t, sub.Events, 2)
+	})
+
+	t.Run("slow subscribers are dropped", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet2", "user2"))
+
+		_, ok := <-sub.Events
+		assert.True(t, ok)
+		_, ok = <-sub.Events
+		assert.False(t, ok)
+		sub.Close()
+	})
+
+	t.Run("closed subscriptions stop receiving", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+		sub.Close()
+		sub.Close()
+
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+
+		_, ok := <-sub.Events
+		assert.False(t, ok)
+	})
+
+	t.Run("mutes and blocks are picked up", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		user := follower()
+		user.Following["user3"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		hub.Handle(context.Background(), domain.UserMuted{MuterID: "user1", MutedID: "user2"})
+		hub.Handle(context.Background(), domain.UserBlocked{BlockerID: "user3", BlockedID: "user1"})
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		hub.Handle(context.Background(), tweetBy("tweet2", "user3"))
+		assert.Len(t, sub.Events, 0)
+
+		hub.Handle(context.Background(), domain.UserUnmuted{MuterID: "user1", MutedID: "user2"})
+		hub.Handle(context.Background(), tweetBy("tweet3", "user2"))
+		event := <-sub.Events
+		assert.Equal(t, "tweet3", event.Tweet.ID)
+	})
+
+	t.Run("deleted users are purged", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		hub.Handle(context.Background(), tweetBy("tweet1", "user2"))
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		sub, err := hub.Subscribe(context.Background(), "user1", "")
+		assert.NoError(t, err)
+		defer sub.Close()
+		deleted, err := hub.Subscribe(context.Background(), "user2", "")
+		assert.NoError(t, err)
+
+		hub.Handle(context.Background(), domain.UserDeleted{UserID: "user2"})
+		_, ok := <-deleted.Events
+		assert.False(t, ok)
+
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(follower(), nil).Times(1)
+		resumed, err := hub.Subscribe(context.Background(), "user1", "0")
+		assert.NoError(t, err)
+		defer resumed.Close()
+		assert.Len(t, resumed.Events, 0, "history of the deleted user is dropped")
+
+		hub.Handle(context.Background(), tweetBy("tweet2", "user2"))
+		assert.Len(t, sub.Events, 0)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		hub := NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := hub.Subscribe(context.Background(), "user1", "")
+
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
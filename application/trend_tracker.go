@@ -0,0 +1,253 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+const (
+	trendSketchWidth = 2048
+	trendSketchDepth = 4
+	// minTrendCount keeps hashtags used in only a handful of tweets out of the
+	// trends, however unusual they are.
+	minTrendCount = 3
+	// minTrendScore is how many standard deviations above its expected count a
+	// hashtag must be to trend.
+	minTrendScore = 1
+	// maxBaselineExponent bounds the forward-decay weights before the baseline
+	// sketch is rescaled.
+	maxBaselineExponent = 30
+)
+
+//go:generate mockgen -destination=./mocks/mock_get_trends.go -package=mocks github.com/pedro00627/urblog/application GetTrends
+type GetTrends interface {
+	Execute(ctx context.Context, window string, limit int) ([]domain.Trend, error)
+}
+
+type trendBucket struct {
+	index  int64
+	sketch *domain.CountMinSketch
+}
+
+type trendWindow struct {
+	domain.TrendWindow
+	bucketSize time.Duration
+	buckets    []trendBucket
+
+	// baseline holds the long-term counts with forward decay: weights grow
+	// exponentially from landmark, so decaying every count is a single
+	// multiplication at read time.
+	baseline *domain.CountMinSketch
+	landmark time.Time
+	lambda   float64
+
+	candidates map[string]struct{}
+}
+
+// TrendTracker counts hashtags from tweet-created events in sliding windows
+// and reports the ones spiking above their baseline. Counts are kept in
+// count-min sketches, so memory does not grow with the number of hashtags.
+type TrendTracker struct {
+	maxCandidates int
+	now           func() time.Time
+
+	mu      sync.Mutex
+	windows []*trendWindow
+}
+
+// NewTrendTracker tracks the given windows, the first of which is the default.
+// At most maxCandidates hashtags per window are considered for trending.
+func NewTrendTracker(windows []domain.TrendWindow, maxCandidates int) *TrendTracker {
+	tracker := &TrendTracker{
+		maxCandidates: maxCandidates,
+		now:           time.Now,
+	}
+	for _, config := range windows {
+		w := &trendWindow{
+			TrendWindow: config,
+			bucketSize:  config.Length / time.Duration(config.Buckets),
+			buckets:     make([]trendBucket, config.Buckets),
+			baseline:    domain.NewCountMinSketch(trendSketchWidth, trendSketchDepth),
+			lambda:      math.Ln2 / config.Baseline.Seconds(),
+			candidates:  make(map[string]struct{}),
+		}
+		for i := range w.buckets {
+			w.buckets[i] = trendBucket{index: -1, sketch: domain.NewCountMinSketch(trendSketchWidth, trendSketchDepth)}
+		}
+		tracker.windows = append(tracker.windows, w)
+	}
+	return tracker
+}
+
+// Handle feeds domain events into the tracker.
+func (t *TrendTracker) Handle(_ context.Context, event domain.Event) {
+	e, ok := event.(domain.TweetCreated)
+	if !ok {
+		return
+	}
+	hashtags := tweetHashtags(e.Tweet)
+	if len(hashtags) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for _, w := range t.windows {
+		for _, hashtag := range hashtags {
+			w.add(hashtag, e.OccurredAt())
+		}
+		if len(w.candidates) > 2*t.maxCandidates {
+			w.prune(now, t.maxCandidates)
+		}
+	}
+}
+
+// Execute returns up to limit trending hashtags in the named window, the
+// default one when window is empty, highest score first.
+func (t *TrendTracker) Execute(ctx context.Context, window string, limit int) ([]domain.Trend, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w, err := t.window(window)
+	if err != nil {
+		return nil, err
+	}
+
+	var trends []domain.Trend
+	for _, trend := range w.trends(t.now()) {
+		if trend.Count >= minTrendCount && trend.Score >= minTrendScore {
+			trends = append(trends, trend)
+		}
+	}
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].Score != trends[j].Score {
+			return trends[i].Score > trends[j].Score
+		}
+		return trends[i].Hashtag < trends[j].Hashtag
+	})
+	if limit > 0 && len(trends) > limit {
+		trends = trends[:limit]
+	}
+	return trends, nil
+}
+
+func (t *TrendTracker) window(name string) (*trendWindow, error) {
+	if len(t.windows) == 0 {
+		return nil, domain.ErrInvalidTrendWindow
+	}
+	if name == "" {
+		return t.windows[0], nil
+	}
+	for _, w := range t.windows {
+		if w.Name == name {
+			return w, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", domain.ErrInvalidTrendWindow, name)
+}
+
+func (w *trendWindow) add(hashtag string, at time.Time) {
+	index := at.UnixNano() / int64(w.bucketSize)
+	bucket := &w.buckets[index%int64(len(w.buckets))]
+	if index > bucket.index {
+		bucket.sketch.Reset()
+		bucket.index = index
+	}
+	if index == bucket.index {
+		bucket.sketch.Add(hashtag, 1)
+	}
+
+	if w.landmark.IsZero() {
+		w.landmark = at
+	}
+	exponent := w.lambda * at.Sub(w.landmark).Seconds()
+	if exponent > maxBaselineExponent {
+		w.baseline.Scale(math.Exp(-exponent))
+		w.landmark = at
+		exponent = 0
+	}
+	w.baseline.Add(hashtag, math.Exp(exponent))
+
+	w.candidates[hashtag] = struct{}{}
+}
+
+// trends scores every candidate at now. The baseline sketch's decayed count
+// of a hashtag is its rate divided by lambda, which gives the count expected
+// over the window's decay-weighted length.
+func (w *trendWindow) trends(now time.Time) []domain.Trend {
+	current := now.UnixNano() / int64(w.bucketSize)
+	var buckets []trendBucket
+	var weights []float64
+	length := 0.0
+	for _, bucket := range w.buckets {
+		age := current - bucket.index
+		if bucket.index < 0 || age < 0 || age >= int64(len(w.buckets)) {
+			continue
+		}
+		// Buckets are aged from their end, so the newest ones weigh fully.
+		end := time.Unix(0, (bucket.index+1)*int64(w.bucketSize))
+		weight := math.Min(1, math.Pow(0.5, now.Sub(end).Seconds()/w.HalfLife.Seconds()))
+		buckets = append(buckets, bucket)
+		weights = append(weights, weight)
+		length += weight * w.bucketSize.Seconds()
+	}
+
+	decay := 0.0
+	if !w.landmark.IsZero() {
+		decay = math.Exp(-w.lambda * now.Sub(w.landmark).Seconds())
+	}
+
+	trends := make([]domain.Trend, 0, len(w.candidates))
+	for hashtag := range w.candidates {
+		count := 0.0
+		for i, bucket := range buckets {
+			count += weights[i] * bucket.sketch.Estimate(hashtag)
+		}
+		rate := w.baseline.Estimate(hashtag) * decay * w.lambda
+		expected := rate * length
+		trends = append(trends, domain.Trend{
+			Hashtag:  hashtag,
+			Count:    count,
+			Expected: expected,
+			Score:    (count - expected) / math.Sqrt(expected+1),
+		})
+	}
+	return trends
+}
+
+// prune keeps the max candidates with the highest counts in the window.
+func (w *trendWindow) prune(now time.Time, max int) {
+	trends := w.trends(now)
+	sort.Slice(trends, func(i, j int) bool {
+		return trends[i].Count > trends[j].Count
+	})
+	for _, trend := range trends[max:] {
+		delete(w.candidates, trend.Hashtag)
+	}
+}
+
+// tweetHashtags returns the distinct hashtags of a tweet, lowercased.
+func tweetHashtags(tweet *domain.Tweet) []string {
+	seen := make(map[string]bool)
+	var hashtags []string
+	for _, entity := range tweet.Entities {
+		if entity.Type != domain.EntityHashtag {
+			continue
+		}
+		hashtag := "#" + strings.ToLower(entity.Name())
+		if !seen[hashtag] {
+			seen[hashtag] = true
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return hashtags
+}
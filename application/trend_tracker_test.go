@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrendTracker(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	windows := []domain.TrendWindow{
+		{Name: "1h", Length: time.Hour, Buckets: 12, HalfLife: 30 * time.Minute, Baseline: 24 * time.Hour},
+		{Name: "24h", Length: 24 * time.Hour, Buckets: 24, HalfLife: 12 * time.Hour, Baseline: 7 * 24 * time.Hour},
+	}
+	newTracker := func(maxCandidates int) *TrendTracker {
+		tracker := NewTrendTracker(windows, maxCandidates)
+		tracker.now = func() time.Time { return now }
+		return tracker
+	}
+	tweetAt := func(at time.Time, content string) domain.Event {
+		return domain.TweetCreated{Tweet: &domain.Tweet{
+			ID:        fmt.Sprintf("tweet-%d", at.UnixNano()),
+			Content:   content,
+			Entities:  domain.ExtractEntities(content),
+			Timestamp: at,
+		}}
+	}
+	hashtags := func(trends []domain.Trend) []string {
+		var hashtags []string
+		for _, trend := range trends {
+			hashtags = append(hashtags, trend.Hashtag)
+		}
+		return hashtags
+	}
+
+	t.Run("spikes beat always popular hashtags", func(t *testing.T) {
+		tracker := newTracker(100)
+		// #golang is used every five minutes for a week, #Launch only in the
+		// last quarter of an hour.
+		for at := now.Add(-7 * 24 * time.Hour); at.Before(now); at = at.Add(5 * time.Minute) {
+			tracker.Handle(context.Background(), tweetAt(at, "Hoy #golang"))
+		}
+		for i := 0; i < 6; i++ {
+			tracker.Handle(context.Background(), tweetAt(now.Add(-time.Duration(i+1)*2*time.Minute), "#Launch #launch del día"))
+		}
+
+		trends, err := tracker.Execute(context.Background(), "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"#launch"}, hashtags(trends))
+		assert.Greater(t, trends[0].Count, 4.0)
+		assert.Less(t, trends[0].Expected, 1.0)
+	})
+
+	t.Run("events outside the window are only counted in the baseline", func(t *testing.T) {
+		tracker := newTracker(100)
+		for i := 0; i < 5; i++ {
+			tracker.Handle(context.Background(), tweetAt(now.Add(-3*time.Hour), "#old"))
+			tracker.Handle(context.Background(), tweetAt(now.Add(-time.Minute), "#new"))
+		}
+
+		trends, err := tracker.Execute(context.Background(), "1h", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"#new"}, hashtags(trends))
+
+		trends, err = tracker.Execute(context.Background(), "24h", 10)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"#new", "#old"}, hashtags(trends))
+	})
+
+	t.Run("rarely used hashtags do not trend", func(t *testing.T) {
+		tracker := newTracker(100)
+		tracker.Handle(context.Background(), tweetAt(now.Add(-time.Minute), "#rare"))
+		tracker.Handle(context.Background(), tweetAt(now.Add(-time.Minute), "#rare"))
+
+		trends, err := tracker.Execute(context.Background(), "1h", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, trends)
+	})
+
+	t.Run("candidates are bounded", func(t *testing.T) {
+		tracker := newTracker(2)
+		for i := 0; i < 3; i++ {
+			tracker.Handle(context.Background(), tweetAt(now.Add(-time.Minute), "#top"))
+		}
+		for i := 0; i < 50; i++ {
+			tracker.Handle(context.Background(), tweetAt(now.Add(-time.Minute), fmt.Sprintf("#tag%d", i)))
+		}
+
+		for _, w := range tracker.windows {
+			assert.LessOrEqual(t, len(w.candidates), 4)
+			assert.Contains(t, w.candidates, "#top")
+		}
+		trends, err := tracker.Execute(context.Background(), "1h", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"#top"}, hashtags(trends))
+	})
+
+	t.Run("unknown window", func(t *testing.T) {
+		_, err := newTracker(100).Execute(context.Background(), "7d", 10)
+		assert.True(t, errors.Is(err, domain.ErrInvalidTrendWindow))
+	})
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// TweetScheduler publishes scheduled tweets once they are due. Several
+// schedulers may run against the same repository: each due tweet is claimed
+// by a single one under a lease, and is published under its own ID, so a
+// tweet whose scheduler died mid-publish is not published twice.
+type TweetScheduler struct {
+	scheduledTweetRepo db.ScheduledTweetRepository
+	tweetRepo          db.TweetRepository
+	createTweet        *CreateTweetUseCase
+	logger             *slog.Logger
+
+	owner     string
+	interval  time.Duration
+	lease     time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+// NewTweetScheduler checks for due tweets every interval and publishes up to
+// batchSize of them at a time, each claimed for lease.
+func NewTweetScheduler(scheduledTweetRepo db.ScheduledTweetRepository, tweetRepo db.TweetRepository, createTweet *CreateTweetUseCase, interval, lease time.Duration, batchSize int, logger *slog.Logger) *TweetScheduler {
+	return &TweetScheduler{
+		scheduledTweetRepo: scheduledTweetRepo,
+		tweetRepo:          tweetRepo,
+		createTweet:        createTweet,
+		logger:             logger,
+		owner:              generateID(),
+		interval:           interval,
+		lease:              lease,
+		batchSize:          batchSize,
+		now:                time.Now,
+	}
+}
+
+// Run publishes due tweets until ctx is done.
+func (s *TweetScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		for {
+			published, err := s.PublishDue(ctx)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "publishing scheduled tweets failed", "error", err)
+			}
+			if err != nil || published < s.batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue claims one batch of due tweets and publishes them, returning how
+// many it claimed.
+func (s *TweetScheduler) PublishDue(ctx context.Context) (int, error) {
+	now := s.now()
+	claimed, err := s.scheduledTweetRepo.ClaimDue(ctx, s.owner, now, now.Add(s.lease), s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, scheduled := range claimed {
+		if err := s.publish(ctx, scheduled); err != nil {
+			// Left claimed, the tweet is retried when the lease expires.
+			s.logger.ErrorContext(ctx, "publishing scheduled tweet failed", "scheduled_tweet_id", scheduled.ID, "error", err)
+		}
+	}
+	return len(claimed), nil
+}
+
+func (s *TweetScheduler) publish(ctx context.Context, scheduled *domain.ScheduledTweet) error {
+	// A previous claim may have published the tweet and died before
+	// recording it.
+	tweet, err := s.tweetRepo.FindByID(ctx, scheduled.ID)
+	if errors.Is(err, domain.ErrTweetNotFound) {
+		tweet, err = s.createTweet.create(ctx, scheduled.ID, scheduled.UserID, scheduled.Content, scheduled.Attachments)
+	}
+	switch {
+	case err == nil:
+		scheduled.MarkPublished(tweet.ID, s.now())
+	case isPermanentPublishError(err):
+		scheduled.MarkFailed(err, s.now())
+	default:
+		return err
+	}
+	return s.scheduledTweetRepo.Update(ctx, scheduled)
+}
+
+// isPermanentPublishError reports whether retrying the publication of a
+// tweet cannot succeed.
+func isPermanentPublishError(err error) bool {
+	return errors.Is(err, domain.ErrUserNotFound) ||
+		errors.Is(err, domain.ErrUserBlocked) ||
+		errors.Is(err, domain.ErrInvalidTweetContent) ||
+		errors.Is(err, domain.ErrMediaNotFound)
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTweetScheduler_PublishDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledTweetRepo := mocks.NewMockScheduledTweetRepository(ctrl)
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	mediaRepo := mocks.NewMockMediaRepository(ctrl)
+	queue := mocks.NewMockQueue(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
+
+	createTweet := NewCreateTweetUseCase(tweetRepo, userRepo, mediaRepo, queue, events)
+	scheduler := NewTweetScheduler(scheduledTweetRepo, tweetRepo, createTweet, time.Second, time.Minute, 10, slog.New(slog.DiscardHandler))
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	scheduler.now = func() time.Time { return now }
+
+	claimed := func() *domain.ScheduledTweet {
+		tweet, _ := domain.NewScheduledTweet("scheduled1", "user1", "hello", now, now.Add(-time.Hour))
+		tweet.Claim(scheduler.owner, now.Add(time.Minute), now)
+		return tweet
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		wantStatus domain.ScheduledTweetStatus
+		wantError  string
+	}{
+		{
+			name: "published through the create path",
+			setup: func() {
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(nil, domain.ErrTweetNotFound).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+				tweetRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tweet *domain.Tweet) error {
+					assert.Equal(t, "scheduled1", tweet.ID)
+					assert.Equal(t, "hello", tweet.Content)
+					return nil
+				}).Times(1)
+				queue.EXPECT().WriteMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.TweetCreated{})).Return(nil).Times(1)
+			},
+			wantStatus: domain.ScheduledTweetPublished,
+		},
+		{
+			name: "already published by an earlier claim",
+			setup: func() {
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(&domain.Tweet{ID: "scheduled1"}, nil).Times(1)
+			},
+			wantStatus: domain.ScheduledTweetPublished,
+		},
+		{
+			name: "author deleted",
+			setup: func() {
+				tweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(nil, domain.ErrTweetNotFound).Times(1)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantStatus: domain.ScheduledTweetFailed,
+			wantError:  domain.ErrUserNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduledTweetRepo.EXPECT().ClaimDue(gomock.Any(), scheduler.owner, now, now.Add(time.Minute), 10).Return([]*domain.ScheduledTweet{claimed()}, nil).Times(1)
+			tt.setup()
+			scheduledTweetRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tweet *domain.ScheduledTweet) error {
+				assert.Equal(t, tt.wantStatus, tweet.Status)
+				assert.Equal(t, tt.wantError, tweet.Error)
+				if tt.wantStatus == domain.ScheduledTweetPublished {
+					assert.Equal(t, "scheduled1", tweet.TweetID)
+				}
+				return nil
+			}).Times(1)
+
+			published, err := scheduler.PublishDue(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, published)
+		})
+	}
+
+	t.Run("transient errors leave the tweet claimed", func(t *testing.T) {
+		scheduledTweetRepo.EXPECT().ClaimDue(gomock.Any(), scheduler.owner, now, now.Add(time.Minute), 10).Return([]*domain.ScheduledTweet{claimed()}, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(nil, errors.New("connection reset")).Times(1)
+
+		published, err := scheduler.PublishDue(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, published)
+	})
+
+	t.Run("claim error", func(t *testing.T) {
+		scheduledTweetRepo.EXPECT().ClaimDue(gomock.Any(), scheduler.owner, now, now.Add(time.Minute), 10).Return(nil, errors.New("connection reset")).Times(1)
+
+		_, err := scheduler.PublishDue(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_unblock_user.go -package=mocks github.com/pedro00627/urblog/application UnblockUser
+type UnblockUser interface {
+	Execute(ctx context.Context, userID, targetID string) error
+}
+
+type UnblockUserUseCase struct {
+	userRepo db.UserRepository
+	events   infrastructure.EventPublisher
+}
+
+func NewUnblockUserUseCase(userRepo db.UserRepository, events infrastructure.EventPublisher) UnblockUser {
+	return &UnblockUserUseCase{
+		userRepo: userRepo,
+		events:   events,
+	}
+}
+
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, userID, targetID string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := user.Unblock(targetID); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+	return uc.events.Publish(ctx, domain.UserUnblocked{BlockerID: user.ID, BlockedID: targetID, At: time.Now()})
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_unmute_user.go -package=mocks github.com/pedro00627/urblog/application UnmuteUser
+type UnmuteUser interface {
+	Execute(ctx context.Context, userID, targetID string) error
+}
+
+type UnmuteUserUseCase struct {
+	userRepo db.UserRepository
+	events   infrastructure.EventPublisher
+}
+
+func NewUnmuteUserUseCase(userRepo db.UserRepository, events infrastructure.EventPublisher) UnmuteUser {
+	return &UnmuteUserUseCase{
+		userRepo: userRepo,
+		events:   events,
+	}
+}
+
+func (uc *UnmuteUserUseCase) Execute(ctx context.Context, userID, targetID string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := user.Unmute(targetID); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+	return uc.events.Publish(ctx, domain.UserUnmuted{MuterID: user.ID, MutedID: targetID, At: time.Now()})
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmuteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	events := mocks.NewMockEventPublisher(ctrl)
+
+	unmuteUserUseCase := NewUnmuteUserUseCase(userRepo, events)
+
+	t.Run("success", func(t *testing.T) {
+		user := domain.NewUser("user1", "user1")
+		user.Muted["user2"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		userRepo.EXPECT().Save(gomock.Any(), user).Return(nil).Times(1)
+		events.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(domain.UserUnmuted{})).Return(nil).Times(1)
+
+		assert.NoError(t, unmuteUserUseCase.Execute(context.Background(), "user1", "user2"))
+		assert.False(t, user.Muted["user2"])
+	})
+
+	t.Run("not muted", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		assert.Equal(t, domain.ErrNotMuted, unmuteUserUseCase.Execute(context.Background(), "user1", "user2"))
+	})
+}
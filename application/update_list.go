@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_update_list.go -package=mocks github.com/pedro00627/urblog/application UpdateList
+type UpdateList interface {
+	Execute(ctx context.Context, userID, listID string, name *string, private *bool) (*domain.List, error)
+}
+
+type UpdateListUseCase struct {
+	listRepo db.ListRepository
+}
+
+func NewUpdateListUseCase(listRepo db.ListRepository) UpdateList {
+	return &UpdateListUseCase{
+		listRepo: listRepo,
+	}
+}
+
+// Execute renames the list and changes its visibility on behalf of its owner.
+// Nil arguments are left unchanged.
+func (uc *UpdateListUseCase) Execute(ctx context.Context, userID, listID string, name *string, private *bool) (*domain.List, error) {
+	list, err := findOwnedList(ctx, uc.listRepo, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if name != nil {
+		if err := list.Rename(*name); err != nil {
+			return nil, err
+		}
+	}
+	if private != nil {
+		list.SetPrivate(*private)
+	}
+	if err := uc.listRepo.Save(ctx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// findOwnedList loads a list that userID is about to change.
+func findOwnedList(ctx context.Context, listRepo db.ListRepository, userID, listID string) (*domain.List, error) {
+	list, err := listRepo.FindByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if err := list.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mocks.NewMockListRepository(ctrl)
+
+	updateListUseCase := NewUpdateListUseCase(listRepo)
+
+	name := func(s string) *string { return &s }
+	private := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name        string
+		userID      string
+		listName    *string
+		private     *bool
+		save        bool
+		wantName    string
+		wantPrivate bool
+		wantErr     error
+	}{
+		{name: "rename", userID: "user1", listName: name("family"), save: true, wantName: "family"},
+		{name: "make private", userID: "user1", private: private(true), save: true, wantName: "friends", wantPrivate: true},
+		{name: "invalid name", userID: "user1", listName: name(""), wantErr: domain.ErrInvalidListName},
+		{name: "not the owner", userID: "user2", listName: name("family"), wantErr: domain.ErrNotListOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listRepo.EXPECT().FindByID(gomock.Any(), "list1").Return(newTestList(false), nil).Times(1)
+			if tt.save {
+				listRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			}
+
+			list, err := updateListUseCase.Execute(context.Background(), tt.userID, "list1", tt.listName, tt.private)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, list.Name)
+			assert.Equal(t, tt.wantPrivate, list.Private)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_update_scheduled_tweet.go -package=mocks github.com/pedro00627/urblog/application UpdateScheduledTweet
+type UpdateScheduledTweet interface {
+	Execute(ctx context.Context, userID, id string, content *string, publishAt *time.Time) (*domain.ScheduledTweet, error)
+}
+
+type UpdateScheduledTweetUseCase struct {
+	scheduledTweetRepo db.ScheduledTweetRepository
+	now                func() time.Time
+}
+
+func NewUpdateScheduledTweetUseCase(scheduledTweetRepo db.ScheduledTweetRepository) UpdateScheduledTweet {
+	return &UpdateScheduledTweetUseCase{
+		scheduledTweetRepo: scheduledTweetRepo,
+		now:                time.Now,
+	}
+}
+
+// Execute edits a pending scheduled tweet. It fails with
+// domain.ErrScheduledTweetConflict if the scheduler claimed the tweet
+// meanwhile.
+func (uc *UpdateScheduledTweetUseCase) Execute(ctx context.Context, userID, id string, content *string, publishAt *time.Time) (*domain.ScheduledTweet, error) {
+	tweet, err := findOwnScheduledTweet(ctx, uc.scheduledTweetRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tweet.Edit(content, publishAt, uc.now()); err != nil {
+		return nil, err
+	}
+	if err := uc.scheduledTweetRepo.Update(ctx, tweet); err != nil {
+		return nil, err
+	}
+	return tweet, nil
+}
+
+func findOwnScheduledTweet(ctx context.Context, scheduledTweetRepo db.ScheduledTweetRepository, userID, id string) (*domain.ScheduledTweet, error) {
+	tweet, err := scheduledTweetRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tweet.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	return tweet, nil
+}
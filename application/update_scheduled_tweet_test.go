@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateScheduledTweet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledTweetRepo := mocks.NewMockScheduledTweetRepository(ctrl)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	updateScheduledTweetUseCase := NewUpdateScheduledTweetUseCase(scheduledTweetRepo).(*UpdateScheduledTweetUseCase)
+	updateScheduledTweetUseCase.now = func() time.Time { return now }
+
+	pending := func() *domain.ScheduledTweet {
+		tweet, _ := domain.NewScheduledTweet("scheduled1", "user1", "hello", now.Add(time.Hour), now)
+		return tweet
+	}
+	content := "bye"
+
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func()
+		wantContent string
+		wantErr     error
+	}{
+		{
+			name:   "success",
+			userID: "user1",
+			setup: func() {
+				scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(pending(), nil).Times(1)
+				scheduledTweetRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantContent: "bye",
+		},
+		{
+			name:   "claimed by the scheduler meanwhile",
+			userID: "user1",
+			setup: func() {
+				scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(pending(), nil).Times(1)
+				scheduledTweetRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(domain.ErrScheduledTweetConflict).Times(1)
+			},
+			wantErr: domain.ErrScheduledTweetConflict,
+		},
+		{
+			name:   "already published",
+			userID: "user1",
+			setup: func() {
+				tweet := pending()
+				tweet.MarkPublished("scheduled1", now)
+				scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(tweet, nil).Times(1)
+			},
+			wantErr: domain.ErrScheduledTweetNotPending,
+		},
+		{
+			name:   "someone else's tweet",
+			userID: "user2",
+			setup: func() {
+				scheduledTweetRepo.EXPECT().FindByID(gomock.Any(), "scheduled1").Return(pending(), nil).Times(1)
+			},
+			wantErr: domain.ErrScheduledTweetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			tweet, err := updateScheduledTweetUseCase.Execute(context.Background(), tt.userID, "scheduled1", &content, nil)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContent, tweet.Content)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_upload_media.go -package=mocks github.com/pedro00627/urblog/application UploadMedia
+type UploadMedia interface {
+	Execute(ctx context.Context, userID string, data []byte) (*domain.Media, error)
+}
+
+type UploadMediaUseCase struct {
+	mediaRepo db.MediaRepository
+	userRepo  db.UserRepository
+	blobs     infrastructure.BlobStore
+	now       func() time.Time
+}
+
+func NewUploadMediaUseCase(mediaRepo db.MediaRepository, userRepo db.UserRepository, blobs infrastructure.BlobStore) UploadMedia {
+	return &UploadMediaUseCase{
+		mediaRepo: mediaRepo,
+		userRepo:  userRepo,
+		blobs:     blobs,
+		now:       time.Now,
+	}
+}
+
+// Execute processes an uploaded image and stores it with its thumbnail, so
+// that userID can attach it to a tweet. The metadata is saved last: media
+// whose blobs failed to store are never visible.
+func (uc *UploadMediaUseCase) Execute(ctx context.Context, userID string, data []byte) (*domain.Media, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	img, err := domain.ProcessImage(data)
+	if err != nil {
+		return nil, err
+	}
+	media := domain.NewMedia(generateID(), userID, img, uc.now())
+
+	if err := uc.blobs.Put(ctx, media.BlobKey(), media.ContentType, img.Data); err != nil {
+		return nil, err
+	}
+	if err := uc.blobs.Put(ctx, media.ThumbnailKey(), media.ThumbnailContentType, img.Thumbnail); err != nil {
+		_ = uc.blobs.Delete(ctx, media.BlobKey())
+		return nil, err
+	}
+	if err := uc.mediaRepo.Save(ctx, media); err != nil {
+		_ = uc.blobs.Delete(ctx, media.BlobKey())
+		_ = uc.blobs.Delete(ctx, media.ThumbnailKey())
+		return nil, err
+	}
+	return media, nil
+}
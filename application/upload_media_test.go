@@ -0,0 +1,76 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mediaRepo := mocks.NewMockMediaRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	blobs := mocks.NewMockBlobStore(ctrl)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	uploadMediaUseCase := NewUploadMediaUseCase(mediaRepo, userRepo, blobs).(*UploadMediaUseCase)
+	uploadMediaUseCase.now = func() time.Time { return now }
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 640, 480)))
+	photo := buf.Bytes()
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		blobs.EXPECT().Put(gomock.Any(), gomock.Any(), "image/png", gomock.Any()).Return(nil).Times(2)
+		mediaRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		media, err := uploadMediaUseCase.Execute(context.Background(), "user1", photo)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, media.ID)
+		assert.Equal(t, "user1", media.UserID)
+		assert.Equal(t, "image/png", media.ContentType)
+		assert.Equal(t, 640, media.Width)
+		assert.Equal(t, 480, media.Height)
+		assert.Equal(t, 320, media.ThumbnailWidth)
+		assert.Equal(t, 240, media.ThumbnailHeight)
+		assert.Equal(t, now, media.CreatedAt)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+
+		_, err := uploadMediaUseCase.Execute(context.Background(), "user1", []byte("hello"))
+		assert.Equal(t, domain.ErrUnsupportedMediaType, err)
+	})
+
+	t.Run("thumbnail store error removes the image", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "user1"), nil).Times(1)
+		gomock.InOrder(
+			blobs.EXPECT().Put(gomock.Any(), gomock.Any(), "image/png", gomock.Any()).Return(nil),
+			blobs.EXPECT().Put(gomock.Any(), gomock.Any(), "image/png", gomock.Any()).Return(errors.New("disk full")),
+			blobs.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		_, err := uploadMediaUseCase.Execute(context.Background(), "user1", photo)
+		assert.Error(t, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+
+		_, err := uploadMediaUseCase.Execute(context.Background(), "ghost", photo)
+		assert.Equal(t, domain.ErrUserNotFound, err)
+	})
+}
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+//go:generate mockgen -destination=./mocks/mock_vote_poll.go -package=mocks github.com/pedro00627/urblog/application VotePoll
+type VotePoll interface {
+	Execute(ctx context.Context, userID, tweetID string, option int) (*domain.Tweet, error)
+}
+
+type VotePollUseCase struct {
+	pollVoteRepo db.PollVoteRepository
+	tweetRepo    db.TweetRepository
+	userRepo     db.UserRepository
+	now          func() time.Time
+}
+
+func NewVotePollUseCase(pollVoteRepo db.PollVoteRepository, tweetRepo db.TweetRepository, userRepo db.UserRepository) VotePoll {
+	return &VotePollUseCase{
+		pollVoteRepo: pollVoteRepo,
+		tweetRepo:    tweetRepo,
+		userRepo:     userRepo,
+		now:          time.Now,
+	}
+}
+
+// Execute records userID's vote for option in the poll of tweetID and returns
+// the tweet with the results, which the vote reveals to them.
+func (uc *VotePollUseCase) Execute(ctx context.Context, userID, tweetID string, option int) (*domain.Tweet, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tweet, err := uc.tweetRepo.FindByID(ctx, tweetID)
+	if err != nil {
+		return nil, err
+	}
+	author, err := uc.userRepo.FindByID(ctx, tweet.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if domain.BlockedBetween(user, author) {
+		return nil, domain.ErrUserBlocked
+	}
+	if !domain.CanSeeTweets(user, author) {
+		return nil, domain.ErrProtectedAccount
+	}
+
+	now := uc.now()
+	vote, err := domain.NewPollVote(tweet, userID, option, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.pollVoteRepo.Save(ctx, vote); err != nil {
+		return nil, err
+	}
+	tweets, err := attachPollResults(ctx, uc.pollVoteRepo, userID, []*domain.Tweet{tweet}, now)
+	if err != nil {
+		return nil, err
+	}
+	return tweets[0], nil
+}
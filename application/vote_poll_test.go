@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotePoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pollVoteRepo := mocks.NewMockPollVoteRepository(ctrl)
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	votePollUseCase := NewVotePollUseCase(pollVoteRepo, tweetRepo, userRepo).(*VotePollUseCase)
+	votePollUseCase.now = func() time.Time { return now }
+
+	tweet := &domain.Tweet{ID: "tweet1", UserID: "user2", Poll: &domain.Poll{Options: []string{"yes", "no"}, EndsAt: now.Add(time.Hour)}}
+	voter := domain.NewUser("user1", "user1")
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+		pollVoteRepo.EXPECT().Save(gomock.Any(), &domain.PollVote{TweetID: "tweet1", UserID: "user1", Option: 1, CreatedAt: now}).Return(nil).Times(1)
+		pollVoteRepo.EXPECT().Tally(gomock.Any(), []string{"tweet1"}).Return(map[string]map[int]int{"tweet1": {0: 4, 1: 1}}, nil).Times(1)
+		pollVoteRepo.EXPECT().FindByUserID(gomock.Any(), "user1", []string{"tweet1"}).Return(map[string]*domain.PollVote{"tweet1": {TweetID: "tweet1", UserID: "user1", Option: 1}}, nil).Times(1)
+
+		got, err := votePollUseCase.Execute(context.Background(), "user1", "tweet1", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.PollResults{Votes: []int{4, 1}, TotalVotes: 5, ViewerVote: 1}, got.Poll.Results)
+		assert.Nil(t, tweet.Poll.Results)
+	})
+
+	t.Run("already voted", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+		pollVoteRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(domain.ErrAlreadyVoted).Times(1)
+
+		_, err := votePollUseCase.Execute(context.Background(), "user1", "tweet1", 0)
+		assert.Equal(t, domain.ErrAlreadyVoted, err)
+	})
+
+	t.Run("invalid option", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).Times(1)
+
+		_, err := votePollUseCase.Execute(context.Background(), "user1", "tweet1", 2)
+		assert.Equal(t, domain.ErrInvalidPollOption, err)
+	})
+
+	t.Run("protected author", func(t *testing.T) {
+		author := domain.NewUser("user2", "user2")
+		author.Protected = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(1)
+
+		_, err := votePollUseCase.Execute(context.Background(), "user1", "tweet1", 0)
+		assert.Equal(t, domain.ErrProtectedAccount, err)
+	})
+
+	t.Run("blocked by the author", func(t *testing.T) {
+		author := domain.NewUser("user2", "user2")
+		author.Blocked["user1"] = true
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "tweet1").Return(tweet, nil).Times(1)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(author, nil).Times(1)
+
+		_, err := votePollUseCase.Execute(context.Background(), "user1", "tweet1", 0)
+		assert.Equal(t, domain.ErrUserBlocked, err)
+	})
+
+	t.Run("tweet not found", func(t *testing.T) {
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(voter, nil).Times(1)
+		tweetRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrTweetNotFound).Times(1)
+
+		_, err := votePollUseCase.Execute(context.Background(), "user1", "ghost", 0)
+		assert.Equal(t, domain.ErrTweetNotFound, err)
+	})
+}
@@ -2,69 +2,450 @@ package main
 
 import (
 	"context"
+	"errors"
+	"github.com/pedro00627/urblog/infrastructure/blob/local"
+	"github.com/pedro00627/urblog/infrastructure/blob/s3"
 	"github.com/pedro00627/urblog/infrastructure/db"
 	"github.com/pedro00627/urblog/infrastructure/db/in_memory"
 	mongo2 "github.com/pedro00627/urblog/infrastructure/db/mongo"
+	events "github.com/pedro00627/urblog/infrastructure/events/in_memory"
+	"github.com/pedro00627/urblog/infrastructure/health"
+	"github.com/pedro00627/urblog/infrastructure/logging"
+	"github.com/pedro00627/urblog/infrastructure/metrics"
 	inmemory2 "github.com/pedro00627/urblog/infrastructure/queue/in_memory"
 	"github.com/pedro00627/urblog/infrastructure/queue/kafka"
+	inmemorysearch "github.com/pedro00627/urblog/infrastructure/search/in_memory"
+	mongosearch "github.com/pedro00627/urblog/infrastructure/search/mongo"
+	"github.com/pedro00627/urblog/infrastructure/tracing"
+	"log/slog"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
 	"github.com/pedro00627/urblog/infrastructure"
 	"github.com/pedro00627/urblog/interfaces"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 )
 
 // Dependencies contains the application dependencies
 type Dependencies struct {
-	TweetController *interfaces.TweetController
-	UserController  *interfaces.UserController
+	TweetController           *interfaces.TweetController
+	UserController            *interfaces.UserController
+	NotificationController    *interfaces.NotificationController
+	TimelineStreamController  *interfaces.TimelineStreamController
+	WebSocketController       *interfaces.WebSocketController
+	SearchController          *interfaces.SearchController
+	TrendController           *interfaces.TrendController
+	SuggestionController      *interfaces.SuggestionController
+	RelationshipController    *interfaces.RelationshipController
+	FollowRequestController   *interfaces.FollowRequestController
+	ProfileController         *interfaces.ProfileController
+	ListController            *interfaces.ListController
+	BookmarkController        *interfaces.BookmarkController
+	ScheduledTweetController  *interfaces.ScheduledTweetController
+	MediaController           *interfaces.MediaController
+	PollController            *interfaces.PollController
+	TweetScheduler            *application.TweetScheduler
+	ImportController          *interfaces.ImportController
+	ImportUsers               *application.ImportUsersUseCase
+	GetImportJob              application.GetImportJob
+	ExportController          *interfaces.ExportController
+	ExportUsers               application.ExportUsers
+	Metrics                   *metrics.Metrics
+	Tracing                   *tracing.Tracing
+	AccountDeletionController *interfaces.AccountDeletionController
+	AccountPurger             *application.AccountPurger
+	Health                    *health.Health
+
+	// closers release the connections of the dependencies, in the order
+	// Close calls them.
+	closers []func(ctx context.Context) error
+}
+
+// Close writes what the queue still holds, disconnects from the database and
+// exports the spans not yet exported, in that order, so that nothing the
+// first steps do goes unrecorded. It returns every error it met.
+func (d *Dependencies) Close(ctx context.Context) error {
+	var errs []error
+	for _, closer := range d.closers {
+		if err := closer(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
-func InitializeDependencies() (*Dependencies, error) {
+func InitializeDependencies(logger *slog.Logger) (*Dependencies, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	var tweetRepo db.TweetRepository
 	var userRepo db.UserRepository
+	var notificationRepo db.NotificationRepository
+	var followRequestRepo db.FollowRequestRepository
+	var listRepo db.ListRepository
+	var bookmarkRepo db.BookmarkRepository
+	var scheduledTweetRepo db.ScheduledTweetRepository
+	var mediaRepo db.MediaRepository
+	var pollVoteRepo db.PollVoteRepository
+	var importJobRepo db.ImportJobRepository
+	var accountExportRepo db.AccountExportRepository
+	var accountDeletionRepo db.AccountDeletionRepository
+	var blobStore infrastructure.BlobStore
+	var searchIndex infrastructure.SearchIndex
 	var queue infrastructure.Queue
+	var mongoClient *mongo.Client
+	var kafkaWriter *kafka.Writer
+	appHealth := health.New(envDuration("READINESS_TIMEOUT", 2*time.Second))
+	appMetrics := metrics.New()
+	appTracing, err := newTracing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	adapter := "in_memory"
+	blobAdapter := "local"
 
 	//Creating Repositories
 	if os.Getenv("DATABASE") == "" {
 		tweetRepo = in_memory.NewInMemoryTweetRepository()
 		userRepo = in_memory.NewInMemoryUserRepository()
+		notificationRepo = in_memory.NewInMemoryNotificationRepository()
+		followRequestRepo = in_memory.NewInMemoryFollowRequestRepository()
+		listRepo = in_memory.NewInMemoryListRepository()
+		bookmarkRepo = in_memory.NewInMemoryBookmarkRepository()
+		scheduledTweetRepo = in_memory.NewInMemoryScheduledTweetRepository()
+		mediaRepo = in_memory.NewInMemoryMediaRepository()
+		pollVoteRepo = in_memory.NewInMemoryPollVoteRepository()
+		importJobRepo = in_memory.NewInMemoryImportJobRepository()
+		accountExportRepo = in_memory.NewInMemoryAccountExportRepository()
+		accountDeletionRepo = in_memory.NewInMemoryAccountDeletionRepository()
+		searchIndex = inmemorysearch.NewIndex()
 	} else {
-		client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGODB_URI")))
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGODB_URI")).SetMonitor(appTracing.MongoMonitor()))
 		if err != nil {
 			return nil, err
 		}
+		mongoClient = client
+		appHealth.Add("mongo", func(ctx context.Context) error {
+			return client.Ping(ctx, readpref.Primary())
+		})
 		database := client.Database(os.Getenv("DATABASE"))
+		adapter = "mongo"
 		tweetRepo = mongo2.NewTweetRepository(database)
 		userRepo = mongo2.NewUserRepository(database)
+		notificationRepo = mongo2.NewNotificationRepository(database)
+		followRequestRepo = mongo2.NewFollowRequestRepository(database)
+		listRepo = mongo2.NewListRepository(database)
+		mongoBookmarkRepo := mongo2.NewBookmarkRepository(database)
+		if err := mongoBookmarkRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		bookmarkRepo = mongoBookmarkRepo
+		mongoScheduledTweetRepo := mongo2.NewScheduledTweetRepository(database)
+		if err := mongoScheduledTweetRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		scheduledTweetRepo = mongoScheduledTweetRepo
+		mongoMediaRepo := mongo2.NewMediaRepository(database)
+		if err := mongoMediaRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		mediaRepo = mongoMediaRepo
+		mongoPollVoteRepo := mongo2.NewPollVoteRepository(database)
+		if err := mongoPollVoteRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		pollVoteRepo = mongoPollVoteRepo
+		mongoImportJobRepo := mongo2.NewImportJobRepository(database)
+		if err := mongoImportJobRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		importJobRepo = mongoImportJobRepo
+		mongoAccountExportRepo := mongo2.NewAccountExportRepository(database)
+		if err := mongoAccountExportRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		accountExportRepo = mongoAccountExportRepo
+		mongoAccountDeletionRepo := mongo2.NewAccountDeletionRepository(database)
+		if err := mongoAccountDeletionRepo.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		accountDeletionRepo = mongoAccountDeletionRepo
+		mongoIndex := mongosearch.NewIndex(database)
+		if err := mongoIndex.EnsureIndexes(ctx); err != nil {
+			return nil, err
+		}
+		searchIndex = mongoIndex
 	}
 
 	if kafkaBroker := os.Getenv("KAFKA_BROKER"); kafkaBroker != "" {
-		queue = kafka.NewWriter(kafkaBroker)
+		kafkaWriter = kafka.NewWriter(kafkaBroker, logger)
+		appHealth.Add("kafka", kafkaWriter.Ping)
+		queue = kafkaWriter
 	} else {
 		queue = inmemory2.NewInMemoryQueue()
 	}
+	queue = appTracing.Queue(appMetrics.Queue(queue))
+	eventBus := events.NewBus()
+
+	if os.Getenv("BLOB_STORE") == "s3" {
+		s3Store := s3.NewStore(s3.Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    envString("S3_REGION", "us-east-1"),
+			Bucket:    envString("S3_BUCKET", "media"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		})
+		if err := s3Store.EnsureBucket(ctx); err != nil {
+			return nil, err
+		}
+		blobStore = s3Store
+		blobAdapter = "s3"
+	} else {
+		localStore, err := local.NewStore(envString("MEDIA_DIR", "media"))
+		if err != nil {
+			return nil, err
+		}
+		blobStore = localStore
+	}
+
+	// Measuring repositories
+	tweetRepo = appMetrics.TweetRepository(tweetRepo, adapter)
+	userRepo = appMetrics.UserRepository(userRepo, adapter)
+
+	// Tracing repositories and stores
+	tweetRepo = appTracing.TweetRepository(tweetRepo, adapter)
+	userRepo = appTracing.UserRepository(userRepo, adapter)
+	notificationRepo = appTracing.NotificationRepository(notificationRepo, adapter)
+	followRequestRepo = appTracing.FollowRequestRepository(followRequestRepo, adapter)
+	listRepo = appTracing.ListRepository(listRepo, adapter)
+	bookmarkRepo = appTracing.BookmarkRepository(bookmarkRepo, adapter)
+	scheduledTweetRepo = appTracing.ScheduledTweetRepository(scheduledTweetRepo, adapter)
+	mediaRepo = appTracing.MediaRepository(mediaRepo, adapter)
+	pollVoteRepo = appTracing.PollVoteRepository(pollVoteRepo, adapter)
+	importJobRepo = appTracing.ImportJobRepository(importJobRepo, adapter)
+	accountExportRepo = appTracing.AccountExportRepository(accountExportRepo, adapter)
+	accountDeletionRepo = appTracing.AccountDeletionRepository(accountDeletionRepo, adapter)
+	searchIndex = appTracing.SearchIndex(searchIndex, adapter)
+	blobStore = appTracing.BlobStore(blobStore, blobAdapter)
 
 	// Creating Use Cases
-	createTweet := application.NewCreateTweetUseCase(tweetRepo, userRepo, queue)
-	followUser := application.NewFollowUserUseCase(userRepo, queue)
-	getTimeline := application.NewGetTimelineUseCase(tweetRepo, userRepo)
-	loadUsersUseCase := application.NewLoadUsersUseCase(userRepo)
+	createTweet := application.NewCreateTweetUseCase(tweetRepo, userRepo, mediaRepo, queue, eventBus)
+	followUser := application.NewFollowUserUseCase(userRepo, followRequestRepo, queue, eventBus)
+	getTimeline := application.NewGetTimelineUseCase(tweetRepo, userRepo, pollVoteRepo, logger)
+	importUsers := application.NewImportUsersUseCase(importJobRepo, userRepo, logger)
+	getImportJob := application.NewGetImportJobUseCase(importJobRepo)
+	exportUsers := appTracing.ExportUsers(appMetrics.ExportUsers(application.NewExportUsersUseCase(userRepo)))
+	buildNotifications := appTracing.BuildNotifications(appMetrics.BuildNotifications(application.NewBuildNotificationsUseCase(notificationRepo)))
+	getNotifications := application.NewGetNotificationsUseCase(notificationRepo, userRepo)
+	markNotificationsRead := application.NewMarkNotificationsReadUseCase(notificationRepo, userRepo)
+	timelineHub := application.NewTimelineHub(userRepo, 1000, 64)
+	liveHub := application.NewLiveHub(userRepo, 64)
+	indexTweets := appTracing.IndexTweets(appMetrics.IndexTweets(application.NewIndexTweetsUseCase(searchIndex)))
+	searchTweets := application.NewSearchTweetsUseCase(searchIndex, userRepo, pollVoteRepo)
+	trendTracker := application.NewTrendTracker([]domain.TrendWindow{
+		{Name: "1h", Length: time.Hour, Buckets: 12, HalfLife: 30 * time.Minute, Baseline: 24 * time.Hour},
+		{Name: "24h", Length: 24 * time.Hour, Buckets: 24, HalfLife: 12 * time.Hour, Baseline: 7 * 24 * time.Hour},
+	}, 1000)
+	suggestUsers := application.NewSuggestUsersUseCase(userRepo, 10000)
+	blockUser := application.NewBlockUserUseCase(userRepo, eventBus)
+	unblockUser := application.NewUnblockUserUseCase(userRepo, eventBus)
+	listBlockedUsers := application.NewListBlockedUsersUseCase(userRepo)
+	muteUser := application.NewMuteUserUseCase(userRepo, eventBus)
+	unmuteUser := application.NewUnmuteUserUseCase(userRepo, eventBus)
+	listMutedUsers := application.NewListMutedUsersUseCase(userRepo)
+	getFollowRequests := application.NewGetFollowRequestsUseCase(userRepo, followRequestRepo)
+	resolveFollowRequest := application.NewResolveFollowRequestUseCase(userRepo, followRequestRepo, eventBus)
+	getUserTweets := application.NewGetUserTweetsUseCase(tweetRepo, userRepo, pollVoteRepo)
+	setAccountPrivacy := application.NewSetAccountPrivacyUseCase(userRepo)
+	createList := application.NewCreateListUseCase(listRepo, userRepo)
+	updateList := application.NewUpdateListUseCase(listRepo)
+	deleteList := application.NewDeleteListUseCase(listRepo)
+	addListMember := application.NewAddListMemberUseCase(listRepo, userRepo)
+	removeListMember := application.NewRemoveListMemberUseCase(listRepo)
+	getList := application.NewGetListUseCase(listRepo)
+	getUserLists := application.NewGetUserListsUseCase(listRepo, userRepo)
+	getListTimeline := application.NewGetListTimelineUseCase(listRepo, tweetRepo, userRepo, pollVoteRepo, logger)
+	bookmarkTweet := application.NewBookmarkTweetUseCase(bookmarkRepo, tweetRepo, userRepo)
+	removeBookmark := application.NewRemoveBookmarkUseCase(bookmarkRepo)
+	getBookmarks := application.NewGetBookmarksUseCase(bookmarkRepo, tweetRepo, userRepo, pollVoteRepo)
+	scheduleTweet := application.NewScheduleTweetUseCase(scheduledTweetRepo, userRepo, mediaRepo)
+	getScheduledTweets := application.NewGetScheduledTweetsUseCase(scheduledTweetRepo, userRepo)
+	updateScheduledTweet := application.NewUpdateScheduledTweetUseCase(scheduledTweetRepo)
+	cancelScheduledTweet := application.NewCancelScheduledTweetUseCase(scheduledTweetRepo)
+	uploadMedia := application.NewUploadMediaUseCase(mediaRepo, userRepo, blobStore)
+	getMedia := application.NewGetMediaUseCase(mediaRepo, blobStore)
+	votePoll := application.NewVotePollUseCase(pollVoteRepo, tweetRepo, userRepo)
+	exportAccount := application.NewExportAccountUseCase(accountExportRepo, userRepo, tweetRepo, bookmarkRepo, blobStore, logger)
+	getAccountExport := application.NewGetAccountExportUseCase(accountExportRepo, blobStore)
+	tweetScheduler := application.NewTweetScheduler(scheduledTweetRepo, tweetRepo, createTweet, 5*time.Second, time.Minute, 100, logger)
+	deleteUser := application.NewDeleteUserUseCase(userRepo, accountDeletionRepo, envDuration("ACCOUNT_DELETION_GRACE", 30*24*time.Hour))
+	getAccountDeletion := application.NewGetAccountDeletionUseCase(accountDeletionRepo)
+	accountPurger := application.NewAccountPurger(accountDeletionRepo, application.PurgeRepositories{
+		Users:           userRepo,
+		Tweets:          tweetRepo,
+		ScheduledTweets: scheduledTweetRepo,
+		Media:           mediaRepo,
+		Bookmarks:       bookmarkRepo,
+		PollVotes:       pollVoteRepo,
+		FollowRequests:  followRequestRepo,
+		Notifications:   notificationRepo,
+		Lists:           listRepo,
+		Exports:         accountExportRepo,
+	}, blobStore, eventBus, time.Minute, 5*time.Minute, 10, logger)
+
+	// Subscribing to domain events
+	eventBus.Subscribe(func(ctx context.Context, event domain.Event) {
+		if err := buildNotifications.Execute(ctx, event); err != nil {
+			logger.ErrorContext(ctx, "building notifications failed", "event", event.EventName(), "error", err)
+		}
+	})
+	eventBus.Subscribe(timelineHub.Handle)
+	eventBus.Subscribe(liveHub.Handle)
+	eventBus.Subscribe(func(ctx context.Context, event domain.Event) {
+		if err := indexTweets.Execute(ctx, event); err != nil {
+			logger.ErrorContext(ctx, "indexing event failed", "event", event.EventName(), "error", err)
+		}
+	})
+	eventBus.Subscribe(trendTracker.Handle)
+	eventBus.Subscribe(suggestUsers.Handle)
 
 	// Creating Controllers
-	tweetController := interfaces.NewTweetController(createTweet)
-	userController := interfaces.NewUserController(followUser, getTimeline, loadUsersUseCase)
+	tweetController := interfaces.NewTweetController(appTracing.CreateTweet(appMetrics.CreateTweet(createTweet)), appTracing.ScheduleTweet(appMetrics.ScheduleTweet(scheduleTweet)))
+	userController := interfaces.NewUserController(appTracing.FollowUser(appMetrics.FollowUser(followUser)), appTracing.GetTimeline(appMetrics.GetTimeline(getTimeline)))
+	notificationController := interfaces.NewNotificationController(appTracing.GetNotifications(appMetrics.GetNotifications(getNotifications)), appTracing.MarkNotificationsRead(appMetrics.MarkNotificationsRead(markNotificationsRead)))
+	timelineStreamController := interfaces.NewTimelineStreamController(timelineHub, 15*time.Second)
+	searchController := interfaces.NewSearchController(appTracing.SearchTweets(appMetrics.SearchTweets(searchTweets)))
+	trendController := interfaces.NewTrendController(appTracing.GetTrends(appMetrics.GetTrends(trendTracker)))
+	suggestionController := interfaces.NewSuggestionController(appTracing.SuggestUsers(appMetrics.SuggestUsers(suggestUsers)))
+	relationshipController := interfaces.NewRelationshipController(appTracing.BlockUser(appMetrics.BlockUser(blockUser)), appTracing.UnblockUser(appMetrics.UnblockUser(unblockUser)), appTracing.ListBlockedUsers(appMetrics.ListBlockedUsers(listBlockedUsers)), appTracing.MuteUser(appMetrics.MuteUser(muteUser)), appTracing.UnmuteUser(appMetrics.UnmuteUser(unmuteUser)), appTracing.ListMutedUsers(appMetrics.ListMutedUsers(listMutedUsers)))
+	followRequestController := interfaces.NewFollowRequestController(appTracing.GetFollowRequests(appMetrics.GetFollowRequests(getFollowRequests)), appTracing.ResolveFollowRequest(appMetrics.ResolveFollowRequest(resolveFollowRequest)))
+	profileController := interfaces.NewProfileController(appTracing.GetUserTweets(appMetrics.GetUserTweets(getUserTweets)), appTracing.SetAccountPrivacy(appMetrics.SetAccountPrivacy(setAccountPrivacy)))
+	listController := interfaces.NewListController(appTracing.CreateList(appMetrics.CreateList(createList)), appTracing.UpdateList(appMetrics.UpdateList(updateList)), appTracing.DeleteList(appMetrics.DeleteList(deleteList)), appTracing.AddListMember(appMetrics.AddListMember(addListMember)), appTracing.RemoveListMember(appMetrics.RemoveListMember(removeListMember)), appTracing.GetList(appMetrics.GetList(getList)), appTracing.GetUserLists(appMetrics.GetUserLists(getUserLists)), appTracing.GetListTimeline(appMetrics.GetListTimeline(getListTimeline)))
+	bookmarkController := interfaces.NewBookmarkController(appTracing.BookmarkTweet(appMetrics.BookmarkTweet(bookmarkTweet)), appTracing.RemoveBookmark(appMetrics.RemoveBookmark(removeBookmark)), appTracing.GetBookmarks(appMetrics.GetBookmarks(getBookmarks)))
+	scheduledTweetController := interfaces.NewScheduledTweetController(appTracing.GetScheduledTweets(appMetrics.GetScheduledTweets(getScheduledTweets)), appTracing.UpdateScheduledTweet(appMetrics.UpdateScheduledTweet(updateScheduledTweet)), appTracing.CancelScheduledTweet(appMetrics.CancelScheduledTweet(cancelScheduledTweet)))
+	mediaController := interfaces.NewMediaController(appTracing.UploadMedia(appMetrics.UploadMedia(uploadMedia)), appTracing.GetMedia(appMetrics.GetMedia(getMedia)))
+	pollController := interfaces.NewPollController(appTracing.VotePoll(appMetrics.VotePoll(votePoll)))
+	importController := interfaces.NewImportController(appTracing.ImportUsers(appMetrics.ImportUsers(importUsers)), appTracing.GetImportJob(appMetrics.GetImportJob(getImportJob)))
+	exportController := interfaces.NewExportController(appTracing.ExportAccount(appMetrics.ExportAccount(exportAccount)), appTracing.GetAccountExport(appMetrics.GetAccountExport(getAccountExport)))
+	accountDeletionController := interfaces.NewAccountDeletionController(appTracing.DeleteUser(appMetrics.DeleteUser(deleteUser)), appTracing.GetAccountDeletion(appMetrics.GetAccountDeletion(getAccountDeletion)))
+	webSocketController := interfaces.NewWebSocketController(timelineHub, liveHub, interfaces.WebSocketConfig{
+		MaxConnections: envInt("WS_MAX_CONNECTIONS", 1000),
+		SendBuffer:     64,
+		PingInterval:   30 * time.Second,
+		PongWait:       60 * time.Second,
+		WriteWait:      10 * time.Second,
+	})
+
+	var closers []func(ctx context.Context) error
+	if kafkaWriter != nil {
+		closers = append(closers, func(context.Context) error { return kafkaWriter.Close() })
+	}
+	if mongoClient != nil {
+		closers = append(closers, mongoClient.Disconnect)
+	}
+	closers = append(closers, appTracing.Shutdown)
 
 	deps := &Dependencies{
-		TweetController: tweetController,
-		UserController:  userController,
+		TweetController:           tweetController,
+		UserController:            userController,
+		NotificationController:    notificationController,
+		TimelineStreamController:  timelineStreamController,
+		WebSocketController:       webSocketController,
+		SearchController:          searchController,
+		TrendController:           trendController,
+		SuggestionController:      suggestionController,
+		RelationshipController:    relationshipController,
+		FollowRequestController:   followRequestController,
+		ProfileController:         profileController,
+		ListController:            listController,
+		BookmarkController:        bookmarkController,
+		ScheduledTweetController:  scheduledTweetController,
+		MediaController:           mediaController,
+		PollController:            pollController,
+		TweetScheduler:            tweetScheduler,
+		ImportController:          importController,
+		ImportUsers:               importUsers,
+		GetImportJob:              getImportJob,
+		ExportController:          exportController,
+		ExportUsers:               exportUsers,
+		Metrics:                   appMetrics,
+		Tracing:                   appTracing,
+		AccountDeletionController: accountDeletionController,
+		AccountPurger:             accountPurger,
+		Health:                    appHealth,
+		closers:                   closers,
 	}
 
 	return deps, nil
 }
+
+// envInt reads an integer from the environment, falling back to def when the
+// variable is unset or invalid.
+func envInt(name string, def int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// envString reads a string from the environment, falling back to def when the
+// variable is unset.
+func envString(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
+
+// envDuration reads a duration such as "720h" from the environment, falling
+// back to def when the variable is unset or invalid.
+func envDuration(name string, def time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(name))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// newLogger logs as LOG_FORMAT says, "json" or "text" (the default), from
+// LOG_LEVEL up: "debug", "info" (the default), "warn" or "error". Lines go to
+// the standard error, so that they do not end up in the output of
+// export-users.
+func newLogger() *slog.Logger {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(envString("LOG_LEVEL", "info"))); err != nil {
+		level = slog.LevelInfo
+	}
+	return logging.New(os.Stderr, envString("LOG_FORMAT", "text"), level)
+}
+
+// newTracing exports spans as TRACING_EXPORTER says: "otlp" sends them over
+// OTLP/HTTP to OTEL_EXPORTER_OTLP_ENDPOINT, "stdout" prints them, and anything
+// else turns tracing off. Spans are printed to the standard error, so that
+// they do not end up in the output of export-users.
+func newTracing(ctx context.Context) (*tracing.Tracing, error) {
+	switch os.Getenv("TRACING_EXPORTER") {
+	case "otlp":
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return tracing.New(exporter), nil
+	case "stdout":
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stderr), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, err
+		}
+		return tracing.New(exporter), nil
+	default:
+		return tracing.Disabled(), nil
+	}
+}
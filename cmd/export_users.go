@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// exportUsers writes every user and whom they follow to standard output, in
+// a format load-users reads back.
+func exportUsers(logger *slog.Logger, args []string) error {
+	flags := flag.NewFlagSet("export-users", flag.ContinueOnError)
+	format := flags.String("format", string(domain.ImportFormatCSV), "format to write: csv, json, ndjson or edges")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		return errors.New("usage: urblog export-users [-format FORMAT] > FILE")
+	}
+	importFormat, err := domain.ParseImportFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	deps, err := InitializeDependencies(logger)
+	if err != nil {
+		return err
+	}
+	// Write what the command queued and export its spans before it exits
+	defer deps.Close(context.Background())
+	out := bufio.NewWriter(os.Stdout)
+	count, err := deps.ExportUsers.Execute(context.Background(), out, importFormat)
+	if err != nil {
+		return err
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+	logger.Info("exported users", "count", count)
+	return nil
+}
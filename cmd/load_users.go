@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+// loadUsers imports users from files on the server. It only reads files
+// under IMPORT_DIR: names are resolved inside that directory, and paths or
+// symlinks leading out of it are refused.
+func loadUsers(logger *slog.Logger, args []string) error {
+	flags := flag.NewFlagSet("load-users", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "check the files without saving any user")
+	format := flags.String("format", "", "format of the files: csv, json, ndjson or edges (by default, taken from their extension)")
+	merge := flags.String("merge", string(domain.ImportMergeFollows), "what to do with users that already exist: replace, merge-follows or skip-existing")
+	dangling := flags.String("dangling", string(domain.ImportDanglingReject), "what to do with followed users that do not exist: reject or create")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	mergeStrategy, err := domain.ParseImportMergeStrategy(*merge)
+	if err != nil {
+		return err
+	}
+	danglingPolicy, err := domain.ParseImportDanglingPolicy(*dangling)
+	if err != nil {
+		return err
+	}
+	dir := os.Getenv("IMPORT_DIR")
+	if dir == "" {
+		return errors.New("IMPORT_DIR must name the directory to load users from")
+	}
+	if flags.NArg() == 0 {
+		return errors.New("usage: urblog load-users [-dry-run] [-format FORMAT] [-merge STRATEGY] [-dangling POLICY] FILE...")
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	deps, err := InitializeDependencies(logger)
+	if err != nil {
+		return err
+	}
+	// Write what the command queued and export its spans before it exits
+	defer deps.Close(context.Background())
+	ctx := context.Background()
+	for _, name := range flags.Args() {
+		options := domain.ImportOptions{Merge: mergeStrategy, Dangling: danglingPolicy, DryRun: *dryRun}
+		if *format != "" {
+			options.Format, err = domain.ParseImportFormat(*format)
+		} else {
+			options.Format, err = domain.DetectImportFormat(name, "")
+		}
+		if err != nil {
+			return err
+		}
+		file, err := root.Open(name)
+		if err != nil {
+			return err
+		}
+		job, err := deps.ImportUsers.Import(ctx, file, options)
+		file.Close()
+		if err != nil {
+			return err
+		}
+		logger.InfoContext(ctx, "imported users", "file", name, "accepted", job.Accepted, "skipped", job.Skipped, "failed", job.Failed, "created", job.Created)
+		if err := logRejectedRows(ctx, logger, deps.GetImportJob, job.ID); err != nil {
+			return err
+		}
+		if job.Status == domain.ImportJobFailed {
+			return errors.New(job.Error)
+		}
+	}
+	return nil
+}
+
+// logRejectedRows logs the skipped and failed rows of a job with their
+// reasons.
+func logRejectedRows(ctx context.Context, logger *slog.Logger, getImportJob application.GetImportJob, jobID string) error {
+	const pageSize = 1000
+	for offset := 0; ; offset += pageSize {
+		_, rows, err := getImportJob.Execute(ctx, jobID, pageSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if row.Status != domain.ImportRowAccepted {
+				logger.WarnContext(ctx, "rejected row", "line", row.Line, "status", row.Status, "reason", row.Reason)
+			}
+		}
+		if len(rows) < pageSize {
+			return nil
+		}
+	}
+}
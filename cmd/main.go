@@ -1,18 +1,40 @@
 package main
 
 import (
-	"log"
-	"net/http"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
-	server, err := InitializeServer()
-	if err != nil {
-		log.Fatal(err)
+	logger := newLogger()
+
+	if len(os.Args) > 1 {
+		var err error
+		switch os.Args[1] {
+		case "load-users":
+			err = loadUsers(logger, os.Args[2:])
+		case "export-users":
+			err = exportUsers(logger, os.Args[2:])
+		default:
+			err = fmt.Errorf("unknown command %q", os.Args[1])
+		}
+		if err != nil {
+			logger.Error("command failed", "command", os.Args[1], "error", err)
+			os.Exit(1)
+		}
+		return
 	}
 
-	// Iniciar servidor
-	addr := ":8080"
-	log.Printf("Iniciando servidor en %s", addr)
-	log.Fatal(http.ListenAndServe(addr, server))
+	// Shut down on Ctrl+C and on the SIGTERM of Docker and Kubernetes. A
+	// second signal stops the process at once.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	context.AfterFunc(ctx, stop)
+
+	if err := runServer(ctx, logger, ":8080"); err != nil {
+		logger.Error("server failed", "error", err)
+		os.Exit(1)
+	}
 }
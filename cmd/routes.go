@@ -8,5 +8,49 @@ func ConfigureRoutes(mux *http.ServeMux, deps *Dependencies) {
 	mux.HandleFunc("/tweets", deps.TweetController.CreateTweet)
 	mux.HandleFunc("/follow", deps.UserController.FollowUser)
 	mux.HandleFunc("/timeline", deps.UserController.GetTimeline)
-	mux.HandleFunc("/load-users", deps.UserController.LoadUsers)
+	mux.Handle("GET /metrics", deps.Metrics.Handler())
+	mux.HandleFunc("GET /healthz", deps.Health.Live)
+	mux.HandleFunc("GET /readyz", deps.Health.Ready)
+	mux.HandleFunc("POST /imports/users", deps.ImportController.ImportUsers)
+	mux.HandleFunc("GET /imports/{id}", deps.ImportController.GetImportJob)
+	mux.HandleFunc("GET /users/{id}/export", deps.ExportController.ExportAccount)
+	mux.HandleFunc("GET /exports/{id}", deps.ExportController.GetAccountExport)
+	mux.HandleFunc("GET /exports/{id}/archive", deps.ExportController.DownloadAccountExport)
+	mux.HandleFunc("DELETE /users/{id}", deps.AccountDeletionController.DeleteUser)
+	mux.HandleFunc("GET /users/{id}/deletion", deps.AccountDeletionController.GetAccountDeletion)
+	mux.HandleFunc("GET /notifications", deps.NotificationController.GetNotifications)
+	mux.HandleFunc("POST /notifications/read", deps.NotificationController.MarkNotificationsRead)
+	mux.HandleFunc("GET /users/{id}/timeline/stream", deps.TimelineStreamController.StreamTimeline)
+	mux.HandleFunc("GET /ws", deps.WebSocketController.Connect)
+	mux.HandleFunc("GET /search/tweets", deps.SearchController.SearchTweets)
+	mux.HandleFunc("GET /trends", deps.TrendController.GetTrends)
+	mux.HandleFunc("GET /users/{id}/suggestions", deps.SuggestionController.GetSuggestions)
+	mux.HandleFunc("GET /users/{id}/blocks", deps.RelationshipController.GetBlocks)
+	mux.HandleFunc("POST /users/{id}/blocks", deps.RelationshipController.Block)
+	mux.HandleFunc("DELETE /users/{id}/blocks/{target}", deps.RelationshipController.Unblock)
+	mux.HandleFunc("GET /users/{id}/mutes", deps.RelationshipController.GetMutes)
+	mux.HandleFunc("POST /users/{id}/mutes", deps.RelationshipController.Mute)
+	mux.HandleFunc("DELETE /users/{id}/mutes/{target}", deps.RelationshipController.Unmute)
+	mux.HandleFunc("GET /users/{id}/follow-requests", deps.FollowRequestController.GetFollowRequests)
+	mux.HandleFunc("POST /follow-requests/{id}/{action}", deps.FollowRequestController.ResolveFollowRequest)
+	mux.HandleFunc("GET /users/{id}/tweets", deps.ProfileController.GetUserTweets)
+	mux.HandleFunc("PUT /users/{id}/privacy", deps.ProfileController.SetPrivacy)
+	mux.HandleFunc("POST /lists", deps.ListController.CreateList)
+	mux.HandleFunc("GET /users/{id}/lists", deps.ListController.GetUserLists)
+	mux.HandleFunc("GET /lists/{id}", deps.ListController.GetList)
+	mux.HandleFunc("PATCH /lists/{id}", deps.ListController.UpdateList)
+	mux.HandleFunc("DELETE /lists/{id}", deps.ListController.DeleteList)
+	mux.HandleFunc("POST /lists/{id}/members", deps.ListController.AddMember)
+	mux.HandleFunc("DELETE /lists/{id}/members/{member}", deps.ListController.RemoveMember)
+	mux.HandleFunc("GET /lists/{id}/timeline", deps.ListController.GetListTimeline)
+	mux.HandleFunc("POST /tweets/{id}/bookmark", deps.BookmarkController.Bookmark)
+	mux.HandleFunc("DELETE /tweets/{id}/bookmark", deps.BookmarkController.RemoveBookmark)
+	mux.HandleFunc("GET /bookmarks", deps.BookmarkController.GetBookmarks)
+	mux.HandleFunc("GET /users/{id}/scheduled-tweets", deps.ScheduledTweetController.GetScheduledTweets)
+	mux.HandleFunc("PATCH /scheduled-tweets/{id}", deps.ScheduledTweetController.UpdateScheduledTweet)
+	mux.HandleFunc("DELETE /scheduled-tweets/{id}", deps.ScheduledTweetController.CancelScheduledTweet)
+	mux.HandleFunc("POST /media", deps.MediaController.UploadMedia)
+	mux.HandleFunc("GET /media/{id}", deps.MediaController.GetMedia)
+	mux.HandleFunc("GET /media/{id}/thumbnail", deps.MediaController.GetThumbnail)
+	mux.HandleFunc("POST /tweets/{id}/poll/votes", deps.PollController.Vote)
 }
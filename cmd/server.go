@@ -1,21 +1,24 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	_ "github.com/pedro00627/urblog/docs"
+	"github.com/pedro00627/urblog/infrastructure/logging"
 )
 
-func InitializeServer() (*http.ServeMux, error) {
-	// Load dependencies
-	deps, err := InitializeDependencies()
-	if err != nil {
-		log.Printf("Error al cargar dependencias: %v", err)
-		return nil, err
-	}
+// closeTimeout bounds how long closing the dependencies may take once the
+// server has drained.
+const closeTimeout = 10 * time.Second
 
+func InitializeServer(deps *Dependencies) http.Handler {
 	// Configure routes
 	mux := http.NewServeMux()
 	ConfigureRoutes(mux, deps)
@@ -30,5 +33,75 @@ func InitializeServer() (*http.ServeMux, error) {
 	sh := middleware.SwaggerUI(opts, nil)
 	mux.Handle("/docs", sh)
 
-	return mux, nil
+	// Give every request an ID, and measure and trace it. Tracing goes
+	// outside metrics, since the request it hands on is the one the routes
+	// are matched on.
+	return logging.Middleware(deps.Tracing.Middleware(deps.Metrics.Middleware(mux)))
+}
+
+// runServer serves on addr until ctx is done. It then fails readiness and
+// keeps serving for SHUTDOWN_DELAY (none by default), so that orchestrators
+// stop sending requests, lets the requests in flight finish for up to
+// SHUTDOWN_TIMEOUT (15s by default), stops the background workers and closes
+// the dependencies, in that order. Timeline streams and WebSockets, which
+// never end on their own, are ended as soon as the server stops accepting
+// connections; requests still open when the timeout is over are cut.
+func runServer(ctx context.Context, logger *slog.Logger, addr string) error {
+	deps, err := InitializeDependencies(logger)
+	if err != nil {
+		return fmt.Errorf("loading dependencies: %w", err)
+	}
+
+	// Publish scheduled tweets and purge deleted accounts once their grace
+	// period is over, in the background
+	workers, stopWorkers := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		deps.TweetScheduler.Run(workers)
+	}()
+	go func() {
+		defer wg.Done()
+		deps.AccountPurger.Run(workers)
+	}()
+
+	server := &http.Server{Addr: addr, Handler: InitializeServer(deps)}
+	server.RegisterOnShutdown(deps.TimelineStreamController.Shutdown)
+	server.RegisterOnShutdown(deps.WebSocketController.Shutdown)
+	served := make(chan error, 1)
+	go func() {
+		served <- server.ListenAndServe()
+	}()
+	logger.Info("starting server", "addr", addr)
+
+	select {
+	case err = <-served:
+	case <-ctx.Done():
+		delay := envDuration("SHUTDOWN_DELAY", 0)
+		timeout := envDuration("SHUTDOWN_TIMEOUT", 15*time.Second)
+		logger.Info("shutting down server", "delay", delay.String(), "timeout", timeout.String())
+		deps.Health.ShutDown()
+		time.Sleep(delay)
+		drainCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		if err := server.Shutdown(drainCtx); err != nil {
+			logger.Warn("draining requests timed out", "error", err)
+			server.Close()
+		}
+		// The server does not wait for WebSockets, which it hands over
+		if err := deps.WebSocketController.Wait(drainCtx); err != nil {
+			logger.Warn("closing WebSockets timed out", "error", err)
+		}
+		cancel()
+	}
+
+	stopWorkers()
+	wg.Wait()
+	closeCtx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	if closeErr := deps.Close(closeCtx); closeErr != nil {
+		err = errors.Join(err, fmt.Errorf("closing dependencies: %w", closeErr))
+	}
+	logger.Info("server stopped")
+	return err
 }
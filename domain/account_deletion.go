@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type AccountDeletionStatus string
+
+const (
+	// AccountDeletionPending deletions wait for their grace period to end.
+	AccountDeletionPending   AccountDeletionStatus = "pending"
+	AccountDeletionPurging   AccountDeletionStatus = "purging"
+	AccountDeletionCompleted AccountDeletionStatus = "completed"
+)
+
+// PurgeStep is one kind of data a purge deletes. Every step can be run again
+// without harm, in case a purge stops before recording it.
+type PurgeStep string
+
+const (
+	// PurgeEvent publishes UserDeleted.
+	PurgeEvent           PurgeStep = "event"
+	PurgeTweets          PurgeStep = "tweets"
+	PurgeScheduledTweets PurgeStep = "scheduled_tweets"
+	PurgeMedia           PurgeStep = "media"
+	PurgeBookmarks       PurgeStep = "bookmarks"
+	PurgePollVotes       PurgeStep = "poll_votes"
+	// PurgeFollows removes the user from whom others follow, block and mute,
+	// along with follow requests from and to them.
+	PurgeFollows       PurgeStep = "follows"
+	PurgeNotifications PurgeStep = "notifications"
+	PurgeLists         PurgeStep = "lists"
+	PurgeExports       PurgeStep = "exports"
+	PurgeUser          PurgeStep = "user"
+)
+
+// PurgeSteps are the steps of a purge, in the order they run. The user goes
+// last, so that whom they follow is gone only once the rest is.
+var PurgeSteps = []PurgeStep{
+	PurgeEvent,
+	PurgeTweets,
+	PurgeScheduledTweets,
+	PurgeMedia,
+	PurgeBookmarks,
+	PurgePollVotes,
+	PurgeFollows,
+	PurgeNotifications,
+	PurgeLists,
+	PurgeExports,
+	PurgeUser,
+}
+
+// AccountDeletion tracks the deletion of a user, from the request to the end
+// of the purge of their data. A purge records each step as it finishes, so
+// that one that stops halfway resumes with the next step.
+type AccountDeletion struct {
+	UserID      string
+	Status      AccountDeletionStatus
+	RequestedAt time.Time
+	PurgeAt     time.Time
+	Done        []PurgeStep
+	// LeaseOwner is the purger working on the deletion until LeaseUntil.
+	LeaseOwner string
+	LeaseUntil time.Time
+	// Error is why the last attempt at a step failed.
+	Error      string
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+}
+
+func NewAccountDeletion(userID string, now time.Time, gracePeriod time.Duration) *AccountDeletion {
+	return &AccountDeletion{
+		UserID:      userID,
+		Status:      AccountDeletionPending,
+		RequestedAt: now,
+		PurgeAt:     now.Add(gracePeriod),
+		Done:        []PurgeStep{},
+		UpdatedAt:   now,
+	}
+}
+
+// Due reports whether d should be claimed for purging at now: its grace
+// period is over, or the lease of a previous claim expired.
+func (d *AccountDeletion) Due(now time.Time) bool {
+	switch d.Status {
+	case AccountDeletionPending:
+		return !d.PurgeAt.After(now)
+	case AccountDeletionPurging:
+		return d.LeaseUntil.Before(now)
+	}
+	return false
+}
+
+// Claim moves d to purging on behalf of owner until leaseUntil.
+func (d *AccountDeletion) Claim(owner string, leaseUntil, now time.Time) {
+	d.Status = AccountDeletionPurging
+	d.LeaseOwner = owner
+	d.LeaseUntil = leaseUntil
+	d.UpdatedAt = now
+}
+
+// NextStep returns the first step not done yet, or false if none is left.
+func (d *AccountDeletion) NextStep() (PurgeStep, bool) {
+	for _, step := range PurgeSteps {
+		if !slices.Contains(d.Done, step) {
+			return step, true
+		}
+	}
+	return "", false
+}
+
+func (d *AccountDeletion) StepDone(step PurgeStep, now time.Time) {
+	if !slices.Contains(d.Done, step) {
+		d.Done = append(d.Done, step)
+	}
+	d.Error = ""
+	d.UpdatedAt = now
+}
+
+func (d *AccountDeletion) StepFailed(err error, now time.Time) {
+	d.Error = err.Error()
+	d.UpdatedAt = now
+}
+
+func (d *AccountDeletion) Complete(now time.Time) {
+	d.Status = AccountDeletionCompleted
+	d.LeaseOwner = ""
+	d.LeaseUntil = time.Time{}
+	d.UpdatedAt = now
+	d.FinishedAt = now
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountDeletion(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	deletion := NewAccountDeletion("user1", now, 24*time.Hour)
+	assert.Equal(t, AccountDeletionPending, deletion.Status)
+	assert.Equal(t, now.Add(24*time.Hour), deletion.PurgeAt)
+	assert.False(t, deletion.Due(now))
+	assert.True(t, deletion.Due(now.Add(24*time.Hour)))
+
+	step, ok := deletion.NextStep()
+	assert.True(t, ok)
+	assert.Equal(t, PurgeEvent, step)
+
+	deletion.Claim("purger1", now.Add(25*time.Hour), now.Add(24*time.Hour))
+	assert.Equal(t, AccountDeletionPurging, deletion.Status)
+	assert.False(t, deletion.Due(now.Add(24*time.Hour)))
+	assert.True(t, deletion.Due(now.Add(26*time.Hour)), "due again once the lease expires")
+
+	deletion.StepFailed(errors.New("tweets: timeout"), now)
+	assert.Equal(t, "tweets: timeout", deletion.Error)
+	deletion.StepDone(PurgeEvent, now)
+	deletion.StepDone(PurgeEvent, now)
+	assert.Equal(t, []PurgeStep{PurgeEvent}, deletion.Done)
+	assert.Empty(t, deletion.Error)
+	step, _ = deletion.NextStep()
+	assert.Equal(t, PurgeTweets, step)
+
+	for _, step := range PurgeSteps {
+		deletion.StepDone(step, now)
+	}
+	_, ok = deletion.NextStep()
+	assert.False(t, ok)
+
+	deletion.Complete(now.Add(time.Hour))
+	assert.Equal(t, AccountDeletionCompleted, deletion.Status)
+	assert.Equal(t, now.Add(time.Hour), deletion.FinishedAt)
+	assert.Empty(t, deletion.LeaseOwner)
+	assert.False(t, deletion.Due(now.Add(48*time.Hour)))
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"slices"
+	"time"
+)
+
+type AccountExportStatus string
+
+const (
+	AccountExportPending   AccountExportStatus = "pending"
+	AccountExportRunning   AccountExportStatus = "running"
+	AccountExportCompleted AccountExportStatus = "completed"
+	AccountExportFailed    AccountExportStatus = "failed"
+)
+
+// AccountExport builds the archive of a large account in the background. The
+// archive itself lives in a blob store, under ArchiveKey.
+type AccountExport struct {
+	ID         string
+	UserID     string
+	Status     AccountExportStatus
+	Size       int
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+}
+
+func NewAccountExport(id, userID string, now time.Time) *AccountExport {
+	return &AccountExport{
+		ID:        id,
+		UserID:    userID,
+		Status:    AccountExportPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (e *AccountExport) ArchiveKey() string {
+	return "exports/" + e.ID + ".zip"
+}
+
+func (e *AccountExport) Start(now time.Time) {
+	e.Status = AccountExportRunning
+	e.UpdatedAt = now
+}
+
+func (e *AccountExport) Complete(size int, now time.Time) {
+	e.Status = AccountExportCompleted
+	e.Size = size
+	e.UpdatedAt = now
+	e.FinishedAt = now
+}
+
+func (e *AccountExport) Fail(reason string, now time.Time) {
+	e.Status = AccountExportFailed
+	e.Error = reason
+	e.UpdatedAt = now
+	e.FinishedAt = now
+}
+
+func (e *AccountExport) Finished() bool {
+	return e.Status == AccountExportCompleted || e.Status == AccountExportFailed
+}
+
+// ArchiveFilename is the name an account archive is downloaded as.
+func ArchiveFilename(userID string) string {
+	return "urblog-" + userID + ".zip"
+}
+
+// AccountArchive is everything an account holds. Media are referenced by ID
+// rather than copied, as they can be downloaded from /media/{id}.
+type AccountArchive struct {
+	User       *User
+	Tweets     []*Tweet
+	Bookmarks  []*Bookmark
+	ExportedAt time.Time
+}
+
+type archiveProfile struct {
+	ID          string    `json:"id"`
+	Username    string    `json:"username"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Protected   bool      `json:"protected"`
+	ExportedAt  time.Time `json:"exported_at"`
+}
+
+type archiveFollows struct {
+	Following []string `json:"following"`
+	Blocked   []string `json:"blocked"`
+	Muted     []string `json:"muted"`
+}
+
+type archiveMedia struct {
+	ID          string `json:"id"`
+	TweetID     string `json:"tweet_id"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	URL         string `json:"url"`
+}
+
+type archivePoll struct {
+	Options []string  `json:"options"`
+	EndsAt  time.Time `json:"ends_at"`
+}
+
+type archiveTweet struct {
+	ID        string       `json:"id"`
+	Content   string       `json:"content"`
+	MediaIDs  []string     `json:"media_ids,omitempty"`
+	Poll      *archivePoll `json:"poll,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type archiveBookmark struct {
+	TweetID   string    `json:"tweet_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WriteZip writes the archive as a zip of JSON files: profile.json,
+// tweets.json, follows.json, bookmarks.json and media.json. urblog has no
+// likes; bookmarks are what a user keeps of others' tweets.
+func (a *AccountArchive) WriteZip(w io.Writer) error {
+	tweets := make([]archiveTweet, len(a.Tweets))
+	media := []archiveMedia{}
+	for i, tweet := range a.Tweets {
+		tweets[i] = archiveTweet{ID: tweet.ID, Content: tweet.Content, CreatedAt: tweet.Timestamp}
+		for _, m := range tweet.Media {
+			tweets[i].MediaIDs = append(tweets[i].MediaIDs, m.ID)
+			media = append(media, archiveMedia{
+				ID:          m.ID,
+				TweetID:     tweet.ID,
+				ContentType: m.ContentType,
+				Width:       m.Width,
+				Height:      m.Height,
+				URL:         "/media/" + m.ID,
+			})
+		}
+		if tweet.Poll != nil {
+			tweets[i].Poll = &archivePoll{Options: tweet.Poll.Options, EndsAt: tweet.Poll.EndsAt}
+		}
+	}
+	bookmarks := make([]archiveBookmark, len(a.Bookmarks))
+	for i, bookmark := range a.Bookmarks {
+		bookmarks[i] = archiveBookmark{TweetID: bookmark.TweetID, CreatedAt: bookmark.CreatedAt}
+	}
+
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", archiveProfile{
+			ID:          a.User.ID,
+			Username:    a.User.Username,
+			DisplayName: a.User.DisplayName,
+			Protected:   a.User.Protected,
+			ExportedAt:  a.ExportedAt,
+		}},
+		{"tweets.json", tweets},
+		{"follows.json", archiveFollows{
+			Following: sortedKeys(a.User.Following),
+			Blocked:   sortedKeys(a.User.Blocked),
+			Muted:     sortedKeys(a.User.Muted),
+		}},
+		{"bookmarks.json", bookmarks},
+		{"media.json", media},
+	}
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		fw, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: a.ExportedAt,
+		})
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(fw)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// sortedKeys returns the members of set in order, so that exports are
+// stable.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key, ok := range set {
+		if ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
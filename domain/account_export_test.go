@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountExport(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	export := NewAccountExport("export1", "user1", now)
+	assert.Equal(t, AccountExportPending, export.Status)
+	assert.Equal(t, "exports/export1.zip", export.ArchiveKey())
+
+	export.Start(now)
+	assert.Equal(t, AccountExportRunning, export.Status)
+	assert.False(t, export.Finished())
+
+	export.Complete(42, now.Add(time.Second))
+	assert.True(t, export.Finished())
+	assert.Equal(t, 42, export.Size)
+	assert.Equal(t, now.Add(time.Second), export.FinishedAt)
+
+	failed := NewAccountExport("export2", "user1", now)
+	failed.Fail("disk full", now)
+	assert.True(t, failed.Finished())
+	assert.Equal(t, AccountExportFailed, failed.Status)
+	assert.Equal(t, "disk full", failed.Error)
+}
+
+func TestAccountArchive_WriteZip(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	user := NewUser("user1", "ana")
+	user.Following["user2"] = true
+	user.Muted["user3"] = true
+	archive := AccountArchive{
+		User: user,
+		Tweets: []*Tweet{
+			{ID: "tweet1", UserID: "user1", Content: "hello", Timestamp: now,
+				Media: []TweetMedia{{ID: "media1", ContentType: "image/png", Width: 10, Height: 20}}},
+			{ID: "tweet2", UserID: "user1", Content: "which?", Timestamp: now,
+				Poll: &Poll{Options: []string{"a", "b"}, EndsAt: now.Add(time.Hour)}},
+		},
+		Bookmarks:  []*Bookmark{{UserID: "user1", TweetID: "tweet9", CreatedAt: now}},
+		ExportedAt: now,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.WriteZip(&buf))
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		files[file.Name] = string(data)
+	}
+	assert.Len(t, files, 5)
+	assert.JSONEq(t, `{"id":"user1","username":"ana","protected":false,"exported_at":"2025-03-04T12:00:00Z"}`, files["profile.json"])
+	assert.JSONEq(t, `[
+		{"id":"tweet1","content":"hello","media_ids":["media1"],"created_at":"2025-03-04T12:00:00Z"},
+		{"id":"tweet2","content":"which?","poll":{"options":["a","b"],"ends_at":"2025-03-04T13:00:00Z"},"created_at":"2025-03-04T12:00:00Z"}
+	]`, files["tweets.json"])
+	assert.JSONEq(t, `{"following":["user2"],"blocked":[],"muted":["user3"]}`, files["follows.json"])
+	assert.JSONEq(t, `[{"tweet_id":"tweet9","created_at":"2025-03-04T12:00:00Z"}]`, files["bookmarks.json"])
+	var media []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(files["media.json"]), &media))
+	require.Len(t, media, 1)
+	assert.Equal(t, "tweet1", media[0]["tweet_id"])
+	assert.Equal(t, "/media/media1", media[0]["url"])
+}
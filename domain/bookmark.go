@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Bookmark saves a tweet for later. Unlike likes, bookmarks are private to
+// the user who made them.
+type Bookmark struct {
+	UserID    string
+	TweetID   string
+	CreatedAt time.Time
+}
+
+func NewBookmark(userID, tweetID string) *Bookmark {
+	return &Bookmark{
+		UserID:    userID,
+		TweetID:   tweetID,
+		CreatedAt: time.Now(),
+	}
+}
+
+// BookmarkCursor identifies the last bookmark of a page; the next page starts
+// right after it, most recent first.
+type BookmarkCursor struct {
+	CreatedAt time.Time `json:"t"`
+	TweetID   string    `json:"id"`
+}
+
+func NewBookmarkCursor(bookmark *Bookmark) BookmarkCursor {
+	return BookmarkCursor{CreatedAt: bookmark.CreatedAt, TweetID: bookmark.TweetID}
+}
+
+func (c BookmarkCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeBookmarkCursor(s string) (*BookmarkCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidBookmarkCursor
+	}
+	var cursor BookmarkCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.TweetID == "" {
+		return nil, ErrInvalidBookmarkCursor
+	}
+	return &cursor, nil
+}
+
+// Covers reports whether bookmark sorts at or before c, that is, whether it
+// was already returned in an earlier page.
+func (c BookmarkCursor) Covers(bookmark *Bookmark) bool {
+	if !bookmark.CreatedAt.Equal(c.CreatedAt) {
+		return bookmark.CreatedAt.After(c.CreatedAt)
+	}
+	return bookmark.TweetID >= c.TweetID
+}
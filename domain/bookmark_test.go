@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkCursor(t *testing.T) {
+	at := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	cursor := NewBookmarkCursor(&Bookmark{UserID: "user1", TweetID: "tweet5", CreatedAt: at})
+
+	decoded, err := DecodeBookmarkCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.True(t, decoded.CreatedAt.Equal(at))
+	assert.Equal(t, "tweet5", decoded.TweetID)
+
+	for _, s := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		_, err := DecodeBookmarkCursor(s)
+		assert.Equal(t, ErrInvalidBookmarkCursor, err, s)
+	}
+
+	tests := []struct {
+		name     string
+		bookmark *Bookmark
+		want     bool
+	}{
+		{name: "newer", bookmark: &Bookmark{TweetID: "tweet1", CreatedAt: at.Add(time.Second)}, want: true},
+		{name: "older", bookmark: &Bookmark{TweetID: "tweet9", CreatedAt: at.Add(-time.Second)}, want: false},
+		{name: "same bookmark", bookmark: &Bookmark{TweetID: "tweet5", CreatedAt: at}, want: true},
+		{name: "same time, higher tweet ID", bookmark: &Bookmark{TweetID: "tweet6", CreatedAt: at}, want: true},
+		{name: "same time, lower tweet ID", bookmark: &Bookmark{TweetID: "tweet4", CreatedAt: at}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cursor.Covers(tt.bookmark))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+type EntityType string
+
+const (
+	EntityMention EntityType = "mention"
+	EntityHashtag EntityType = "hashtag"
+	EntityURL     EntityType = "url"
+)
+
+var tagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@#])([@#][\p{L}\p{N}_]+)`)
+
+// Entity is a mention, hashtag or URL found in tweet content. Start and End
+// are rune offsets into the content; Text includes the leading @ or #.
+type Entity struct {
+	Type   EntityType
+	Text   string
+	Start  int
+	End    int
+	UserID string
+}
+
+// Name returns the entity text without its leading @ or #.
+func (e Entity) Name() string {
+	if e.Type == EntityURL {
+		return e.Text
+	}
+	return e.Text[1:]
+}
+
+// ExtractEntities tokenizes content into mentions, hashtags and URLs, ordered
+// by position. Mentions are returned unresolved, with an empty UserID.
+func ExtractEntities(content string) []Entity {
+	var entities []Entity
+	urls := urlPattern.FindAllStringIndex(content, -1)
+	for _, loc := range urls {
+		entities = append(entities, newEntity(EntityURL, content, loc[0], loc[1]))
+	}
+
+	for _, loc := range tagPattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := loc[2], loc[3]
+		if insideAny(urls, start) {
+			continue
+		}
+		switch content[start] {
+		case '@':
+			entities = append(entities, newEntity(EntityMention, content, start, end))
+		case '#':
+			if hasLetter(content[start+1 : end]) {
+				entities = append(entities, newEntity(EntityHashtag, content, start, end))
+			}
+		}
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Start < entities[j].Start
+	})
+	return entities
+}
+
+func newEntity(entityType EntityType, content string, start, end int) Entity {
+	runeStart := utf8.RuneCountInString(content[:start])
+	return Entity{
+		Type:  entityType,
+		Text:  content[start:end],
+		Start: runeStart,
+		End:   runeStart + utf8.RuneCountInString(content[start:end]),
+	}
+}
+
+func insideAny(spans [][]int, i int) bool {
+	for _, span := range spans {
+		if i >= span[0] && i < span[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractEntities(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Entity
+	}{
+		{
+			name:    "no entities",
+			content: "Hello, world!",
+			want:    nil,
+		},
+		{
+			name:    "mention and hashtag",
+			content: "@user2 mira esto #golang",
+			want: []Entity{
+				{Type: EntityMention, Text: "@user2", Start: 0, End: 6},
+				{Type: EntityHashtag, Text: "#golang", Start: 17, End: 24},
+			},
+		},
+		{
+			name:    "offsets count runes",
+			content: "¡Hola @josé! #café",
+			want: []Entity{
+				{Type: EntityMention, Text: "@josé", Start: 6, End: 11},
+				{Type: EntityHashtag, Text: "#café", Start: 13, End: 18},
+			},
+		},
+		{
+			name:    "url with fragment is not a hashtag",
+			content: "see https://example.com/#top and @a,@b",
+			want: []Entity{
+				{Type: EntityURL, Text: "https://example.com/#top", Start: 4, End: 28},
+				{Type: EntityMention, Text: "@a", Start: 33, End: 35},
+				{Type: EntityMention, Text: "@b", Start: 36, End: 38},
+			},
+		},
+		{
+			name:    "emails and numeric tags are ignored",
+			content: "mail me@example.com about #123",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractEntities(tt.content))
+		})
+	}
+}
+
+func TestEntity_Name(t *testing.T) {
+	assert.Equal(t, "user2", Entity{Type: EntityMention, Text: "@user2"}.Name())
+	assert.Equal(t, "golang", Entity{Type: EntityHashtag, Text: "#golang"}.Name())
+	assert.Equal(t, "https://a.co", Entity{Type: EntityURL, Text: "https://a.co"}.Name())
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// Event is something that happened in the domain and that other parts of the
+// system may react to.
+type Event interface {
+	EventName() string
+	OccurredAt() time.Time
+}
+
+type TweetCreated struct {
+	Tweet *Tweet
+}
+
+func (e TweetCreated) EventName() string     { return "tweet.created" }
+func (e TweetCreated) OccurredAt() time.Time { return e.Tweet.Timestamp }
+
+type UserFollowed struct {
+	FollowerID string
+	FolloweeID string
+	At         time.Time
+}
+
+func (e UserFollowed) EventName() string     { return "user.followed" }
+func (e UserFollowed) OccurredAt() time.Time { return e.At }
+
+type FollowRequested struct {
+	RequestID  string
+	FollowerID string
+	FolloweeID string
+	At         time.Time
+}
+
+func (e FollowRequested) EventName() string     { return "follow.requested" }
+func (e FollowRequested) OccurredAt() time.Time { return e.At }
+
+type UserBlocked struct {
+	BlockerID string
+	BlockedID string
+	At        time.Time
+}
+
+func (e UserBlocked) EventName() string     { return "user.blocked" }
+func (e UserBlocked) OccurredAt() time.Time { return e.At }
+
+type UserUnblocked struct {
+	BlockerID string
+	BlockedID string
+	At        time.Time
+}
+
+func (e UserUnblocked) EventName() string     { return "user.unblocked" }
+func (e UserUnblocked) OccurredAt() time.Time { return e.At }
+
+type UserMuted struct {
+	MuterID string
+	MutedID string
+	At      time.Time
+}
+
+func (e UserMuted) EventName() string     { return "user.muted" }
+func (e UserMuted) OccurredAt() time.Time { return e.At }
+
+type UserUnmuted struct {
+	MuterID string
+	MutedID string
+	At      time.Time
+}
+
+func (e UserUnmuted) EventName() string     { return "user.unmuted" }
+func (e UserUnmuted) OccurredAt() time.Time { return e.At }
+
+// UserDeleted is published when a deleted user is purged, so that whatever
+// was derived from them is purged too.
+type UserDeleted struct {
+	UserID string
+	At     time.Time
+}
+
+func (e UserDeleted) EventName() string     { return "user.deleted" }
+func (e UserDeleted) OccurredAt() time.Time { return e.At }
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+type FollowRequestStatus string
+
+const (
+	FollowRequestRequested FollowRequestStatus = "requested"
+	FollowRequestApproved  FollowRequestStatus = "approved"
+	FollowRequestRejected  FollowRequestStatus = "rejected"
+	FollowRequestCancelled FollowRequestStatus = "cancelled"
+)
+
+// FollowRequest asks a protected account for permission to follow it. A
+// request starts out requested and is then approved or rejected by the
+// followee, or cancelled by the follower.
+type FollowRequest struct {
+	ID         string
+	FollowerID string
+	FolloweeID string
+	Status     FollowRequestStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewFollowRequest(id, followerID, followeeID string) *FollowRequest {
+	now := time.Now()
+	return &FollowRequest{
+		ID:         id,
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Status:     FollowRequestRequested,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Resolve moves a pending request to status on behalf of userID: only the
+// followee may approve or reject it, and only the follower may cancel it.
+// Requests of other users are reported as not found.
+func (r *FollowRequest) Resolve(userID string, status FollowRequestStatus) error {
+	switch status {
+	case FollowRequestApproved, FollowRequestRejected:
+		if userID != r.FolloweeID {
+			return ErrFollowRequestNotFound
+		}
+	case FollowRequestCancelled:
+		if userID != r.FollowerID {
+			return ErrFollowRequestNotFound
+		}
+	default:
+		return ErrInvalidFollowRequestAction
+	}
+	if r.Status != FollowRequestRequested {
+		return ErrInvalidFollowRequestAction
+	}
+	r.Status = status
+	r.UpdatedAt = time.Now()
+	return nil
+}
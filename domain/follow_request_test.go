@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowRequest_Resolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		status     FollowRequestStatus
+		wantErr    error
+		wantStatus FollowRequestStatus
+	}{
+		{name: "followee approves", userID: "user2", status: FollowRequestApproved, wantStatus: FollowRequestApproved},
+		{name: "followee rejects", userID: "user2", status: FollowRequestRejected, wantStatus: FollowRequestRejected},
+		{name: "follower cancels", userID: "user1", status: FollowRequestCancelled, wantStatus: FollowRequestCancelled},
+		{name: "follower cannot approve", userID: "user1", status: FollowRequestApproved, wantErr: ErrFollowRequestNotFound},
+		{name: "followee cannot cancel", userID: "user2", status: FollowRequestCancelled, wantErr: ErrFollowRequestNotFound},
+		{name: "other users cannot reject", userID: "user3", status: FollowRequestRejected, wantErr: ErrFollowRequestNotFound},
+		{name: "back to requested", userID: "user2", status: FollowRequestRequested, wantErr: ErrInvalidFollowRequestAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := NewFollowRequest("request1", "user1", "user2")
+			err := request.Resolve(tt.userID, tt.status)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, FollowRequestRequested, request.Status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, request.Status)
+
+			assert.Equal(t, ErrInvalidFollowRequestAction, request.Resolve(tt.userID, tt.status))
+		})
+	}
+}
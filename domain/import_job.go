@@ -0,0 +1,102 @@
+package domain
+
+import "time"
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+type ImportRowStatus string
+
+const (
+	ImportRowAccepted ImportRowStatus = "accepted"
+	ImportRowSkipped  ImportRowStatus = "skipped"
+	ImportRowFailed   ImportRowStatus = "failed"
+)
+
+// ImportRow reports what an import did with one line of its file.
+type ImportRow struct {
+	JobID  string
+	Line   int
+	Status ImportRowStatus
+	UserID string
+	Reason string
+}
+
+// ImportJob imports users in the background. The job itself only holds the
+// counts; the report of each row is stored apart, as it may be long.
+type ImportJob struct {
+	ID        string
+	Status    ImportJobStatus
+	Options   ImportOptions
+	TotalRows int
+	Processed int
+	Accepted  int
+	Skipped   int
+	Failed    int
+	// Created counts the users created because the file follows them.
+	Created    int
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+}
+
+func NewImportJob(id string, options ImportOptions, totalRows int, now time.Time) *ImportJob {
+	if options.Merge == "" {
+		options.Merge = ImportMergeFollows
+	}
+	if options.Dangling == "" {
+		options.Dangling = ImportDanglingReject
+	}
+	return &ImportJob{
+		ID:        id,
+		Status:    ImportJobPending,
+		Options:   options,
+		TotalRows: totalRows,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (j *ImportJob) Start(now time.Time) {
+	j.Status = ImportJobRunning
+	j.UpdatedAt = now
+}
+
+// Record counts a processed row.
+func (j *ImportJob) Record(row ImportRow) {
+	j.Processed++
+	switch row.Status {
+	case ImportRowAccepted:
+		j.Accepted++
+	case ImportRowSkipped:
+		j.Skipped++
+	case ImportRowFailed:
+		j.Failed++
+	}
+}
+
+// Complete marks the job as done. A job fails instead when any of its rows
+// fails, as it then saves no user, or when it cannot go on.
+func (j *ImportJob) Complete(now time.Time) {
+	j.Status = ImportJobCompleted
+	j.UpdatedAt = now
+	j.FinishedAt = now
+}
+
+func (j *ImportJob) Fail(reason string, now time.Time) {
+	j.Status = ImportJobFailed
+	j.Error = reason
+	j.UpdatedAt = now
+	j.FinishedAt = now
+}
+
+func (j *ImportJob) Finished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportJob(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	job := NewImportJob("job1", ImportOptions{Format: ImportFormatCSV, DryRun: true}, 3, now)
+	assert.Equal(t, ImportJobPending, job.Status)
+
+	job.Start(now)
+	job.Record(ImportRow{Status: ImportRowAccepted})
+	job.Record(ImportRow{Status: ImportRowSkipped})
+	job.Record(ImportRow{Status: ImportRowFailed})
+	assert.Equal(t, ImportJobRunning, job.Status)
+	assert.False(t, job.Finished())
+	assert.Equal(t, 3, job.Processed)
+	assert.Equal(t, 1, job.Accepted)
+	assert.Equal(t, 1, job.Skipped)
+	assert.Equal(t, 1, job.Failed)
+
+	job.Complete(now.Add(time.Second))
+	assert.True(t, job.Finished())
+	assert.Equal(t, now.Add(time.Second), job.FinishedAt)
+
+	failed := NewImportJob("job2", ImportOptions{Format: ImportFormatCSV}, 1, now)
+	failed.Fail("disk full", now)
+	assert.True(t, failed.Finished())
+	assert.Equal(t, ImportJobFailed, failed.Status)
+	assert.Equal(t, "disk full", failed.Error)
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/uniseg"
+)
+
+const (
+	// MaxListNameLength is the maximum number of characters in a list name.
+	MaxListNameLength = 25
+	// MaxListMembers is the maximum number of users in a list.
+	MaxListMembers = 5000
+)
+
+// List is a user-curated set of accounts whose tweets can be read together
+// without following them. Private lists are only visible to their owner.
+type List struct {
+	ID        string
+	OwnerID   string
+	Name      string
+	Private   bool
+	Members   map[string]bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewList(id, ownerID, name string, private bool) (*List, error) {
+	name, err := normalizeListName(name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &List{
+		ID:        id,
+		OwnerID:   ownerID,
+		Name:      name,
+		Private:   private,
+		Members:   make(map[string]bool),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (l *List) Rename(name string) error {
+	name, err := normalizeListName(name)
+	if err != nil {
+		return err
+	}
+	l.Name = name
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+func (l *List) SetPrivate(private bool) {
+	l.Private = private
+	l.UpdatedAt = time.Now()
+}
+
+func (l *List) AddMember(userID string) error {
+	if l.Members[userID] {
+		return ErrAlreadyListMember
+	}
+	if len(l.Members) >= MaxListMembers {
+		return ErrListFull
+	}
+	if l.Members == nil {
+		l.Members = make(map[string]bool)
+	}
+	l.Members[userID] = true
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+func (l *List) RemoveMember(userID string) error {
+	if !l.Members[userID] {
+		return ErrNotListMember
+	}
+	delete(l.Members, userID)
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+// CanView reports whether userID may see the list; an empty userID is an
+// anonymous viewer.
+func (l *List) CanView(userID string) bool {
+	return !l.Private || userID == l.OwnerID
+}
+
+// CheckOwner checks that userID may change the list. Private lists of other
+// users are reported as not found.
+func (l *List) CheckOwner(userID string) error {
+	if !l.CanView(userID) {
+		return ErrListNotFound
+	}
+	if userID != l.OwnerID {
+		return ErrNotListOwner
+	}
+	return nil
+}
+
+func normalizeListName(name string) (string, error) {
+	name = NormalizeTweetContent(name)
+	if name == "" || strings.ContainsAny(name, "\n\t") {
+		return "", ErrInvalidListName
+	}
+	if length := uniseg.GraphemeClusterCount(name); length > MaxListNameLength {
+		return "", fmt.Errorf("%w: %d characters, maximum is %d", ErrInvalidListName, length, MaxListNameLength)
+	}
+	return name, nil
+}
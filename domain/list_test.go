@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewList(t *testing.T) {
+	tests := []struct {
+		name     string
+		listName string
+		wantName string
+		wantErr  error
+	}{
+		{name: "valid", listName: "  Go people ", wantName: "Go people"},
+		{name: "empty", listName: "   ", wantErr: ErrInvalidListName},
+		{name: "multiple lines", listName: "Go\npeople", wantErr: ErrInvalidListName},
+		{name: "too long", listName: strings.Repeat("a", MaxListNameLength+1), wantErr: ErrInvalidListName},
+		{name: "max length in graphemes", listName: strings.Repeat("é", MaxListNameLength), wantName: strings.Repeat("é", MaxListNameLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := NewList("list1", "user1", tt.listName, false)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, list.Name)
+			assert.Equal(t, "user1", list.OwnerID)
+			assert.Empty(t, list.Members)
+		})
+	}
+}
+
+func TestList_Members(t *testing.T) {
+	list, _ := NewList("list1", "user1", "friends", false)
+
+	assert.NoError(t, list.AddMember("user2"))
+	assert.Equal(t, ErrAlreadyListMember, list.AddMember("user2"))
+	assert.True(t, list.Members["user2"])
+
+	assert.NoError(t, list.RemoveMember("user2"))
+	assert.Equal(t, ErrNotListMember, list.RemoveMember("user2"))
+	assert.Empty(t, list.Members)
+
+	for i := 0; len(list.Members) < MaxListMembers; i++ {
+		list.Members[strings.Repeat("u", i+1)] = true
+	}
+	assert.Equal(t, ErrListFull, list.AddMember("user2"))
+}
+
+func TestList_Visibility(t *testing.T) {
+	public, _ := NewList("list1", "user1", "public", false)
+	private, _ := NewList("list2", "user1", "private", true)
+
+	tests := []struct {
+		name        string
+		list        *List
+		userID      string
+		wantView    bool
+		wantOwnerOK error
+	}{
+		{name: "owner of a private list", list: private, userID: "user1", wantView: true},
+		{name: "other user, private list", list: private, userID: "user2", wantOwnerOK: ErrListNotFound},
+		{name: "anonymous, private list", list: private, userID: "", wantOwnerOK: ErrListNotFound},
+		{name: "other user, public list", list: public, userID: "user2", wantView: true, wantOwnerOK: ErrNotListOwner},
+		{name: "anonymous, public list", list: public, userID: "", wantView: true, wantOwnerOK: ErrNotListOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantView, tt.list.CanView(tt.userID))
+			assert.Equal(t, tt.wantOwnerOK, tt.list.CheckOwner(tt.userID))
+		})
+	}
+}
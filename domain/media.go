@@ -0,0 +1,317 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"time"
+)
+
+const (
+	// MaxMediaSize is the maximum size in bytes of an uploaded file.
+	MaxMediaSize = 5 << 20
+	// MaxMediaPixels bounds the decoded size of an image, so that a small
+	// file cannot expand into gigabytes of pixels.
+	MaxMediaPixels = 4096 * 4096
+	// MaxMediaPerTweet is the number of media a tweet may carry.
+	MaxMediaPerTweet = 4
+	// ThumbnailSize is the largest side of a thumbnail, in pixels.
+	ThumbnailSize = 320
+
+	jpegQuality = 90
+)
+
+// Media is an uploaded image. The image itself and its thumbnail live in a
+// blob store, under the keys returned by BlobKey and ThumbnailKey.
+type Media struct {
+	ID                   string
+	UserID               string
+	ContentType          string
+	Size                 int
+	Width                int
+	Height               int
+	ThumbnailContentType string
+	ThumbnailWidth       int
+	ThumbnailHeight      int
+	CreatedAt            time.Time
+}
+
+// ProcessedImage is an upload ready to be stored: its metadata has been
+// stripped and a thumbnail has been made.
+type ProcessedImage struct {
+	ContentType          string
+	Data                 []byte
+	Width                int
+	Height               int
+	ThumbnailContentType string
+	Thumbnail            []byte
+	ThumbnailWidth       int
+	ThumbnailHeight      int
+}
+
+func NewMedia(id, userID string, img *ProcessedImage, now time.Time) *Media {
+	return &Media{
+		ID:                   id,
+		UserID:               userID,
+		ContentType:          img.ContentType,
+		Size:                 len(img.Data),
+		Width:                img.Width,
+		Height:               img.Height,
+		ThumbnailContentType: img.ThumbnailContentType,
+		ThumbnailWidth:       img.ThumbnailWidth,
+		ThumbnailHeight:      img.ThumbnailHeight,
+		CreatedAt:            now,
+	}
+}
+
+func (m *Media) BlobKey() string {
+	return m.ID
+}
+
+func (m *Media) ThumbnailKey() string {
+	return m.ID + "/thumbnail"
+}
+
+// ProcessImage validates an uploaded JPEG, PNG or GIF and re-encodes it.
+// The type is sniffed from the data, whatever the client claims. Re-encoding
+// drops EXIF and any other metadata, such as the location a photo was taken
+// at; the EXIF orientation of a JPEG is applied to the pixels first, so that
+// the image still displays the right way up.
+func ProcessImage(data []byte) (*ProcessedImage, error) {
+	if len(data) > MaxMediaSize {
+		return nil, ErrMediaTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+	default:
+		return nil, ErrUnsupportedMediaType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMedia, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("%w: empty image", ErrInvalidMedia)
+	}
+	if config.Width*config.Height > MaxMediaPixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", ErrMediaTooLarge, config.Width, config.Height)
+	}
+
+	var img image.Image
+	var out bytes.Buffer
+	switch contentType {
+	case "image/jpeg":
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMedia, err)
+		}
+		if orientation := jpegOrientation(data); orientation > 1 {
+			img = orient(toRGBA(img), orientation)
+		}
+		err = jpeg.Encode(&out, img, &jpeg.Options{Quality: jpegQuality})
+	case "image/png":
+		img, err = png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMedia, err)
+		}
+		err = png.Encode(&out, img)
+	case "image/gif":
+		var anim *gif.GIF
+		anim, err = gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMedia, err)
+		}
+		// The first frame may cover only part of the canvas.
+		canvas := image.NewRGBA(image.Rect(0, 0, config.Width, config.Height))
+		first := anim.Image[0]
+		draw.Draw(canvas, first.Bounds(), first, first.Bounds().Min, draw.Over)
+		img = canvas
+		err = gif.EncodeAll(&out, anim)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	thumb := thumbnail(img, ThumbnailSize)
+	thumbType := "image/png"
+	var thumbOut bytes.Buffer
+	if contentType == "image/jpeg" {
+		thumbType = "image/jpeg"
+		err = jpeg.Encode(&thumbOut, thumb, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&thumbOut, thumb)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	return &ProcessedImage{
+		ContentType:          contentType,
+		Data:                 out.Bytes(),
+		Width:                bounds.Dx(),
+		Height:               bounds.Dy(),
+		ThumbnailContentType: thumbType,
+		Thumbnail:            thumbOut.Bytes(),
+		ThumbnailWidth:       thumb.Bounds().Dx(),
+		ThumbnailHeight:      thumb.Bounds().Dy(),
+	}, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, b.Min, draw.Src)
+	return rgba
+}
+
+// thumbnail scales img down to fit in a size×size square, averaging the
+// source pixels that fall into each thumbnail pixel. Images that already fit
+// are kept at their size.
+func thumbnail(img image.Image, size int) *image.RGBA {
+	src := toRGBA(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	tw, th := w, h
+	if w > size || h > size {
+		if w >= h {
+			tw, th = size, max(1, h*size/w)
+		} else {
+			tw, th = max(1, w*size/h), size
+		}
+	}
+	if tw == w && th == h {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for ty := 0; ty < th; ty++ {
+		y0, y1 := ty*h/th, max((ty+1)*h/th, ty*h/th+1)
+		for tx := 0; tx < tw; tx++ {
+			x0, x1 := tx*w/tw, max((tx+1)*w/tw, tx*w/tw+1)
+			var sum [4]int
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride+x0*4 : y*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+			n := (x1 - x0) * (y1 - y0)
+			i := dst.PixOffset(tx, ty)
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return dst
+}
+
+// orient applies an EXIF orientation (2 to 8) to src.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			default:
+				dx, dy = x, y
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):][:4], src.Pix[src.PixOffset(x, y):][:4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag of a JPEG, returning 1 (as
+// stored) when there is none or it cannot be read.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(data[i+2])<<8 | int(data[i+3])
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var u16 func([]byte) int
+	var u32 func([]byte) int
+	switch string(tiff[:2]) {
+	case "II":
+		u16 = func(b []byte) int { return int(b[0]) | int(b[1])<<8 }
+		u32 = func(b []byte) int { return u16(b) | u16(b[2:])<<16 }
+	case "MM":
+		u16 = func(b []byte) int { return int(b[0])<<8 | int(b[1]) }
+		u32 = func(b []byte) int { return u16(b)<<16 | u16(b[2:]) }
+	default:
+		return 1
+	}
+	ifd := u32(tiff[4:])
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := u16(tiff[ifd:])
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if u16(tiff[entry:]) == 0x0112 {
+			if orientation := u16(tiff[entry+8:]); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+			return 1
+		}
+	}
+	return 1
+}
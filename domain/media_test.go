@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func encodeGIF(t *testing.T, w, h int) []byte {
+	palette := color.Palette{color.Black, color.White}
+	anim := &gif.GIF{
+		Image: []*image.Paletted{
+			image.NewPaletted(image.Rect(0, 0, w, h), palette),
+			image.NewPaletted(image.Rect(0, 0, w, h), palette),
+		},
+		Delay: []int{10, 10},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, gif.EncodeAll(&buf, anim))
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes a w×h JPEG whose left half is black and right half is
+// white, with an EXIF segment holding orientation and a GPS tag.
+func encodeJPEG(t *testing.T, w, h, orientation int) []byte {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := w / 2; x < w; x++ {
+			img.SetGray(x, y, color.Gray{Y: 0xFF})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+
+	tiff := []byte{
+		'I', 'I', 42, 0, 8, 0, 0, 0, // little endian header, IFD0 at 8
+		2, 0, // two entries
+		0x12, 0x01, 3, 0, 1, 0, 0, 0, byte(orientation), 0, 0, 0, // orientation, SHORT
+		0x25, 0x88, 4, 0, 1, 0, 0, 0, 0, 0, 0, 0, // GPS IFD pointer
+		0, 0, 0, 0, // no next IFD
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := append([]byte{0xFF, 0xE1, byte((len(payload) + 2) >> 8), byte(len(payload) + 2)}, payload...)
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func TestProcessImage(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		wantType      string
+		wantWidth     int
+		wantHeight    int
+		wantThumbType string
+		wantThumbW    int
+		wantThumbH    int
+	}{
+		{
+			name:          "large png gets a scaled thumbnail",
+			data:          encodePNG(t, 1000, 500),
+			wantType:      "image/png",
+			wantWidth:     1000,
+			wantHeight:    500,
+			wantThumbType: "image/png",
+			wantThumbW:    320,
+			wantThumbH:    160,
+		},
+		{
+			name:          "small png keeps its size",
+			data:          encodePNG(t, 100, 50),
+			wantType:      "image/png",
+			wantWidth:     100,
+			wantHeight:    50,
+			wantThumbType: "image/png",
+			wantThumbW:    100,
+			wantThumbH:    50,
+		},
+		{
+			name:          "animated gif",
+			data:          encodeGIF(t, 40, 640),
+			wantType:      "image/gif",
+			wantWidth:     40,
+			wantHeight:    640,
+			wantThumbType: "image/png",
+			wantThumbW:    20,
+			wantThumbH:    320,
+		},
+		{
+			name:          "jpeg without rotation",
+			data:          encodeJPEG(t, 64, 32, 1),
+			wantType:      "image/jpeg",
+			wantWidth:     64,
+			wantHeight:    32,
+			wantThumbType: "image/jpeg",
+			wantThumbW:    64,
+			wantThumbH:    32,
+		},
+		{
+			name:          "rotated jpeg",
+			data:          encodeJPEG(t, 64, 32, 6),
+			wantType:      "image/jpeg",
+			wantWidth:     32,
+			wantHeight:    64,
+			wantThumbType: "image/jpeg",
+			wantThumbW:    32,
+			wantThumbH:    64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := ProcessImage(tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, img.ContentType)
+			assert.Equal(t, tt.wantWidth, img.Width)
+			assert.Equal(t, tt.wantHeight, img.Height)
+			assert.Equal(t, tt.wantThumbType, img.ThumbnailContentType)
+			assert.Equal(t, tt.wantThumbW, img.ThumbnailWidth)
+			assert.Equal(t, tt.wantThumbH, img.ThumbnailHeight)
+			assert.NotContains(t, string(img.Data), "Exif")
+
+			config, format, err := image.DecodeConfig(bytes.NewReader(img.Data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, "image/"+format)
+			assert.Equal(t, tt.wantWidth, config.Width)
+			assert.Equal(t, tt.wantHeight, config.Height)
+		})
+	}
+}
+
+func TestProcessImage_Orientation(t *testing.T) {
+	// The source is black on the left and white on the right; turned 90°
+	// clockwise, the black half ends up on top.
+	img, err := ProcessImage(encodeJPEG(t, 64, 32, 6))
+	require.NoError(t, err)
+	decoded, err := jpeg.Decode(bytes.NewReader(img.Data))
+	require.NoError(t, err)
+
+	top, _, _, _ := decoded.At(16, 8).RGBA()
+	bottom, _, _, _ := decoded.At(16, 56).RGBA()
+	assert.Less(t, top, uint32(0x2000))
+	assert.Greater(t, bottom, uint32(0xE000))
+	assert.Equal(t, 1, jpegOrientation(img.Data))
+}
+
+func TestProcessImage_Errors(t *testing.T) {
+	huge := encodeGIF(t, 1, 1)
+	huge[6], huge[7], huge[8], huge[9] = 0xFF, 0xFF, 0xFF, 0xFF
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "not an image", data: []byte("hello, world"), wantErr: ErrUnsupportedMediaType},
+		{name: "unsupported image type", data: []byte("BM\x00\x00\x00\x00"), wantErr: ErrUnsupportedMediaType},
+		{name: "file too large", data: make([]byte, MaxMediaSize+1), wantErr: ErrMediaTooLarge},
+		{name: "too many pixels", data: huge, wantErr: ErrMediaTooLarge},
+		{name: "truncated image", data: encodePNG(t, 10, 10)[:40], wantErr: ErrInvalidMedia},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProcessImage(tt.data)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	for orientation := 1; orientation <= 8; orientation++ {
+		assert.Equal(t, orientation, jpegOrientation(encodeJPEG(t, 4, 4, orientation)))
+	}
+	assert.Equal(t, 1, jpegOrientation(encodePNG(t, 4, 4)))
+	assert.Equal(t, 1, jpegOrientation([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF}))
+}
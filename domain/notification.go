@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxNotificationActors bounds how many actors an aggregated notification keeps.
+const maxNotificationActors = 10
+
+type NotificationType string
+
+const (
+	NotificationFollowed        NotificationType = "followed"
+	NotificationFollowRequested NotificationType = "follow_requested"
+	NotificationMentioned       NotificationType = "mentioned"
+)
+
+// Notification tells UserID that one or more actors did something. Unread
+// notifications of the same type about the same tweet are aggregated.
+type Notification struct {
+	ID         string
+	UserID     string
+	Type       NotificationType
+	TweetID    string
+	ActorIDs   []string
+	ActorCount int
+	Read       bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NotificationTarget is a notification that an event should produce.
+type NotificationTarget struct {
+	UserID  string
+	Type    NotificationType
+	TweetID string
+	ActorID string
+}
+
+// NotificationTargetsFor lists who should be notified about event. Users are
+// never notified about their own actions.
+func NotificationTargetsFor(event Event) []NotificationTarget {
+	var targets []NotificationTarget
+	add := func(userID string, notificationType NotificationType, tweetID, actorID string) {
+		if userID != "" && userID != actorID {
+			targets = append(targets, NotificationTarget{UserID: userID, Type: notificationType, TweetID: tweetID, ActorID: actorID})
+		}
+	}
+
+	switch e := event.(type) {
+	case UserFollowed:
+		add(e.FolloweeID, NotificationFollowed, "", e.FollowerID)
+	case FollowRequested:
+		add(e.FolloweeID, NotificationFollowRequested, "", e.FollowerID)
+	case TweetCreated:
+		for _, entity := range e.Tweet.Entities {
+			if entity.Type == EntityMention {
+				add(entity.UserID, NotificationMentioned, e.Tweet.ID, e.Tweet.UserID)
+			}
+		}
+	}
+	return targets
+}
+
+func NewNotification(id, userID string, notificationType NotificationType, tweetID, actorID string) *Notification {
+	now := time.Now()
+	return &Notification{
+		ID:         id,
+		UserID:     userID,
+		Type:       notificationType,
+		TweetID:    tweetID,
+		ActorIDs:   []string{actorID},
+		ActorCount: 1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// AddActor aggregates another occurrence of the event into the notification,
+// keeping the most recent actor first.
+func (n *Notification) AddActor(actorID string) {
+	for i, id := range n.ActorIDs {
+		if id == actorID {
+			n.ActorIDs = append(n.ActorIDs[:i], n.ActorIDs[i+1:]...)
+			n.ActorCount--
+			break
+		}
+	}
+	n.ActorIDs = append([]string{actorID}, n.ActorIDs...)
+	if len(n.ActorIDs) > maxNotificationActors {
+		n.ActorIDs = n.ActorIDs[:maxNotificationActors]
+	}
+	n.ActorCount++
+	n.UpdatedAt = time.Now()
+}
+
+// Summary describes the notification, e.g. "user2 and 4 others followed you".
+func (n *Notification) Summary() string {
+	actors := n.ActorIDs[0]
+	switch others := n.ActorCount - 1; {
+	case others == 1:
+		actors += " and 1 other"
+	case others > 1:
+		actors += fmt.Sprintf(" and %d others", others)
+	}
+
+	switch n.Type {
+	case NotificationFollowed:
+		return actors + " followed you"
+	case NotificationFollowRequested:
+		return actors + " requested to follow you"
+	case NotificationMentioned:
+		return actors + " mentioned you"
+	}
+	return actors
+}
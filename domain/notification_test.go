@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification_AddActor(t *testing.T) {
+	n := NewNotification("n1", "user1", NotificationMentioned, "tweet1", "user2")
+
+	n.AddActor("user3")
+	n.AddActor("user2")
+
+	assert.Equal(t, []string{"user2", "user3"}, n.ActorIDs)
+	assert.Equal(t, 2, n.ActorCount)
+
+	for i := 0; i < 20; i++ {
+		n.AddActor(string(rune('a' + i)))
+	}
+
+	assert.Len(t, n.ActorIDs, maxNotificationActors)
+	assert.Equal(t, 22, n.ActorCount)
+}
+
+func TestNotification_Summary(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification *Notification
+		want         string
+	}{
+		{
+			name:         "single follower",
+			notification: &Notification{Type: NotificationFollowed, ActorIDs: []string{"user2"}, ActorCount: 1},
+			want:         "user2 followed you",
+		},
+		{
+			name:         "follow requests",
+			notification: &Notification{Type: NotificationFollowRequested, ActorIDs: []string{"user2", "user3"}, ActorCount: 2},
+			want:         "user2 and 1 other requested to follow you",
+		},
+		{
+			name:         "many followers",
+			notification: &Notification{Type: NotificationFollowed, ActorIDs: []string{"user2", "user3"}, ActorCount: 5},
+			want:         "user2 and 4 others followed you",
+		},
+		{
+			name:         "mention",
+			notification: &Notification{Type: NotificationMentioned, ActorIDs: []string{"user2"}, ActorCount: 1},
+			want:         "user2 mentioned you",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.notification.Summary())
+		})
+	}
+}
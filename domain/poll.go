@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/uniseg"
+)
+
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 4
+	// MaxPollOptionLength is the maximum number of user-perceived characters
+	// in a poll option.
+	MaxPollOptionLength = 25
+	MinPollDuration     = 5 * time.Minute
+	MaxPollDuration     = 7 * 24 * time.Hour
+)
+
+// PollDraft is a poll as requested by the author, before it is attached to a
+// tweet. Its duration starts counting when the tweet is published.
+type PollDraft struct {
+	Options  []string
+	Duration time.Duration
+}
+
+// Validate checks the draft and returns its options normalized.
+func (d *PollDraft) Validate() ([]string, error) {
+	if len(d.Options) < MinPollOptions || len(d.Options) > MaxPollOptions {
+		return nil, fmt.Errorf("%w: a poll has %d to %d options", ErrInvalidPoll, MinPollOptions, MaxPollOptions)
+	}
+	if d.Duration < MinPollDuration || d.Duration > MaxPollDuration {
+		return nil, fmt.Errorf("%w: a poll lasts from %v to %v", ErrInvalidPoll, MinPollDuration, MaxPollDuration)
+	}
+	options := make([]string, len(d.Options))
+	seen := make(map[string]bool, len(d.Options))
+	for i, option := range d.Options {
+		option = NormalizeTweetContent(option)
+		if option == "" || strings.ContainsAny(option, "\n\t") {
+			return nil, fmt.Errorf("%w: option %d is empty or spans lines", ErrInvalidPoll, i+1)
+		}
+		if uniseg.GraphemeClusterCount(option) > MaxPollOptionLength {
+			return nil, fmt.Errorf("%w: option %d is longer than %d characters", ErrInvalidPoll, i+1, MaxPollOptionLength)
+		}
+		if seen[strings.ToLower(option)] {
+			return nil, fmt.Errorf("%w: option %q is repeated", ErrInvalidPoll, option)
+		}
+		seen[strings.ToLower(option)] = true
+		options[i] = option
+	}
+	return options, nil
+}
+
+// Poll is a question with 2 to 4 options attached to a tweet. Each user votes
+// once; votes are stored apart from the tweet, so that the tweet itself never
+// changes.
+type Poll struct {
+	Options []string
+	EndsAt  time.Time
+	// Results is filled in for a reader when tweets are read, and is never
+	// stored.
+	Results *PollResults
+}
+
+func NewPoll(draft *PollDraft, now time.Time) (*Poll, error) {
+	options, err := draft.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &Poll{
+		Options: options,
+		EndsAt:  now.Add(draft.Duration),
+	}, nil
+}
+
+func (p *Poll) Closed(now time.Time) bool {
+	return !now.Before(p.EndsAt)
+}
+
+// PollResults are the tallies of a poll as one reader may see them.
+type PollResults struct {
+	// Votes holds the votes of each option, or is nil while the results are
+	// hidden: until the reader votes or the poll closes.
+	Votes      []int
+	TotalVotes int
+	// ViewerVote is the option the reader voted for, or -1.
+	ViewerVote int
+	Closed     bool
+}
+
+// NewPollResults builds the results of p for a reader who voted for
+// viewerVote (-1 if they did not) from the votes of each option.
+func NewPollResults(p *Poll, votes map[int]int, viewerVote int, now time.Time) *PollResults {
+	results := &PollResults{
+		ViewerVote: viewerVote,
+		Closed:     p.Closed(now),
+	}
+	counts := make([]int, len(p.Options))
+	for option, count := range votes {
+		if option >= 0 && option < len(counts) {
+			counts[option] = count
+			results.TotalVotes += count
+		}
+	}
+	if results.Closed || viewerVote >= 0 {
+		results.Votes = counts
+	}
+	return results
+}
+
+// WithPollResults returns a copy of t carrying results, leaving t, which may
+// be shared, untouched.
+func (t *Tweet) WithPollResults(results *PollResults) *Tweet {
+	tweet := *t
+	poll := *t.Poll
+	poll.Results = results
+	tweet.Poll = &poll
+	return &tweet
+}
+
+// PollVote is the option a user chose in the poll of a tweet.
+type PollVote struct {
+	TweetID   string
+	UserID    string
+	Option    int
+	CreatedAt time.Time
+}
+
+// NewPollVote records userID's vote for option in the poll of tweet.
+func NewPollVote(tweet *Tweet, userID string, option int, now time.Time) (*PollVote, error) {
+	if tweet.Poll == nil {
+		return nil, ErrTweetHasNoPoll
+	}
+	if tweet.Poll.Closed(now) {
+		return nil, ErrPollClosed
+	}
+	if option < 0 || option >= len(tweet.Poll.Options) {
+		return nil, ErrInvalidPollOption
+	}
+	return &PollVote{
+		TweetID:   tweet.ID,
+		UserID:    userID,
+		Option:    option,
+		CreatedAt: now,
+	}, nil
+}
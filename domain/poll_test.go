@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollDraft_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		draft       PollDraft
+		wantOptions []string
+		wantErr     bool
+	}{
+		{name: "two options", draft: PollDraft{Options: []string{"yes", "no"}, Duration: time.Hour}, wantOptions: []string{"yes", "no"}},
+		{name: "four options", draft: PollDraft{Options: []string{"a", "b", "c", "d"}, Duration: MaxPollDuration}, wantOptions: []string{"a", "b", "c", "d"}},
+		{name: "options are normalized", draft: PollDraft{Options: []string{" café ", "te\x00"}, Duration: time.Hour}, wantOptions: []string{"café", "te"}},
+		{name: "option of 25 emoji", draft: PollDraft{Options: []string{strings.Repeat("👍🏽", 25), "no"}, Duration: time.Hour}, wantOptions: []string{strings.Repeat("👍🏽", 25), "no"}},
+		{name: "one option", draft: PollDraft{Options: []string{"yes"}, Duration: time.Hour}, wantErr: true},
+		{name: "five options", draft: PollDraft{Options: []string{"a", "b", "c", "d", "e"}, Duration: time.Hour}, wantErr: true},
+		{name: "empty option", draft: PollDraft{Options: []string{"yes", " "}, Duration: time.Hour}, wantErr: true},
+		{name: "multiline option", draft: PollDraft{Options: []string{"yes", "n\no"}, Duration: time.Hour}, wantErr: true},
+		{name: "option too long", draft: PollDraft{Options: []string{"yes", strings.Repeat("a", 26)}, Duration: time.Hour}, wantErr: true},
+		{name: "repeated option", draft: PollDraft{Options: []string{"Yes", "yes"}, Duration: time.Hour}, wantErr: true},
+		{name: "too short", draft: PollDraft{Options: []string{"yes", "no"}, Duration: time.Minute}, wantErr: true},
+		{name: "too long", draft: PollDraft{Options: []string{"yes", "no"}, Duration: MaxPollDuration + time.Second}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := tt.draft.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPoll)
+				assert.ErrorIs(t, err, ErrInvalidTweetContent)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOptions, options)
+		})
+	}
+}
+
+func TestNewPollResults(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	poll := &Poll{Options: []string{"yes", "no", "maybe"}, EndsAt: now.Add(time.Hour)}
+	votes := map[int]int{0: 3, 2: 1}
+
+	tests := []struct {
+		name       string
+		viewerVote int
+		now        time.Time
+		want       *PollResults
+	}{
+		{
+			name:       "hidden before voting",
+			viewerVote: -1,
+			now:        now,
+			want:       &PollResults{TotalVotes: 4, ViewerVote: -1},
+		},
+		{
+			name:       "visible after voting",
+			viewerVote: 2,
+			now:        now,
+			want:       &PollResults{Votes: []int{3, 0, 1}, TotalVotes: 4, ViewerVote: 2},
+		},
+		{
+			name:       "visible once closed",
+			viewerVote: -1,
+			now:        now.Add(time.Hour),
+			want:       &PollResults{Votes: []int{3, 0, 1}, TotalVotes: 4, ViewerVote: -1, Closed: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewPollResults(poll, votes, tt.viewerVote, tt.now))
+		})
+	}
+}
+
+func TestNewPollVote(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet := &Tweet{ID: "tweet1", Poll: &Poll{Options: []string{"yes", "no"}, EndsAt: now.Add(time.Hour)}}
+
+	vote, err := NewPollVote(tweet, "user1", 1, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &PollVote{TweetID: "tweet1", UserID: "user1", Option: 1, CreatedAt: now}, vote)
+
+	_, err = NewPollVote(tweet, "user1", 2, now)
+	assert.Equal(t, ErrInvalidPollOption, err)
+	_, err = NewPollVote(tweet, "user1", -1, now)
+	assert.Equal(t, ErrInvalidPollOption, err)
+	_, err = NewPollVote(tweet, "user1", 0, now.Add(time.Hour))
+	assert.Equal(t, ErrPollClosed, err)
+	_, err = NewPollVote(&Tweet{ID: "tweet2"}, "user1", 0, now)
+	assert.Equal(t, ErrTweetHasNoPoll, err)
+}
+
+func TestTweet_WithPollResults(t *testing.T) {
+	tweet := &Tweet{ID: "tweet1", Poll: &Poll{Options: []string{"yes", "no"}}}
+	results := &PollResults{TotalVotes: 1, ViewerVote: -1}
+
+	got := tweet.WithPollResults(results)
+	assert.Equal(t, results, got.Poll.Results)
+	assert.Nil(t, tweet.Poll.Results)
+}
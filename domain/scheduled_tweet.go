@@ -0,0 +1,157 @@
+package domain
+
+import "time"
+
+type ScheduledTweetStatus string
+
+const (
+	ScheduledTweetPending    ScheduledTweetStatus = "pending"
+	ScheduledTweetPublishing ScheduledTweetStatus = "publishing"
+	ScheduledTweetPublished  ScheduledTweetStatus = "published"
+	ScheduledTweetCancelled  ScheduledTweetStatus = "cancelled"
+	ScheduledTweetFailed     ScheduledTweetStatus = "failed"
+)
+
+// ScheduledTweet is a tweet composed now and published at PublishAt. Until it
+// is published it is not a Tweet, so it never shows up in timelines.
+//
+// A scheduler claims a due tweet by moving it to publishing under a lease; if
+// the scheduler dies, the tweet becomes due again once the lease expires.
+// Version changes on every write, so concurrent writers can detect each other.
+type ScheduledTweet struct {
+	ID          string
+	UserID      string
+	Content     string
+	Attachments TweetAttachments
+	PublishAt   time.Time
+	Status      ScheduledTweetStatus
+	TweetID     string
+	Error       string
+	LeaseOwner  string
+	LeaseUntil  time.Time
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewScheduledTweet(id, userID, content string, publishAt, now time.Time) (*ScheduledTweet, error) {
+	return NewScheduledTweetWithAttachments(id, userID, content, TweetAttachments{}, publishAt, now)
+}
+
+// NewScheduledTweetWithAttachments schedules a tweet carrying attachments.
+// They are checked against their owner once more at publish time.
+func NewScheduledTweetWithAttachments(id, userID, content string, attachments TweetAttachments, publishAt, now time.Time) (*ScheduledTweet, error) {
+	if err := attachments.Validate(); err != nil {
+		return nil, err
+	}
+	content, err := validateScheduledContent(userID, content, attachments)
+	if err != nil {
+		return nil, err
+	}
+	if !publishAt.After(now) {
+		return nil, ErrInvalidPublishTime
+	}
+	return &ScheduledTweet{
+		ID:          id,
+		UserID:      userID,
+		Content:     content,
+		Attachments: attachments,
+		PublishAt:   publishAt,
+		Status:      ScheduledTweetPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// CheckOwner checks that userID may see and change t. Scheduled tweets of
+// other users are reported as not found.
+func (t *ScheduledTweet) CheckOwner(userID string) error {
+	if userID != t.UserID {
+		return ErrScheduledTweetNotFound
+	}
+	return nil
+}
+
+// Edit changes the content and publish time of a pending tweet. Nil arguments
+// are left unchanged.
+func (t *ScheduledTweet) Edit(content *string, publishAt *time.Time, now time.Time) error {
+	if t.Status != ScheduledTweetPending {
+		return ErrScheduledTweetNotPending
+	}
+	if content != nil {
+		normalized, err := validateScheduledContent(t.UserID, *content, t.Attachments)
+		if err != nil {
+			return err
+		}
+		t.Content = normalized
+	}
+	if publishAt != nil {
+		if !publishAt.After(now) {
+			return ErrInvalidPublishTime
+		}
+		t.PublishAt = *publishAt
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+func (t *ScheduledTweet) Cancel(now time.Time) error {
+	if t.Status != ScheduledTweetPending {
+		return ErrScheduledTweetNotPending
+	}
+	t.Status = ScheduledTweetCancelled
+	t.UpdatedAt = now
+	return nil
+}
+
+// Due reports whether t should be claimed for publishing at now: it is
+// pending and its time has come, or the lease of a previous claim expired.
+func (t *ScheduledTweet) Due(now time.Time) bool {
+	switch t.Status {
+	case ScheduledTweetPending:
+		return !t.PublishAt.After(now)
+	case ScheduledTweetPublishing:
+		return t.LeaseUntil.Before(now)
+	}
+	return false
+}
+
+// Claim moves t to publishing on behalf of owner until leaseUntil.
+func (t *ScheduledTweet) Claim(owner string, leaseUntil, now time.Time) {
+	t.Status = ScheduledTweetPublishing
+	t.LeaseOwner = owner
+	t.LeaseUntil = leaseUntil
+	t.UpdatedAt = now
+}
+
+func (t *ScheduledTweet) MarkPublished(tweetID string, now time.Time) {
+	t.Status = ScheduledTweetPublished
+	t.TweetID = tweetID
+	t.LeaseOwner = ""
+	t.LeaseUntil = time.Time{}
+	t.UpdatedAt = now
+}
+
+// MarkFailed gives up on t, for instance because its author was deleted or
+// it mentions a user who has since blocked them.
+func (t *ScheduledTweet) MarkFailed(err error, now time.Time) {
+	t.Status = ScheduledTweetFailed
+	t.Error = err.Error()
+	t.LeaseOwner = ""
+	t.LeaseUntil = time.Time{}
+	t.UpdatedAt = now
+}
+
+// validateScheduledContent applies the rules of NewTweet, so that a scheduled
+// tweet is only refused at publish time if the world changed meanwhile.
+func validateScheduledContent(userID, content string, attachments TweetAttachments) (string, error) {
+	media := make([]*Media, len(attachments.MediaIDs))
+	for i, id := range attachments.MediaIDs {
+		media[i] = &Media{ID: id}
+	}
+	tweet, err := NewTweetWithAttachments("", userID, content, media, attachments.Poll)
+	if err != nil {
+		return "", err
+	}
+	return tweet.Content, nil
+}
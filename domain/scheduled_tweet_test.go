@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScheduledTweet(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		content     string
+		attachments TweetAttachments
+		publishAt   time.Time
+		wantContent string
+		wantErr     error
+	}{
+		{name: "valid", content: " hello ", publishAt: now.Add(time.Hour), wantContent: "hello"},
+		{name: "publish time now", content: "hello", publishAt: now, wantErr: ErrInvalidPublishTime},
+		{name: "publish time in the past", content: "hello", publishAt: now.Add(-time.Hour), wantErr: ErrInvalidPublishTime},
+		{name: "empty content", content: " ", publishAt: now.Add(time.Hour), wantErr: ErrInvalidTweetContent},
+		{name: "content too long", content: strings.Repeat("a", MaxTweetLength+1), publishAt: now.Add(time.Hour), wantErr: ErrInvalidTweetContent},
+		{name: "media without content", attachments: TweetAttachments{MediaIDs: []string{"media1"}}, publishAt: now.Add(time.Hour)},
+		{name: "too many media", content: "hello", attachments: TweetAttachments{MediaIDs: []string{"1", "2", "3", "4", "5"}}, publishAt: now.Add(time.Hour), wantErr: ErrTooManyMedia},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tweet, err := NewScheduledTweetWithAttachments("scheduled1", "user1", tt.content, tt.attachments, tt.publishAt, now)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContent, tweet.Content)
+			assert.Equal(t, tt.attachments, tweet.Attachments)
+			assert.Equal(t, ScheduledTweetPending, tweet.Status)
+		})
+	}
+}
+
+func TestScheduledTweet_Lifecycle(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet, _ := NewScheduledTweet("scheduled1", "user1", "hello", now.Add(time.Hour), now)
+
+	content := "bye"
+	later := now.Add(2 * time.Hour)
+	assert.NoError(t, tweet.Edit(&content, &later, now))
+	assert.Equal(t, "bye", tweet.Content)
+	assert.Equal(t, later, tweet.PublishAt)
+
+	past := now.Add(-time.Minute)
+	assert.Equal(t, ErrInvalidPublishTime, tweet.Edit(nil, &past, now))
+
+	assert.False(t, tweet.Due(now.Add(time.Hour)))
+	assert.True(t, tweet.Due(later))
+
+	tweet.Claim("scheduler1", later.Add(time.Minute), later)
+	assert.Equal(t, ScheduledTweetPublishing, tweet.Status)
+	assert.False(t, tweet.Due(later.Add(30*time.Second)), "claimed under a live lease")
+	assert.True(t, tweet.Due(later.Add(2*time.Minute)), "lease expired")
+	assert.Equal(t, ErrScheduledTweetNotPending, tweet.Edit(&content, nil, later))
+	assert.Equal(t, ErrScheduledTweetNotPending, tweet.Cancel(later))
+
+	tweet.MarkPublished("scheduled1", later)
+	assert.Equal(t, ScheduledTweetPublished, tweet.Status)
+	assert.Equal(t, "scheduled1", tweet.TweetID)
+	assert.False(t, tweet.Due(later.Add(time.Hour)))
+}
+
+func TestScheduledTweet_Cancel(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet, _ := NewScheduledTweet("scheduled1", "user1", "hello", now.Add(time.Hour), now)
+
+	assert.Equal(t, ErrScheduledTweetNotFound, tweet.CheckOwner("user2"))
+	assert.NoError(t, tweet.CheckOwner("user1"))
+
+	assert.NoError(t, tweet.Cancel(now))
+	assert.Equal(t, ScheduledTweetCancelled, tweet.Status)
+	assert.False(t, tweet.Due(now.Add(2*time.Hour)))
+	assert.Equal(t, ErrScheduledTweetNotPending, tweet.Cancel(now))
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const searchDateLayout = "2006-01-02"
+
+type SearchSort string
+
+const (
+	SearchByRecency   SearchSort = "recent"
+	SearchByRelevance SearchSort = "relevance"
+)
+
+// SearchQuery is a parsed tweet search. All criteria must match.
+type SearchQuery struct {
+	Terms    []string
+	Phrases  []string
+	Hashtags []string
+	// From is the username given with from:, FromUserID its resolved ID.
+	From       string
+	FromUserID string
+	// Since is inclusive and Until exclusive.
+	Since time.Time
+	Until time.Time
+
+	Sort  SearchSort
+	Limit int
+	After *SearchCursor
+}
+
+// SearchCursor identifies the last result of a page; the next page starts
+// right after it in the query's sort order.
+type SearchCursor struct {
+	Score     float64   `json:"s,omitempty"`
+	Timestamp time.Time `json:"t"`
+	TweetID   string    `json:"id"`
+}
+
+type SearchResult struct {
+	Tweet *Tweet
+	Score float64
+}
+
+// ParseSearchQuery parses q into terms, "quoted phrases", #hashtags,
+// from:username, since:YYYY-MM-DD and until:YYYY-MM-DD.
+func ParseSearchQuery(q string) (SearchQuery, error) {
+	var query SearchQuery
+	for _, token := range splitSearchQuery(q) {
+		switch {
+		case strings.HasPrefix(token, `"`):
+			phrase := strings.TrimSpace(strings.Trim(token, `"`))
+			if phrase != "" {
+				query.Phrases = append(query.Phrases, phrase)
+			}
+		case strings.HasPrefix(token, "#") && len(token) > 1:
+			query.Hashtags = append(query.Hashtags, token[1:])
+		case strings.HasPrefix(token, "from:"):
+			query.From = strings.TrimPrefix(strings.TrimPrefix(token, "from:"), "@")
+		case strings.HasPrefix(token, "since:"):
+			since, err := time.Parse(searchDateLayout, strings.TrimPrefix(token, "since:"))
+			if err != nil {
+				return SearchQuery{}, fmt.Errorf("%w: %s", ErrInvalidSearchQuery, token)
+			}
+			query.Since = since
+		case strings.HasPrefix(token, "until:"):
+			until, err := time.Parse(searchDateLayout, strings.TrimPrefix(token, "until:"))
+			if err != nil {
+				return SearchQuery{}, fmt.Errorf("%w: %s", ErrInvalidSearchQuery, token)
+			}
+			query.Until = until
+		default:
+			query.Terms = append(query.Terms, token)
+		}
+	}
+
+	if len(query.Terms) == 0 && len(query.Phrases) == 0 && len(query.Hashtags) == 0 && query.From == "" {
+		return SearchQuery{}, fmt.Errorf("%w: nothing to search for", ErrInvalidSearchQuery)
+	}
+	return query, nil
+}
+
+// splitSearchQuery splits on whitespace, keeping quoted phrases (quotes
+// included) as single tokens.
+func splitSearchQuery(q string) []string {
+	var tokens []string
+	var current strings.Builder
+	quoted := false
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range q {
+		switch {
+		case r == '"':
+			if quoted {
+				current.WriteRune(r)
+				flush()
+			} else {
+				flush()
+				current.WriteRune(r)
+			}
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+func (c SearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeSearchCursor(s string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidSearchCursor
+	}
+	var cursor SearchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.TweetID == "" {
+		return nil, ErrInvalidSearchCursor
+	}
+	return &cursor, nil
+}
+
+// Covers reports whether a result with the given key sorts at or before c,
+// that is, whether it was already returned in an earlier page.
+func (c SearchCursor) Covers(by SearchSort, score float64, timestamp time.Time, tweetID string) bool {
+	if by == SearchByRelevance && score != c.Score {
+		return score > c.Score
+	}
+	if !timestamp.Equal(c.Timestamp) {
+		return timestamp.After(c.Timestamp)
+	}
+	return tweetID >= c.TweetID
+}
+
+// SortSearchResults orders results by score when sorting by relevance, then
+// newest first.
+func SortSearchResults(results []SearchResult, by SearchSort) {
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if by == SearchByRelevance && a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.Tweet.Timestamp.Equal(b.Tweet.Timestamp) {
+			return a.Tweet.Timestamp.After(b.Tweet.Timestamp)
+		}
+		return a.Tweet.ID > b.Tweet.ID
+	})
+}
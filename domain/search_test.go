@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       string
+		want    SearchQuery
+		wantErr error
+	}{
+		{
+			name: "terms",
+			q:    "hola  mundo",
+			want: SearchQuery{Terms: []string{"hola", "mundo"}},
+		},
+		{
+			name: "all operators",
+			q:    `golang "hexagonal architecture" #go from:@user2 since:2025-03-01 until:2025-03-04`,
+			want: SearchQuery{
+				Terms:    []string{"golang"},
+				Phrases:  []string{"hexagonal architecture"},
+				Hashtags: []string{"go"},
+				From:     "user2",
+				Since:    time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+				Until:    time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "unterminated phrase",
+			q:    `"hola mundo`,
+			want: SearchQuery{Phrases: []string{"hola mundo"}},
+		},
+		{
+			name:    "invalid date",
+			q:       "hola since:ayer",
+			wantErr: ErrInvalidSearchQuery,
+		},
+		{
+			name:    "nothing to search for",
+			q:       `since:2025-03-01 ""`,
+			wantErr: ErrInvalidSearchQuery,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSearchQuery(tt.q)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	cursor := SearchCursor{Score: 1.5, Timestamp: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), TweetID: "tweet5"}
+
+	decoded, err := DecodeSearchCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	_, err = DecodeSearchCursor("not a cursor")
+	assert.Equal(t, ErrInvalidSearchCursor, err)
+
+	newer := cursor.Timestamp.Add(time.Hour)
+	older := cursor.Timestamp.Add(-time.Hour)
+	assert.True(t, cursor.Covers(SearchByRecency, 0, newer, "tweet1"))
+	assert.True(t, cursor.Covers(SearchByRecency, 0, cursor.Timestamp, "tweet5"))
+	assert.False(t, cursor.Covers(SearchByRecency, 0, cursor.Timestamp, "tweet4"))
+	assert.False(t, cursor.Covers(SearchByRecency, 9, older, "tweet9"))
+	assert.True(t, cursor.Covers(SearchByRelevance, 2, older, "tweet1"))
+	assert.False(t, cursor.Covers(SearchByRelevance, 1, newer, "tweet9"))
+}
+
+func TestSortSearchResults(t *testing.T) {
+	now := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	a := SearchResult{Tweet: &Tweet{ID: "a", Timestamp: now.Add(-time.Hour)}, Score: 2}
+	b := SearchResult{Tweet: &Tweet{ID: "b", Timestamp: now}, Score: 1}
+	c := SearchResult{Tweet: &Tweet{ID: "c", Timestamp: now}, Score: 1}
+
+	results := []SearchResult{a, b, c}
+	SortSearchResults(results, SearchByRecency)
+	assert.Equal(t, []SearchResult{c, b, a}, results)
+
+	SortSearchResults(results, SearchByRelevance)
+	assert.Equal(t, []SearchResult{a, c, b}, results)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "hash/fnv"
+
+// CountMinSketch estimates the total weight added for each key in a fixed
+// amount of memory. Estimates never fall below the true weight and exceed it
+// only through hash collisions, by a small fraction of the total weight.
+type CountMinSketch struct {
+	width int
+	rows  [][]float64
+}
+
+func NewCountMinSketch(width, depth int) *CountMinSketch {
+	rows := make([][]float64, depth)
+	for i := range rows {
+		rows[i] = make([]float64, width)
+	}
+	return &CountMinSketch{
+		width: width,
+		rows:  rows,
+	}
+}
+
+func (s *CountMinSketch) Add(key string, weight float64) {
+	h1, h2 := sketchHashes(key)
+	for i, row := range s.rows {
+		row[s.index(h1, h2, i)] += weight
+	}
+}
+
+func (s *CountMinSketch) Estimate(key string) float64 {
+	h1, h2 := sketchHashes(key)
+	estimate := 0.0
+	for i, row := range s.rows {
+		if count := row[s.index(h1, h2, i)]; i == 0 || count < estimate {
+			estimate = count
+		}
+	}
+	return estimate
+}
+
+// Scale multiplies every counter by factor.
+func (s *CountMinSketch) Scale(factor float64) {
+	for _, row := range s.rows {
+		for j := range row {
+			row[j] *= factor
+		}
+	}
+}
+
+func (s *CountMinSketch) Reset() {
+	s.Scale(0)
+}
+
+func (s *CountMinSketch) index(h1, h2 uint32, row int) int {
+	return int((h1 + uint32(row)*h2) % uint32(s.width))
+}
+
+// sketchHashes derives the two hashes from which each row's hash is built.
+func sketchHashes(key string) (uint32, uint32) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum64()
+	return uint32(sum), uint32(sum>>32) | 1
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	sketch := NewCountMinSketch(256, 4)
+
+	for i := 0; i < 1000; i++ {
+		sketch.Add(fmt.Sprintf("key%d", i%100), 1)
+	}
+	sketch.Add("hot", 500)
+
+	assert.GreaterOrEqual(t, sketch.Estimate("hot"), 500.0)
+	assert.Less(t, sketch.Estimate("hot"), 550.0)
+	for i := 0; i < 100; i++ {
+		assert.GreaterOrEqual(t, sketch.Estimate(fmt.Sprintf("key%d", i)), 10.0)
+	}
+	assert.Less(t, sketch.Estimate("missing"), 50.0)
+
+	sketch.Scale(0.5)
+	assert.GreaterOrEqual(t, sketch.Estimate("hot"), 250.0)
+
+	sketch.Reset()
+	assert.Equal(t, 0.0, sketch.Estimate("hot"))
+}
@@ -0,0 +1,12 @@
+package domain
+
+// Suggestion is a user worth following, found among the users followed by the
+// accounts a user already follows.
+type Suggestion struct {
+	UserID   string
+	Username string
+	// MutualCount is how many of the followed accounts follow UserID, Mutuals
+	// a few of them.
+	MutualCount int
+	Mutuals     []string
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// TrendWindow configures one of the sliding windows over which hashtags are
+// counted. The window is split into Buckets; older buckets weigh less, halving
+// every HalfLife. A hashtag trends when its count in the window exceeds what
+// its long-term rate, decayed with a half-life of Baseline, predicts.
+type TrendWindow struct {
+	Name     string
+	Length   time.Duration
+	Buckets  int
+	HalfLife time.Duration
+	Baseline time.Duration
+}
+
+type Trend struct {
+	Hashtag string
+	// Count is the decayed number of tweets using the hashtag in the window,
+	// Expected the count its baseline rate predicts.
+	Count    float64
+	Expected float64
+	Score    float64
+}
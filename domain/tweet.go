@@ -1,22 +1,158 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxTweetLength is the maximum number of user-perceived characters in a tweet.
+	MaxTweetLength = 280
+	// URLLength is the fixed weight of a URL, whatever its actual length.
+	URLLength = 23
+)
+
+var urlPattern = regexp.MustCompile(`https?://[^\s]+`)
+
+// TweetTooLongError reports content that exceeds MaxTweetLength.
+type TweetTooLongError struct {
+	Length int
+	Max    int
+}
+
+func (e *TweetTooLongError) Error() string {
+	return fmt.Sprintf("%v: %d characters, maximum is %d", ErrInvalidTweetContent, e.Length, e.Max)
+}
+
+func (e *TweetTooLongError) Unwrap() error {
+	return ErrInvalidTweetContent
+}
 
 type Tweet struct {
 	ID        string
 	UserID    string
 	Content   string
+	Entities  []Entity
+	Media     []TweetMedia
+	Poll      *Poll
 	Timestamp time.Time
 }
 
+// TweetMedia is the part of a Media a tweet carries along, so that timelines
+// can render attachments without looking each one up.
+type TweetMedia struct {
+	ID          string
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// TweetAttachments are what a tweet may carry besides its text: either media
+// or a poll.
+type TweetAttachments struct {
+	MediaIDs []string
+	Poll     *PollDraft
+}
+
+// Validate checks the attachments before they are looked up.
+func (a TweetAttachments) Validate() error {
+	if len(a.MediaIDs) > MaxMediaPerTweet {
+		return ErrTooManyMedia
+	}
+	if a.Poll != nil {
+		if len(a.MediaIDs) > 0 {
+			return ErrPollWithMedia
+		}
+		if _, err := a.Poll.Validate(); err != nil {
+			return err
+		}
+	}
+	seen := make(map[string]bool, len(a.MediaIDs))
+	for _, id := range a.MediaIDs {
+		if seen[id] {
+			return fmt.Errorf("%w: media %s attached twice", ErrInvalidTweetContent, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
 func NewTweet(id, userID, content string) (*Tweet, error) {
-	if len(content) == 0 || len(content) > 280 {
-		return nil, ErrInvalidTweetContent
+	return NewTweetWithAttachments(id, userID, content, nil, nil)
+}
+
+// NewTweetWithAttachments creates a tweet with the given media or poll
+// attached. A tweet with media may have no text; the text of a tweet with a
+// poll is its question.
+func NewTweetWithAttachments(id, userID, content string, media []*Media, poll *PollDraft) (*Tweet, error) {
+	content = NormalizeTweetContent(content)
+	if content == "" && len(media) == 0 {
+		return nil, ErrEmptyTweetContent
 	}
-	return &Tweet{
+	if length := TweetLength(content); length > MaxTweetLength {
+		return nil, &TweetTooLongError{Length: length, Max: MaxTweetLength}
+	}
+	if len(media) > MaxMediaPerTweet {
+		return nil, ErrTooManyMedia
+	}
+
+	tweet := &Tweet{
 		ID:        id,
 		UserID:    userID,
 		Content:   content,
+		Entities:  ExtractEntities(content),
 		Timestamp: time.Now(),
-	}, nil
+	}
+	if poll != nil {
+		if len(media) > 0 {
+			return nil, ErrPollWithMedia
+		}
+		var err error
+		if tweet.Poll, err = NewPoll(poll, tweet.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range media {
+		tweet.Media = append(tweet.Media, TweetMedia{
+			ID:          m.ID,
+			ContentType: m.ContentType,
+			Width:       m.Width,
+			Height:      m.Height,
+		})
+	}
+	return tweet, nil
+}
+
+// NormalizeTweetContent converts content to NFC, strips control characters
+// other than line breaks and tabs, and trims surrounding whitespace.
+func NormalizeTweetContent(content string) string {
+	content = norm.NFC.String(content)
+	content = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, content)
+	return strings.TrimSpace(content)
+}
+
+// TweetLength counts content in grapheme clusters, with every URL counted as
+// URLLength characters.
+func TweetLength(content string) int {
+	length := 0
+	last := 0
+	for _, loc := range urlPattern.FindAllStringIndex(content, -1) {
+		length += uniseg.GraphemeClusterCount(content[last:loc[0]]) + URLLength
+		last = loc[1]
+	}
+	return length + uniseg.GraphemeClusterCount(content[last:])
 }
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTweet(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantContent string
+		wantErr     error
+	}{
+		{
+			name:        "plain content",
+			content:     "Hello, world!",
+			wantContent: "Hello, world!",
+		},
+		{
+			name:        "accented content within limit",
+			content:     strings.Repeat("ñ", 280),
+			wantContent: strings.Repeat("ñ", 280),
+		},
+		{
+			name:        "emoji content within limit",
+			content:     strings.Repeat("👍🏽", 280),
+			wantContent: strings.Repeat("👍🏽", 280),
+		},
+		{
+			name:        "decomposed content is normalized",
+			content:     "cafe\u0301",
+			wantContent: "caf\u00e9",
+		},
+		{
+			name:        "control characters are stripped",
+			content:     "  hola\x00 mundo\x1b\r  ",
+			wantContent: "hola mundo",
+		},
+		{
+			name:    "empty content",
+			content: "",
+			wantErr: ErrEmptyTweetContent,
+		},
+		{
+			name:    "whitespace only content",
+			content: " \n\t ",
+			wantErr: ErrEmptyTweetContent,
+		},
+		{
+			name:    "content too long",
+			content: strings.Repeat("a", 281),
+			wantErr: &TweetTooLongError{Length: 281, Max: MaxTweetLength},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tweet, err := NewTweet("tweet1", "user1", tt.content)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.True(t, errors.Is(err, ErrInvalidTweetContent))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContent, tweet.Content)
+		})
+	}
+}
+
+func TestNewTweetWithAttachments(t *testing.T) {
+	photo := &Media{ID: "media1", UserID: "user1", ContentType: "image/png", Width: 640, Height: 480}
+	tests := []struct {
+		name      string
+		content   string
+		media     []*Media
+		poll      *PollDraft
+		wantMedia []TweetMedia
+		wantPoll  []string
+		wantErr   error
+	}{
+		{
+			name:      "content and media",
+			content:   "Look",
+			media:     []*Media{photo},
+			wantMedia: []TweetMedia{{ID: "media1", ContentType: "image/png", Width: 640, Height: 480}},
+		},
+		{
+			name:      "media without content",
+			media:     []*Media{photo},
+			wantMedia: []TweetMedia{{ID: "media1", ContentType: "image/png", Width: 640, Height: 480}},
+		},
+		{
+			name:    "neither content nor media",
+			wantErr: ErrEmptyTweetContent,
+		},
+		{
+			name:    "too many media",
+			content: "Look",
+			media:   []*Media{photo, photo, photo, photo, photo},
+			wantErr: ErrTooManyMedia,
+		},
+		{
+			name:     "poll",
+			content:  "Tabs or spaces?",
+			poll:     &PollDraft{Options: []string{" Tabs", "Spaces "}, Duration: time.Hour},
+			wantPoll: []string{"Tabs", "Spaces"},
+		},
+		{
+			name:    "poll without question",
+			poll:    &PollDraft{Options: []string{"Tabs", "Spaces"}, Duration: time.Hour},
+			wantErr: ErrEmptyTweetContent,
+		},
+		{
+			name:    "media and poll",
+			content: "Look",
+			media:   []*Media{photo},
+			poll:    &PollDraft{Options: []string{"Tabs", "Spaces"}, Duration: time.Hour},
+			wantErr: ErrPollWithMedia,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tweet, err := NewTweetWithAttachments("tweet1", "user1", tt.content, tt.media, tt.poll)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMedia, tweet.Media)
+			if tt.wantPoll == nil {
+				assert.Nil(t, tweet.Poll)
+				return
+			}
+			assert.Equal(t, tt.wantPoll, tweet.Poll.Options)
+			assert.Equal(t, tweet.Timestamp.Add(time.Hour), tweet.Poll.EndsAt)
+		})
+	}
+}
+
+func TestTweetAttachments_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		attachments TweetAttachments
+		wantErr     bool
+	}{
+		{name: "no attachments"},
+		{name: "some media", attachments: TweetAttachments{MediaIDs: []string{"media1", "media2"}}},
+		{name: "too many media", attachments: TweetAttachments{MediaIDs: []string{"1", "2", "3", "4", "5"}}, wantErr: true},
+		{name: "duplicate media", attachments: TweetAttachments{MediaIDs: []string{"media1", "media1"}}, wantErr: true},
+		{name: "poll", attachments: TweetAttachments{Poll: &PollDraft{Options: []string{"a", "b"}, Duration: time.Hour}}},
+		{name: "invalid poll", attachments: TweetAttachments{Poll: &PollDraft{Options: []string{"a"}, Duration: time.Hour}}, wantErr: true},
+		{name: "media and poll", attachments: TweetAttachments{MediaIDs: []string{"media1"}, Poll: &PollDraft{Options: []string{"a", "b"}, Duration: time.Hour}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.attachments.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTweetContent)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTweetTooLongError_Error(t *testing.T) {
+	err := &TweetTooLongError{Length: 300, Max: 280}
+	assert.Equal(t, "invalid tweet content: 300 characters, maximum is 280", err.Error())
+}
+
+func TestTweetLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+	}{
+		{name: "ascii", content: "hello", want: 5},
+		{name: "accented", content: "canción", want: 7},
+		{name: "combined emoji", content: "👨‍👩‍👧‍👦🇨🇴", want: 2},
+		{name: "url", content: "https://example.com/a/very/long/path/that/goes/on", want: URLLength},
+		{name: "text and urls", content: "mira https://a.co y http://b.co", want: 5 + URLLength + 3 + URLLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TweetLength(tt.content))
+		})
+	}
+}
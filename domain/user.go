@@ -1,18 +1,91 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"maps"
+)
 
 var (
 	ErrInvalidTweetContent = errors.New("invalid tweet content")
+	ErrEmptyTweetContent   = fmt.Errorf("%w: content is empty", ErrInvalidTweetContent)
 	ErrInvalidFollowAction = errors.New("invalid follow action")
 	ErrAlreadyFollowing    = errors.New("already following")
 	ErrUserNotFound        = errors.New("user not found")
+	ErrInvalidBlockAction  = errors.New("invalid block action")
+	ErrAlreadyBlocked      = errors.New("already blocked")
+	ErrNotBlocked          = errors.New("not blocked")
+	ErrUserBlocked         = errors.New("user blocked")
+	ErrInvalidMuteAction   = errors.New("invalid mute action")
+	ErrAlreadyMuted        = errors.New("already muted")
+	ErrNotMuted            = errors.New("not muted")
+	ErrProtectedAccount    = errors.New("account is protected")
+
+	ErrFollowRequestNotFound      = errors.New("follow request not found")
+	ErrFollowRequestPending       = errors.New("follow request already pending")
+	ErrInvalidFollowRequestAction = errors.New("invalid follow request action")
+
+	ErrNotificationNotFound = errors.New("notification not found")
+
+	ErrListNotFound      = errors.New("list not found")
+	ErrInvalidListName   = errors.New("invalid list name")
+	ErrNotListOwner      = errors.New("not the list owner")
+	ErrAlreadyListMember = errors.New("already a list member")
+	ErrNotListMember     = errors.New("not a list member")
+	ErrListFull          = errors.New("list is full")
+
+	ErrTweetNotFound         = errors.New("tweet not found")
+	ErrAlreadyBookmarked     = errors.New("already bookmarked")
+	ErrBookmarkNotFound      = errors.New("bookmark not found")
+	ErrInvalidBookmarkCursor = errors.New("invalid bookmark cursor")
+
+	ErrScheduledTweetNotFound   = errors.New("scheduled tweet not found")
+	ErrInvalidPublishTime       = errors.New("publish time must be in the future")
+	ErrScheduledTweetNotPending = errors.New("scheduled tweet is no longer pending")
+	ErrScheduledTweetConflict   = errors.New("scheduled tweet was changed concurrently")
+
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrInvalidMedia         = errors.New("invalid media")
+	ErrUnsupportedMediaType = fmt.Errorf("%w: unsupported media type", ErrInvalidMedia)
+	ErrMediaTooLarge        = fmt.Errorf("%w: media is too large", ErrInvalidMedia)
+	ErrTooManyMedia         = fmt.Errorf("%w: too many media attached", ErrInvalidTweetContent)
+
+	ErrInvalidPoll       = fmt.Errorf("%w: invalid poll", ErrInvalidTweetContent)
+	ErrPollWithMedia     = fmt.Errorf("%w: a tweet cannot carry both media and a poll", ErrInvalidTweetContent)
+	ErrTweetHasNoPoll    = errors.New("tweet has no poll")
+	ErrPollClosed        = errors.New("poll is closed")
+	ErrInvalidPollOption = errors.New("invalid poll option")
+	ErrAlreadyVoted      = errors.New("already voted")
+
+	ErrInvalidSearchQuery  = errors.New("invalid search query")
+	ErrInvalidSearchCursor = errors.New("invalid search cursor")
+
+	ErrInvalidTrendWindow = errors.New("invalid trend window")
+
+	ErrImportTooLarge          = errors.New("import is too large")
+	ErrImportJobNotFound       = errors.New("import job not found")
+	ErrInvalidImport           = errors.New("invalid import")
+	ErrUnsupportedImportFormat = fmt.Errorf("%w: unsupported format", ErrInvalidImport)
+
+	ErrAccountExportNotFound = errors.New("account export not found")
+	ErrAccountExportNotReady = errors.New("account export is not ready")
+
+	ErrAccountDeletionNotFound = errors.New("account deletion not found")
 )
 
 type User struct {
-	ID        string
-	Username  string
-	Following map[string]bool
+	ID          string
+	Username    string
+	DisplayName string
+	Following   map[string]bool
+	Blocked     map[string]bool
+	Muted       map[string]bool
+	// Protected accounts approve their followers, and only show their tweets
+	// to them.
+	Protected bool
+	// Deactivated users are being deleted: they are hidden right away, and
+	// purged once their AccountDeletion is due.
+	Deactivated bool
 }
 
 func NewUser(id, username string) *User {
@@ -20,16 +93,119 @@ func NewUser(id, username string) *User {
 		ID:        id,
 		Username:  username,
 		Following: make(map[string]bool),
+		Blocked:   make(map[string]bool),
+		Muted:     make(map[string]bool),
 	}
 }
 
+// Clone returns a copy of u that can be changed without changing u.
+func (u *User) Clone() *User {
+	clone := *u
+	clone.Following = maps.Clone(u.Following)
+	clone.Blocked = maps.Clone(u.Blocked)
+	clone.Muted = maps.Clone(u.Muted)
+	return &clone
+}
+
+// Deactivate hides u until they are purged.
+func (u *User) Deactivate() {
+	u.Deactivated = true
+}
+
 func (u *User) Follow(userID string) error {
+	if err := u.CanFollow(userID); err != nil {
+		return err
+	}
+	u.Following[userID] = true
+	return nil
+}
+
+// CanFollow checks that u may start following userID.
+func (u *User) CanFollow(userID string) error {
 	if u.ID == userID {
 		return ErrInvalidFollowAction
 	}
 	if _, exists := u.Following[userID]; exists {
 		return ErrAlreadyFollowing
 	}
-	u.Following[userID] = true
 	return nil
 }
+
+func (u *User) Unfollow(userID string) {
+	delete(u.Following, userID)
+}
+
+// Block blocks userID and stops following them. The blocked user is expected
+// to stop following u as well; see BlockedBetween.
+func (u *User) Block(userID string) error {
+	if u.ID == userID {
+		return ErrInvalidBlockAction
+	}
+	if u.Blocked[userID] {
+		return ErrAlreadyBlocked
+	}
+	if u.Blocked == nil {
+		u.Blocked = make(map[string]bool)
+	}
+	u.Blocked[userID] = true
+	u.Unfollow(userID)
+	return nil
+}
+
+func (u *User) Unblock(userID string) error {
+	if !u.Blocked[userID] {
+		return ErrNotBlocked
+	}
+	delete(u.Blocked, userID)
+	return nil
+}
+
+// Mute hides userID's tweets from u's timeline without them knowing.
+func (u *User) Mute(userID string) error {
+	if u.ID == userID {
+		return ErrInvalidMuteAction
+	}
+	if u.Muted[userID] {
+		return ErrAlreadyMuted
+	}
+	if u.Muted == nil {
+		u.Muted = make(map[string]bool)
+	}
+	u.Muted[userID] = true
+	return nil
+}
+
+func (u *User) Unmute(userID string) error {
+	if !u.Muted[userID] {
+		return ErrNotMuted
+	}
+	delete(u.Muted, userID)
+	return nil
+}
+
+func (u *User) HasBlocked(userID string) bool {
+	return u.Blocked[userID]
+}
+
+func (u *User) HasMuted(userID string) bool {
+	return u.Muted[userID]
+}
+
+// CanSeeTweets reports whether viewer may read author's tweets: anyone can,
+// unless a block stands between them or author is protected and viewer is not
+// one of their followers. A nil viewer is anonymous.
+func CanSeeTweets(viewer, author *User) bool {
+	if viewer == nil {
+		return !author.Protected
+	}
+	if BlockedBetween(viewer, author) {
+		return false
+	}
+	return !author.Protected || viewer.ID == author.ID || viewer.Following[author.ID]
+}
+
+// BlockedBetween reports whether either user has blocked the other. Blocks
+// are mutual: neither side can follow or mention the other.
+func BlockedBetween(a, b *User) bool {
+	return a.HasBlocked(b.ID) || b.HasBlocked(a.ID)
+}
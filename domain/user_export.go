@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// UserWriter writes users in one of the import formats, so that importing
+// what it wrote gives the same users back. Close must be called once every
+// user is written.
+type UserWriter interface {
+	Write(user *User) error
+	Close() error
+}
+
+// NewUserWriter returns a writer of users in format. The edges format only
+// keeps who follows whom: usernames, display names and users that follow
+// nobody are left out.
+func NewUserWriter(format ImportFormat, w io.Writer) (UserWriter, error) {
+	switch format {
+	case ImportFormatCSV:
+		return newCSVUserWriter(w), nil
+	case ImportFormatJSON:
+		return &jsonUserWriter{w: w}, nil
+	case ImportFormatNDJSON:
+		return &ndjsonUserWriter{encoder: json.NewEncoder(w)}, nil
+	case ImportFormatEdges:
+		return newEdgeUserWriter(w), nil
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnsupportedImportFormat, format)
+}
+
+func newUserJSON(user *User) userJSON {
+	return userJSON{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Following:   sortedKeys(user.Following),
+	}
+}
+
+type csvUserWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+func newCSVUserWriter(w io.Writer) *csvUserWriter {
+	return &csvUserWriter{writer: csv.NewWriter(w)}
+}
+
+// Write fails for users following IDs that hold spaces or semicolons, as
+// those separate the IDs of the following column.
+func (cw *csvUserWriter) Write(user *User) error {
+	if !cw.header {
+		cw.header = true
+		if err := cw.writer.Write([]string{"id", "username", "display_name", "following"}); err != nil {
+			return err
+		}
+	}
+	ids := sortedKeys(user.Following)
+	for _, id := range ids {
+		if strings.ContainsFunc(id, func(r rune) bool { return r == ';' || unicode.IsSpace(r) }) {
+			return fmt.Errorf("user %s follows %q, which cannot be written as CSV", user.ID, id)
+		}
+	}
+	return cw.writer.Write([]string{user.ID, user.Username, user.DisplayName, strings.Join(ids, " ")})
+}
+
+func (cw *csvUserWriter) Close() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+type jsonUserWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (jw *jsonUserWriter) Write(user *User) error {
+	data, err := json.Marshal(newUserJSON(user))
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if jw.count == 0 {
+		separator = "[\n"
+	}
+	jw.count++
+	if _, err := io.WriteString(jw.w, separator); err != nil {
+		return err
+	}
+	_, err = jw.w.Write(data)
+	return err
+}
+
+func (jw *jsonUserWriter) Close() error {
+	end := "\n]\n"
+	if jw.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(jw.w, end)
+	return err
+}
+
+type ndjsonUserWriter struct {
+	encoder *json.Encoder
+}
+
+func (nw *ndjsonUserWriter) Write(user *User) error {
+	return nw.encoder.Encode(newUserJSON(user))
+}
+
+func (nw *ndjsonUserWriter) Close() error {
+	return nil
+}
+
+type edgeUserWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+func newEdgeUserWriter(w io.Writer) *edgeUserWriter {
+	return &edgeUserWriter{writer: csv.NewWriter(w)}
+}
+
+func (ew *edgeUserWriter) Write(user *User) error {
+	if !ew.header {
+		ew.header = true
+		if err := ew.writer.Write([]string{"follower", "followee"}); err != nil {
+			return err
+		}
+	}
+	for _, id := range sortedKeys(user.Following) {
+		if err := ew.writer.Write([]string{user.ID, id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ew *edgeUserWriter) Close() error {
+	ew.writer.Flush()
+	return ew.writer.Error()
+}
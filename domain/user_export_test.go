@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserWriter_RoundTrip(t *testing.T) {
+	ana := NewUser("1", "ana")
+	ana.DisplayName = "Ana, from \"Lima\""
+	ana.Following["3"] = true
+	ana.Following["2"] = true
+	bea := NewUser("2", "bea")
+	bea.Following["1"] = true
+	cid := NewUser("3", "cid")
+
+	for _, format := range []ImportFormat{ImportFormatCSV, ImportFormatJSON, ImportFormatNDJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			writer, err := NewUserWriter(format, &buf)
+			require.NoError(t, err)
+			for _, user := range []*User{ana, bea, cid} {
+				require.NoError(t, writer.Write(user))
+			}
+			require.NoError(t, writer.Close())
+
+			records, err := ReadUserRecords(format, &buf)
+			require.NoError(t, err)
+			require.Len(t, records, 3)
+			assert.Equal(t, "1", records[0].ID)
+			assert.Equal(t, "ana", records[0].Username)
+			assert.Equal(t, ana.DisplayName, records[0].DisplayName)
+			assert.Equal(t, []string{"2", "3"}, records[0].Following)
+			assert.Equal(t, []string{"1"}, records[1].Following)
+			assert.Empty(t, records[2].Following)
+			for _, record := range records {
+				assert.NoError(t, record.Err)
+			}
+		})
+	}
+
+	t.Run("edges", func(t *testing.T) {
+		var buf bytes.Buffer
+		writer, err := NewUserWriter(ImportFormatEdges, &buf)
+		require.NoError(t, err)
+		for _, user := range []*User{ana, bea, cid} {
+			require.NoError(t, writer.Write(user))
+		}
+		require.NoError(t, writer.Close())
+		assert.Equal(t, "follower,followee\n1,2\n1,3\n2,1\n", buf.String())
+
+		records, err := ReadUserRecords(ImportFormatEdges, &buf)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{"2", "3"}, records[0].Following)
+		assert.Equal(t, []string{"1"}, records[1].Following)
+	})
+}
+
+func TestNewUserWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewUserWriter(ImportFormatJSON, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	assert.Equal(t, "[]\n", buf.String())
+
+	records, err := ReadUserRecords(ImportFormatJSON, &buf)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestNewUserWriter_Invalid(t *testing.T) {
+	_, err := NewUserWriter("xml", &bytes.Buffer{})
+	assert.ErrorIs(t, err, ErrUnsupportedImportFormat)
+
+	writer, err := NewUserWriter(ImportFormatCSV, &bytes.Buffer{})
+	require.NoError(t, err)
+	user := NewUser("1", "ana")
+	user.Following["user 2"] = true
+	assert.Error(t, writer.Write(user))
+}
@@ -0,0 +1,425 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+type ImportFormat string
+
+const (
+	// ImportFormatCSV is RFC 4180 CSV. With a header, its columns are any of
+	// id, username, display_name and following, the last holding the IDs of
+	// followed users separated by spaces or semicolons. Without one, each
+	// row is a username followed by the users it follows, as in
+	// docs/load.csv.
+	ImportFormatCSV ImportFormat = "csv"
+	// ImportFormatJSON is an array of user objects with the same fields as
+	// the CSV columns, following being an array.
+	ImportFormatJSON ImportFormat = "json"
+	// ImportFormatNDJSON holds one user object per line.
+	ImportFormatNDJSON ImportFormat = "ndjson"
+	// ImportFormatEdges is a CSV of follower,followee pairs, with an
+	// optional header. The users are the followers, in the order they first
+	// appear.
+	ImportFormatEdges ImportFormat = "edges"
+)
+
+// ImportMergeStrategy decides what an import does with a user that already
+// exists.
+type ImportMergeStrategy string
+
+const (
+	// ImportMergeReplace replaces the name and follows of the user with those
+	// in the file. Blocks, mutes and privacy are kept.
+	ImportMergeReplace ImportMergeStrategy = "replace"
+	// ImportMergeFollows renames the user and adds the follows in the file to
+	// those the user has.
+	ImportMergeFollows ImportMergeStrategy = "merge-follows"
+	// ImportSkipExisting leaves the user as it is.
+	ImportSkipExisting ImportMergeStrategy = "skip-existing"
+)
+
+// ImportDanglingPolicy decides what an import does with a followed user that
+// neither exists nor is in the file.
+type ImportDanglingPolicy string
+
+const (
+	// ImportDanglingReject fails the row that follows the user.
+	ImportDanglingReject ImportDanglingPolicy = "reject"
+	// ImportDanglingCreate creates the user, with its ID as username.
+	ImportDanglingCreate ImportDanglingPolicy = "create"
+)
+
+// ImportOptions are chosen when an import starts. Left empty, Merge is
+// ImportMergeFollows and Dangling is ImportDanglingReject.
+type ImportOptions struct {
+	Format   ImportFormat
+	Merge    ImportMergeStrategy
+	Dangling ImportDanglingPolicy
+	// DryRun checks every row without saving any user.
+	DryRun bool
+}
+
+// UserRecord is a user as read from an import. Line is the line the record
+// starts on, or its position in a JSON array. A record that could not be read
+// holds the reason in Err.
+type UserRecord struct {
+	Line        int
+	ID          string
+	Username    string
+	DisplayName string
+	Following   []string
+	Err         error
+}
+
+type importFormat struct {
+	contentTypes []string
+	extensions   []string
+	read         func(io.Reader) ([]UserRecord, error)
+}
+
+// importFormats are the formats users can be imported from.
+var importFormats = map[ImportFormat]importFormat{
+	ImportFormatCSV: {
+		contentTypes: []string{"text/csv", "application/csv"},
+		extensions:   []string{".csv"},
+		read:         readCSVUsers,
+	},
+	ImportFormatJSON: {
+		contentTypes: []string{"application/json"},
+		extensions:   []string{".json"},
+		read:         readJSONUsers,
+	},
+	ImportFormatNDJSON: {
+		contentTypes: []string{"application/x-ndjson", "application/ndjson", "application/jsonl"},
+		extensions:   []string{".ndjson", ".jsonl"},
+		read:         readNDJSONUsers,
+	},
+	ImportFormatEdges: {
+		extensions: []string{".edges"},
+		read:       readEdgeUsers,
+	},
+}
+
+func ParseImportFormat(name string) (ImportFormat, error) {
+	format := ImportFormat(strings.ToLower(name))
+	if _, ok := importFormats[format]; !ok {
+		return "", fmt.Errorf("%w %q", ErrUnsupportedImportFormat, name)
+	}
+	return format, nil
+}
+
+func ParseImportMergeStrategy(name string) (ImportMergeStrategy, error) {
+	switch strategy := ImportMergeStrategy(strings.ToLower(name)); strategy {
+	case ImportMergeReplace, ImportMergeFollows, ImportSkipExisting:
+		return strategy, nil
+	}
+	return "", fmt.Errorf("%w: unknown merge strategy %q", ErrInvalidImport, name)
+}
+
+func ParseImportDanglingPolicy(name string) (ImportDanglingPolicy, error) {
+	switch policy := ImportDanglingPolicy(strings.ToLower(name)); policy {
+	case ImportDanglingReject, ImportDanglingCreate:
+		return policy, nil
+	}
+	return "", fmt.Errorf("%w: unknown dangling policy %q", ErrInvalidImport, name)
+}
+
+// DetectImportFormat picks the format of a file from the extension of its
+// name, or else from its content type. Files of no particular type are read
+// as CSV.
+func DetectImportFormat(filename, contentType string) (ImportFormat, error) {
+	ext := strings.ToLower(path.Ext(filename))
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	for name, format := range importFormats {
+		if ext != "" && slices.Contains(format.extensions, ext) {
+			return name, nil
+		}
+	}
+	for name, format := range importFormats {
+		if slices.Contains(format.contentTypes, mediaType) {
+			return name, nil
+		}
+	}
+	switch mediaType {
+	case "", "application/octet-stream", "text/plain":
+		return ImportFormatCSV, nil
+	}
+	return "", fmt.Errorf("%w %q", ErrUnsupportedImportFormat, mediaType)
+}
+
+// ReadUserRecords reads the users of an import. Records that cannot be read
+// are returned with their error, so that the rest of the import goes on; the
+// error is only for files that cannot be read at all.
+func ReadUserRecords(format ImportFormat, r io.Reader) ([]UserRecord, error) {
+	f, ok := importFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedImportFormat, format)
+	}
+	records, err := f.read(skipBOM(r))
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		records[i].normalize()
+	}
+	return records, nil
+}
+
+// normalize trims the fields of r. Users are identified by username unless
+// given an ID.
+func (r *UserRecord) normalize() {
+	r.ID = strings.TrimSpace(r.ID)
+	r.Username = strings.TrimSpace(r.Username)
+	r.DisplayName = strings.TrimSpace(r.DisplayName)
+	for i, following := range r.Following {
+		r.Following[i] = strings.TrimSpace(following)
+	}
+	if r.ID == "" {
+		r.ID = r.Username
+	}
+}
+
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\xEF\xBB\xBF")) {
+		br.Discard(3)
+	}
+	return br
+}
+
+// csvRow reads the next row of reader. A row that breaks the CSV syntax is
+// returned as a record holding the error.
+func csvRow(reader *csv.Reader) ([]string, int, error) {
+	fields, err := reader.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, parseErr.StartLine, parseErr.Err
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	line, _ := reader.FieldPos(0)
+	return fields, line, nil
+}
+
+func newCSVReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	return reader
+}
+
+var csvColumns = map[string]string{
+	"id":           "id",
+	"username":     "username",
+	"display_name": "display_name",
+	"display name": "display_name",
+	"displayname":  "display_name",
+	"following":    "following",
+}
+
+// csvHeader returns the columns named by fields, or nil if fields is a row of
+// users rather than a header.
+func csvHeader(fields []string) []string {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		column, ok := csvColumns[strings.ToLower(strings.TrimSpace(field))]
+		if !ok {
+			return nil
+		}
+		columns[i] = column
+	}
+	if !slices.Contains(columns, "username") && !slices.Contains(columns, "id") {
+		return nil
+	}
+	return columns
+}
+
+func readCSVUsers(r io.Reader) ([]UserRecord, error) {
+	reader := newCSVReader(r)
+	var columns []string
+	var records []UserRecord
+	for first := true; ; first = false {
+		fields, line, err := csvRow(reader)
+		if err == io.EOF {
+			return records, nil
+		}
+		if line == 0 {
+			return nil, err
+		}
+		if err != nil {
+			records = append(records, UserRecord{Line: line, Err: err})
+			continue
+		}
+		if first {
+			if columns = csvHeader(fields); columns != nil {
+				continue
+			}
+		}
+		records = append(records, csvRecord(line, columns, fields))
+	}
+}
+
+func csvRecord(line int, columns, fields []string) UserRecord {
+	record := UserRecord{Line: line}
+	if columns == nil {
+		record.Username, record.Following = fields[0], fields[1:]
+		return record
+	}
+	if len(fields) > len(columns) {
+		record.Err = fmt.Errorf("row has %d fields but the header has %d", len(fields), len(columns))
+		return record
+	}
+	for i, field := range fields {
+		switch columns[i] {
+		case "id":
+			record.ID = field
+		case "username":
+			record.Username = field
+		case "display_name":
+			record.DisplayName = field
+		case "following":
+			record.Following = strings.FieldsFunc(field, func(r rune) bool {
+				return r == ';' || unicode.IsSpace(r)
+			})
+		}
+	}
+	if record.Username == "" {
+		record.Username = record.ID
+	}
+	return record
+}
+
+type userJSON struct {
+	ID          string   `json:"id"`
+	Username    string   `json:"username"`
+	DisplayName string   `json:"display_name"`
+	Following   []string `json:"following"`
+}
+
+func (u userJSON) record(line int) UserRecord {
+	username := u.Username
+	if username == "" {
+		username = u.ID
+	}
+	return UserRecord{
+		Line:        line,
+		ID:          u.ID,
+		Username:    username,
+		DisplayName: u.DisplayName,
+		Following:   u.Following,
+	}
+}
+
+// invalidImport reports a file that cannot be read as a whole, passing read
+// errors such as ErrImportTooLarge through.
+func invalidImport(err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	return err
+}
+
+func readJSONUsers(r io.Reader) ([]UserRecord, error) {
+	decoder := json.NewDecoder(r)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		if err != nil && err != io.EOF {
+			return nil, invalidImport(err)
+		}
+		return nil, fmt.Errorf("%w: expected an array of users", ErrInvalidImport)
+	}
+	var records []UserRecord
+	for position := 1; decoder.More(); position++ {
+		var user userJSON
+		err := decoder.Decode(&user)
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			records = append(records, UserRecord{Line: position, Err: err})
+			continue
+		}
+		if err != nil {
+			return nil, invalidImport(err)
+		}
+		records = append(records, user.record(position))
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, invalidImport(err)
+	}
+	return records, nil
+}
+
+func readNDJSONUsers(r io.Reader) ([]UserRecord, error) {
+	var records []UserRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var user userJSON
+		if err := json.Unmarshal(text, &user); err != nil {
+			records = append(records, UserRecord{Line: line, Err: err})
+			continue
+		}
+		records = append(records, user.record(line))
+	}
+	if err := scanner.Err(); err != nil {
+		if err == bufio.ErrTooLong {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		return nil, err
+	}
+	return records, nil
+}
+
+func readEdgeUsers(r io.Reader) ([]UserRecord, error) {
+	reader := newCSVReader(r)
+	var records []UserRecord
+	followers := make(map[string]int)
+	for first := true; ; first = false {
+		fields, line, err := csvRow(reader)
+		if err == io.EOF {
+			return records, nil
+		}
+		if line == 0 {
+			return nil, err
+		}
+		if err != nil {
+			records = append(records, UserRecord{Line: line, Err: err})
+			continue
+		}
+		if len(fields) != 2 {
+			records = append(records, UserRecord{Line: line, Err: fmt.Errorf("row has %d fields instead of follower and followee", len(fields))})
+			continue
+		}
+		follower, followee := strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		if first && strings.EqualFold(follower, "follower") && strings.EqualFold(followee, "followee") {
+			continue
+		}
+		if follower == "" || followee == "" {
+			records = append(records, UserRecord{Line: line, Err: errors.New("follower or followee is empty")})
+			continue
+		}
+		i, seen := followers[follower]
+		if !seen {
+			i = len(records)
+			followers[follower] = i
+			records = append(records, UserRecord{Line: line, Username: follower})
+		}
+		records[i].Following = append(records[i].Following, followee)
+	}
+}
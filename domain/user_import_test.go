@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordSummary is a UserRecord with its error turned into text, so that
+// records are easy to compare.
+type recordSummary struct {
+	Line        int
+	ID          string
+	Username    string
+	DisplayName string
+	Following   []string
+	Err         string
+}
+
+func summarize(records []UserRecord) []recordSummary {
+	summaries := make([]recordSummary, len(records))
+	for i, r := range records {
+		summaries[i] = recordSummary{Line: r.Line, ID: r.ID, Username: r.Username, DisplayName: r.DisplayName, Following: r.Following}
+		if r.Err != nil {
+			summaries[i].Err = r.Err.Error()
+		}
+	}
+	return summaries
+}
+
+func TestReadUserRecords(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ImportFormat
+		data   string
+		want   []recordSummary
+	}{
+		{
+			name:   "legacy csv",
+			format: ImportFormatCSV,
+			data:   "user1,user2,user3\nuser2, user1\n\nuser3\n",
+			want: []recordSummary{
+				{Line: 1, ID: "user1", Username: "user1", Following: []string{"user2", "user3"}},
+				{Line: 2, ID: "user2", Username: "user2", Following: []string{"user1"}},
+				{Line: 4, ID: "user3", Username: "user3", Following: []string{}},
+			},
+		},
+		{
+			name:   "csv with a byte order mark, quotes and crlf",
+			format: ImportFormatCSV,
+			data:   "\xEF\xBB\xBFuser1,\"user,2\"\r\n\"us\"\"er3\",user1\r\n",
+			want: []recordSummary{
+				{Line: 1, ID: "user1", Username: "user1", Following: []string{"user,2"}},
+				{Line: 2, ID: "us\"er3", Username: "us\"er3", Following: []string{"user1"}},
+			},
+		},
+		{
+			name:   "csv with a header",
+			format: ImportFormatCSV,
+			data:   "ID,Username,Display Name,following\n1,ana,\"Ana, la de sistemas\",2;3\n2,bob,,\n,carla,Carla,1 2\n",
+			want: []recordSummary{
+				{Line: 2, ID: "1", Username: "ana", DisplayName: "Ana, la de sistemas", Following: []string{"2", "3"}},
+				{Line: 3, ID: "2", Username: "bob", Following: []string{}},
+				{Line: 4, ID: "carla", Username: "carla", DisplayName: "Carla", Following: []string{"1", "2"}},
+			},
+		},
+		{
+			name:   "csv rows that cannot be read",
+			format: ImportFormatCSV,
+			data:   "username,following\nana,bob,extra\nbob,\"ana\n\"carla\"x,ana\ndavid,ana\n",
+			want: []recordSummary{
+				{Line: 2, Err: "row has 3 fields but the header has 2"},
+				{Line: 3, Err: `extraneous or missing " in quoted-field`},
+				{Line: 5, ID: "david", Username: "david", Following: []string{"ana"}},
+			},
+		},
+		{
+			name:   "json",
+			format: ImportFormatJSON,
+			data:   `[{"id":"1","username":"ana","display_name":"Ana","following":["2"]}, {"username":"bob"}, {"username":3}, {"id":"4"}]`,
+			want: []recordSummary{
+				{Line: 1, ID: "1", Username: "ana", DisplayName: "Ana", Following: []string{"2"}},
+				{Line: 2, ID: "bob", Username: "bob"},
+				{Line: 3, Err: "json: cannot unmarshal number into Go struct field userJSON.username of type string"},
+				{Line: 4, ID: "4", Username: "4"},
+			},
+		},
+		{
+			name:   "ndjson",
+			format: ImportFormatNDJSON,
+			data:   "{\"username\":\"ana\",\"following\":[\"bob\"]}\n\n{\"username\":\n{\"username\":\"bob\"}\r\n",
+			want: []recordSummary{
+				{Line: 1, ID: "ana", Username: "ana", Following: []string{"bob"}},
+				{Line: 3, Err: "unexpected end of JSON input"},
+				{Line: 4, ID: "bob", Username: "bob"},
+			},
+		},
+		{
+			name:   "edge list",
+			format: ImportFormatEdges,
+			data:   "follower,followee\nana,bob\nbob,ana\nana,carla\nana\n,bob\n",
+			want: []recordSummary{
+				{Line: 2, ID: "ana", Username: "ana", Following: []string{"bob", "carla"}},
+				{Line: 3, ID: "bob", Username: "bob", Following: []string{"ana"}},
+				{Line: 5, Err: "row has 1 fields instead of follower and followee"},
+				{Line: 6, Err: "follower or followee is empty"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := ReadUserRecords(tt.format, strings.NewReader(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, summarize(records))
+		})
+	}
+}
+
+func TestReadUserRecords_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ImportFormat
+		data   string
+	}{
+		{name: "json that is not an array", format: ImportFormatJSON, data: `{"username":"ana"}`},
+		{name: "empty json", format: ImportFormatJSON, data: ``},
+		{name: "broken json", format: ImportFormatJSON, data: `[{"username":"ana"},`},
+		{name: "json syntax error", format: ImportFormatJSON, data: `[{"username" "ana"}]`},
+		{name: "unknown format", format: "xml", data: `<users/>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadUserRecords(tt.format, strings.NewReader(tt.data))
+			assert.ErrorIs(t, err, ErrInvalidImport)
+		})
+	}
+}
+
+func TestDetectImportFormat(t *testing.T) {
+	tests := []struct {
+		filename    string
+		contentType string
+		want        ImportFormat
+		wantErr     bool
+	}{
+		{filename: "users.csv", want: ImportFormatCSV},
+		{filename: "USERS.JSON", contentType: "application/octet-stream", want: ImportFormatJSON},
+		{filename: "users.jsonl", want: ImportFormatNDJSON},
+		{filename: "follows.edges", contentType: "text/csv", want: ImportFormatEdges},
+		{contentType: "application/x-ndjson", want: ImportFormatNDJSON},
+		{contentType: "application/json; charset=utf-8", want: ImportFormatJSON},
+		{filename: "users.txt", contentType: "text/csv", want: ImportFormatCSV},
+		{want: ImportFormatCSV},
+		{contentType: "application/xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename+" "+tt.contentType, func(t *testing.T) {
+			format, err := DetectImportFormat(tt.filename, tt.contentType)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedImportFormat)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, format)
+		})
+	}
+}
+
+func TestParseImportFormat(t *testing.T) {
+	format, err := ParseImportFormat("NDJSON")
+	assert.NoError(t, err)
+	assert.Equal(t, ImportFormatNDJSON, format)
+
+	_, err = ParseImportFormat("xml")
+	assert.ErrorIs(t, err, ErrUnsupportedImportFormat)
+}
+
+func TestParseImportMergeStrategy(t *testing.T) {
+	strategy, err := ParseImportMergeStrategy("skip-existing")
+	assert.NoError(t, err)
+	assert.Equal(t, ImportSkipExisting, strategy)
+
+	_, err = ParseImportMergeStrategy("overwrite")
+	assert.ErrorIs(t, err, ErrInvalidImport)
+}
+
+func TestParseImportDanglingPolicy(t *testing.T) {
+	policy, err := ParseImportDanglingPolicy("Create")
+	assert.NoError(t, err)
+	assert.Equal(t, ImportDanglingCreate, policy)
+
+	_, err = ParseImportDanglingPolicy("ignore")
+	assert.ErrorIs(t, err, ErrInvalidImport)
+}
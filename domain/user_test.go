@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_Block(t *testing.T) {
+	user := NewUser("user1", "user1")
+	user.Following["user2"] = true
+	other := NewUser("user2", "user2")
+
+	assert.Equal(t, ErrInvalidBlockAction, user.Block("user1"))
+	assert.NoError(t, user.Block("user2"))
+	assert.False(t, user.Following["user2"])
+	assert.True(t, user.HasBlocked("user2"))
+	assert.True(t, BlockedBetween(user, other))
+	assert.True(t, BlockedBetween(other, user))
+	assert.Equal(t, ErrAlreadyBlocked, user.Block("user2"))
+
+	assert.NoError(t, user.Unblock("user2"))
+	assert.False(t, BlockedBetween(user, other))
+	assert.Equal(t, ErrNotBlocked, user.Unblock("user2"))
+}
+
+func TestUser_Mute(t *testing.T) {
+	user := NewUser("user1", "user1")
+	user.Following["user2"] = true
+
+	assert.Equal(t, ErrInvalidMuteAction, user.Mute("user1"))
+	assert.NoError(t, user.Mute("user2"))
+	assert.True(t, user.Following["user2"])
+	assert.True(t, user.HasMuted("user2"))
+	assert.Equal(t, ErrAlreadyMuted, user.Mute("user2"))
+
+	assert.NoError(t, user.Unmute("user2"))
+	assert.False(t, user.HasMuted("user2"))
+	assert.Equal(t, ErrNotMuted, user.Unmute("user2"))
+}
+
+func TestUser_Clone(t *testing.T) {
+	user := NewUser("user1", "user1")
+	user.Following["user2"] = true
+
+	clone := user.Clone()
+	assert.NoError(t, clone.Follow("user3"))
+	assert.NoError(t, clone.Block("user2"))
+
+	assert.Equal(t, map[string]bool{"user2": true}, user.Following)
+	assert.False(t, user.HasBlocked("user2"))
+}
+
+func TestUser_BlockWithoutMaps(t *testing.T) {
+	// Users stored before blocking existed have no Blocked or Muted maps.
+	user := &User{ID: "user1", Following: map[string]bool{}}
+
+	assert.False(t, user.HasBlocked("user2"))
+	assert.NoError(t, user.Block("user2"))
+	assert.NoError(t, user.Mute("user3"))
+}
+
+func TestCanSeeTweets(t *testing.T) {
+	author := NewUser("user1", "user1")
+	follower := NewUser("user2", "user2")
+	follower.Following["user1"] = true
+	stranger := NewUser("user3", "user3")
+
+	assert.True(t, CanSeeTweets(nil, author))
+	assert.True(t, CanSeeTweets(stranger, author))
+
+	author.Protected = true
+	assert.False(t, CanSeeTweets(nil, author))
+	assert.False(t, CanSeeTweets(stranger, author))
+	assert.True(t, CanSeeTweets(follower, author))
+	assert.True(t, CanSeeTweets(author, author))
+
+	author.Protected = false
+	assert.NoError(t, author.Block("user3"))
+	assert.False(t, CanSeeTweets(stranger, author))
+}
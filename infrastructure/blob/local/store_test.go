@@ -0,0 +1,54 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(context.Background(), "media1", "image/png", []byte("image")))
+	require.NoError(t, store.Put(context.Background(), "media1/thumbnail", "image/png", []byte("thumb")))
+
+	data, err := store.Get(context.Background(), "media1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("image"), data)
+	data, err = store.Get(context.Background(), "media1/thumbnail")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("thumb"), data)
+
+	require.NoError(t, store.Put(context.Background(), "media1", "image/png", []byte("replaced")))
+	data, _ = store.Get(context.Background(), "media1")
+	assert.Equal(t, []byte("replaced"), data)
+
+	require.NoError(t, store.Delete(context.Background(), "media1"))
+	_, err = store.Get(context.Background(), "media1")
+	assert.ErrorIs(t, err, domain.ErrMediaNotFound)
+	assert.NoError(t, store.Delete(context.Background(), "media1"))
+
+	_, err = store.Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrMediaNotFound)
+}
+
+func TestStore_InvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../escape", "/etc/passwd", "a/../../escape", `a\b`} {
+		assert.Error(t, store.Put(context.Background(), key, "image/png", []byte("x")), key)
+		_, err := store.Get(context.Background(), key)
+		assert.Error(t, err, key)
+		assert.Error(t, store.Delete(context.Background(), key), key)
+	}
+	_, err = os.Stat(filepath.Join(dir, "escape"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,95 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is the smallest path-style S3 the store needs.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		http.Error(w, "unsigned request", http.StatusForbidden)
+		return
+	}
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !f.buckets[bucket] {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			f.buckets[bucket] = true
+		}
+		return
+	}
+	if !f.buckets[bucket] {
+		http.Error(w, "no such bucket", http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestStore(t *testing.T) {
+	fake := &fakeS3{buckets: map[string]bool{}, objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := NewStore(Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "media",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	require.NoError(t, store.EnsureBucket(context.Background()))
+	require.NoError(t, store.EnsureBucket(context.Background()))
+	assert.True(t, fake.buckets["media"])
+
+	require.NoError(t, store.Put(context.Background(), "media1/thumbnail", "image/png", []byte("thumb")))
+	assert.Equal(t, []byte("thumb"), fake.objects["/media/media1/thumbnail"])
+	assert.Equal(t, "image/png", fake.types["/media/media1/thumbnail"])
+
+	data, err := store.Get(context.Background(), "media1/thumbnail")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("thumb"), data)
+
+	require.NoError(t, store.Delete(context.Background(), "media1/thumbnail"))
+	_, err = store.Get(context.Background(), "media1/thumbnail")
+	assert.ErrorIs(t, err, domain.ErrMediaNotFound)
+}
@@ -0,0 +1,11 @@
+package infrastructure
+
+import "context"
+
+//go:generate mockgen -destination=./mocks/mock_blob_store.go -package=mocks github.com/pedro00627/urblog/infrastructure BlobStore
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Get returns domain.ErrMediaNotFound when there is no blob under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
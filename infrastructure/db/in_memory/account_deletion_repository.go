@@ -0,0 +1,66 @@
+package in_memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// InMemoryAccountDeletionRepository keeps copies of the deletions it is
+// given, so that a purge only records progress through Save.
+type InMemoryAccountDeletionRepository struct {
+	mu        sync.RWMutex
+	deletions map[string]domain.AccountDeletion
+}
+
+func NewInMemoryAccountDeletionRepository() *InMemoryAccountDeletionRepository {
+	return &InMemoryAccountDeletionRepository{
+		deletions: make(map[string]domain.AccountDeletion),
+	}
+}
+
+func (r *InMemoryAccountDeletionRepository) FindByUserID(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	deletion, exists := r.deletions[userID]
+	if !exists {
+		return nil, domain.ErrAccountDeletionNotFound
+	}
+	deletion.Done = append([]domain.PurgeStep{}, deletion.Done...)
+	return &deletion, nil
+}
+
+func (r *InMemoryAccountDeletionRepository) Save(ctx context.Context, deletion *domain.AccountDeletion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *deletion
+	stored.Done = append([]domain.PurgeStep{}, deletion.Done...)
+	r.deletions[deletion.UserID] = stored
+	return nil
+}
+
+func (r *InMemoryAccountDeletionRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*domain.AccountDeletion
+	for _, deletion := range r.deletions {
+		if deletion.Due(now) {
+			deletion.Done = append([]domain.PurgeStep{}, deletion.Done...)
+			due = append(due, &deletion)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].PurgeAt.Before(due[j].PurgeAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	for _, deletion := range due {
+		deletion.Claim(owner, leaseUntil, now)
+		r.deletions[deletion.UserID] = *deletion
+	}
+	return due, nil
+}
@@ -0,0 +1,43 @@
+package in_memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryAccountDeletionRepository_ClaimDue(t *testing.T) {
+	repo := NewInMemoryAccountDeletionRepository()
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, repo.Save(context.Background(), domain.NewAccountDeletion("user1", now, time.Hour)))
+	assert.NoError(t, repo.Save(context.Background(), domain.NewAccountDeletion("user2", now, 2*time.Hour)))
+
+	claimed, err := repo.ClaimDue(context.Background(), "purger1", now.Add(time.Hour), now.Add(time.Hour+time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, "user1", claimed[0].UserID)
+
+	// Progress only counts once saved.
+	claimed[0].StepDone(domain.PurgeEvent, now)
+	stored, err := repo.FindByUserID(context.Background(), "user1")
+	assert.NoError(t, err)
+	assert.Empty(t, stored.Done)
+	assert.NoError(t, repo.Save(context.Background(), claimed[0]))
+
+	claimed, err = repo.ClaimDue(context.Background(), "purger2", now.Add(time.Hour), now.Add(time.Hour+time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed, "claimed by purger1 until its lease expires")
+
+	claimed, err = repo.ClaimDue(context.Background(), "purger2", now.Add(2*time.Hour), now.Add(3*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+	assert.Equal(t, "user1", claimed[0].UserID)
+	assert.Equal(t, []domain.PurgeStep{domain.PurgeEvent}, claimed[0].Done, "resumed where it stopped")
+	assert.Equal(t, "purger2", claimed[0].LeaseOwner)
+
+	_, err = repo.FindByUserID(context.Background(), "ghost")
+	assert.Equal(t, domain.ErrAccountDeletionNotFound, err)
+}
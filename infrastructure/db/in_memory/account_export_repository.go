@@ -0,0 +1,57 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// InMemoryAccountExportRepository keeps copies of the exports it is given,
+// since a running export changes while others read it.
+type InMemoryAccountExportRepository struct {
+	mu      sync.RWMutex
+	exports map[string]domain.AccountExport
+}
+
+func NewInMemoryAccountExportRepository() *InMemoryAccountExportRepository {
+	return &InMemoryAccountExportRepository{
+		exports: make(map[string]domain.AccountExport),
+	}
+}
+
+func (r *InMemoryAccountExportRepository) FindByID(ctx context.Context, id string) (*domain.AccountExport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	export, exists := r.exports[id]
+	if !exists {
+		return nil, domain.ErrAccountExportNotFound
+	}
+	return &export, nil
+}
+
+func (r *InMemoryAccountExportRepository) Save(ctx context.Context, export *domain.AccountExport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exports[export.ID] = *export
+	return nil
+}
+
+func (r *InMemoryAccountExportRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.AccountExport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	exports := []*domain.AccountExport{}
+	for _, export := range r.exports {
+		if export.UserID == userID {
+			exports = append(exports, &export)
+		}
+	}
+	return exports, nil
+}
+
+func (r *InMemoryAccountExportRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.exports, id)
+	return nil
+}
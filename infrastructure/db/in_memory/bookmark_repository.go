@@ -0,0 +1,80 @@
+package in_memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryBookmarkRepository struct {
+	mu sync.RWMutex
+	// bookmarks maps a user ID to their bookmarks by tweet ID.
+	bookmarks map[string]map[string]*domain.Bookmark
+}
+
+func NewInMemoryBookmarkRepository() *InMemoryBookmarkRepository {
+	return &InMemoryBookmarkRepository{
+		bookmarks: make(map[string]map[string]*domain.Bookmark),
+	}
+}
+
+func (r *InMemoryBookmarkRepository) Save(ctx context.Context, bookmark *domain.Bookmark) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.bookmarks[bookmark.UserID] == nil {
+		r.bookmarks[bookmark.UserID] = make(map[string]*domain.Bookmark)
+	}
+	r.bookmarks[bookmark.UserID][bookmark.TweetID] = bookmark
+	return nil
+}
+
+func (r *InMemoryBookmarkRepository) Delete(ctx context.Context, userID, tweetID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.bookmarks[userID][tweetID]; !exists {
+		return domain.ErrBookmarkNotFound
+	}
+	delete(r.bookmarks[userID], tweetID)
+	return nil
+}
+
+func (r *InMemoryBookmarkRepository) Find(ctx context.Context, userID, tweetID string) (*domain.Bookmark, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bookmark, exists := r.bookmarks[userID][tweetID]
+	if !exists {
+		return nil, domain.ErrBookmarkNotFound
+	}
+	return bookmark, nil
+}
+
+func (r *InMemoryBookmarkRepository) FindByUserID(ctx context.Context, userID string, after *domain.BookmarkCursor, limit int) ([]*domain.Bookmark, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var bookmarks []*domain.Bookmark
+	for _, bookmark := range r.bookmarks[userID] {
+		if after == nil || !after.Covers(bookmark) {
+			bookmarks = append(bookmarks, bookmark)
+		}
+	}
+	sort.Slice(bookmarks, func(i, j int) bool {
+		a, b := bookmarks[i], bookmarks[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.TweetID > b.TweetID
+	})
+	if len(bookmarks) > limit {
+		bookmarks = bookmarks[:limit]
+	}
+	return bookmarks, nil
+}
+
+func (r *InMemoryBookmarkRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.bookmarks, userID)
+	return nil
+}
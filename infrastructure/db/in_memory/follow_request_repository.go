@@ -0,0 +1,79 @@
+package in_memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryFollowRequestRepository struct {
+	mu       sync.RWMutex
+	requests map[string]*domain.FollowRequest
+}
+
+func NewInMemoryFollowRequestRepository() *InMemoryFollowRequestRepository {
+	return &InMemoryFollowRequestRepository{
+		requests: make(map[string]*domain.FollowRequest),
+	}
+}
+
+func (r *InMemoryFollowRequestRepository) Save(ctx context.Context, request *domain.FollowRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[request.ID] = request
+	return nil
+}
+
+func (r *InMemoryFollowRequestRepository) FindByID(ctx context.Context, id string) (*domain.FollowRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	request, exists := r.requests[id]
+	if !exists {
+		return nil, domain.ErrFollowRequestNotFound
+	}
+	return request, nil
+}
+
+func (r *InMemoryFollowRequestRepository) FindPending(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, request := range r.requests {
+		if request.FollowerID == followerID && request.FolloweeID == followeeID && request.Status == domain.FollowRequestRequested {
+			return request, nil
+		}
+	}
+	return nil, domain.ErrFollowRequestNotFound
+}
+
+func (r *InMemoryFollowRequestRepository) FindPendingByFolloweeID(ctx context.Context, followeeID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var all []*domain.FollowRequest
+	for _, request := range r.requests {
+		if request.FolloweeID == followeeID && request.Status == domain.FollowRequestRequested {
+			all = append(all, request)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].CreatedAt.Before(all[j].CreatedAt)
+	})
+
+	var result []*domain.FollowRequest
+	for i := offset; i < len(all) && i < offset+limit; i++ {
+		result = append(result, all[i])
+	}
+	return result, nil
+}
+
+func (r *InMemoryFollowRequestRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, request := range r.requests {
+		if request.FollowerID == userID || request.FolloweeID == userID {
+			delete(r.requests, id)
+		}
+	}
+	return nil
+}
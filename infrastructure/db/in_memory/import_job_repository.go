@@ -0,0 +1,60 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// InMemoryImportJobRepository keeps copies of the jobs it is given, since a
+// running job keeps changing while others read it.
+type InMemoryImportJobRepository struct {
+	mu   sync.RWMutex
+	jobs map[string]domain.ImportJob
+	rows map[string][]domain.ImportRow
+}
+
+func NewInMemoryImportJobRepository() *InMemoryImportJobRepository {
+	return &InMemoryImportJobRepository{
+		jobs: make(map[string]domain.ImportJob),
+		rows: make(map[string][]domain.ImportRow),
+	}
+}
+
+func (r *InMemoryImportJobRepository) FindByID(ctx context.Context, id string) (*domain.ImportJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	job, exists := r.jobs[id]
+	if !exists {
+		return nil, domain.ErrImportJobNotFound
+	}
+	return &job, nil
+}
+
+func (r *InMemoryImportJobRepository) Save(ctx context.Context, job *domain.ImportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.ID] = *job
+	return nil
+}
+
+func (r *InMemoryImportJobRepository) SaveRows(ctx context.Context, rows []domain.ImportRow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, row := range rows {
+		r.rows[row.JobID] = append(r.rows[row.JobID], row)
+	}
+	return nil
+}
+
+func (r *InMemoryImportJobRepository) FindRows(ctx context.Context, jobID string, limit, offset int) ([]domain.ImportRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rows := r.rows[jobID]
+	if offset >= len(rows) {
+		return []domain.ImportRow{}, nil
+	}
+	end := min(offset+limit, len(rows))
+	return append([]domain.ImportRow(nil), rows[offset:end]...), nil
+}
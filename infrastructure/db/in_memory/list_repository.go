@@ -0,0 +1,71 @@
+package in_memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryListRepository struct {
+	mu    sync.RWMutex
+	lists map[string]*domain.List
+}
+
+func NewInMemoryListRepository() *InMemoryListRepository {
+	return &InMemoryListRepository{
+		lists: make(map[string]*domain.List),
+	}
+}
+
+func (r *InMemoryListRepository) Save(ctx context.Context, list *domain.List) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lists[list.ID] = list
+	return nil
+}
+
+func (r *InMemoryListRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.lists[id]; !exists {
+		return domain.ErrListNotFound
+	}
+	delete(r.lists, id)
+	return nil
+}
+
+func (r *InMemoryListRepository) FindByID(ctx context.Context, id string) (*domain.List, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list, exists := r.lists[id]
+	if !exists {
+		return nil, domain.ErrListNotFound
+	}
+	return list, nil
+}
+
+func (r *InMemoryListRepository) FindByOwnerID(ctx context.Context, ownerID string) ([]*domain.List, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var lists []*domain.List
+	for _, list := range r.lists {
+		if list.OwnerID == ownerID {
+			lists = append(lists, list)
+		}
+	}
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].CreatedAt.Before(lists[j].CreatedAt)
+	})
+	return lists, nil
+}
+
+func (r *InMemoryListRepository) RemoveMember(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, list := range r.lists {
+		delete(list.Members, userID)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryMediaRepository struct {
+	mu    sync.RWMutex
+	media map[string]*domain.Media
+}
+
+func NewInMemoryMediaRepository() *InMemoryMediaRepository {
+	return &InMemoryMediaRepository{
+		media: make(map[string]*domain.Media),
+	}
+}
+
+func (r *InMemoryMediaRepository) Save(ctx context.Context, media *domain.Media) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.media[media.ID] = media
+	return nil
+}
+
+func (r *InMemoryMediaRepository) FindByID(ctx context.Context, id string) (*domain.Media, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	media, exists := r.media[id]
+	if !exists {
+		return nil, domain.ErrMediaNotFound
+	}
+	return media, nil
+}
+
+func (r *InMemoryMediaRepository) FindByUserID(ctx context.Context, userID string, limit int) ([]*domain.Media, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*domain.Media{}
+	for _, media := range r.media {
+		if len(result) == limit {
+			break
+		}
+		if media.UserID == userID {
+			result = append(result, media)
+		}
+	}
+	return result, nil
+}
+
+func (r *InMemoryMediaRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.media, id)
+	return nil
+}
@@ -0,0 +1,111 @@
+package in_memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryNotificationRepository struct {
+	mu            sync.RWMutex
+	notifications map[string]*domain.Notification
+}
+
+func NewInMemoryNotificationRepository() *InMemoryNotificationRepository {
+	return &InMemoryNotificationRepository{
+		notifications: make(map[string]*domain.Notification),
+	}
+}
+
+func (r *InMemoryNotificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifications[notification.ID] = notification
+	return nil
+}
+
+func (r *InMemoryNotificationRepository) FindUnread(ctx context.Context, userID string, notificationType domain.NotificationType, tweetID string) (*domain.Notification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, n := range r.notifications {
+		if n.UserID == userID && n.Type == notificationType && n.TweetID == tweetID && !n.Read {
+			return n, nil
+		}
+	}
+	return nil, domain.ErrNotificationNotFound
+}
+
+func (r *InMemoryNotificationRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var all []*domain.Notification
+	for _, n := range r.notifications {
+		if n.UserID == userID {
+			all = append(all, n)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].UpdatedAt.After(all[j].UpdatedAt)
+	})
+
+	var result []*domain.Notification
+	for i := offset; i < len(all) && i < offset+limit; i++ {
+		result = append(result, all[i])
+	}
+	return result, nil
+}
+
+func (r *InMemoryNotificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	count := 0
+	for _, n := range r.notifications {
+		if n.UserID == userID && !n.Read {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// MarkRead marks the given notifications of userID as read, or all of them
+// when ids is empty.
+func (r *InMemoryNotificationRepository) MarkRead(ctx context.Context, userID string, ids []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(ids) == 0 {
+		for _, n := range r.notifications {
+			if n.UserID == userID {
+				n.Read = true
+			}
+		}
+		return nil
+	}
+	for _, id := range ids {
+		if n, exists := r.notifications[id]; exists && n.UserID == userID {
+			n.Read = true
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryNotificationRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, n := range r.notifications {
+		if n.UserID == userID {
+			delete(r.notifications, id)
+			continue
+		}
+		if i := slices.Index(n.ActorIDs, userID); i >= 0 {
+			n.ActorIDs = slices.Delete(n.ActorIDs, i, i+1)
+			n.ActorCount--
+			if n.ActorCount <= 0 {
+				delete(r.notifications, id)
+			}
+		}
+	}
+	return nil
+}
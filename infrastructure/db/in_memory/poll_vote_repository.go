@@ -0,0 +1,69 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type InMemoryPollVoteRepository struct {
+	mu sync.RWMutex
+	// votes maps a tweet ID to the votes in its poll by user ID.
+	votes map[string]map[string]*domain.PollVote
+}
+
+func NewInMemoryPollVoteRepository() *InMemoryPollVoteRepository {
+	return &InMemoryPollVoteRepository{
+		votes: make(map[string]map[string]*domain.PollVote),
+	}
+}
+
+func (r *InMemoryPollVoteRepository) Save(ctx context.Context, vote *domain.PollVote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.votes[vote.TweetID] == nil {
+		r.votes[vote.TweetID] = make(map[string]*domain.PollVote)
+	}
+	if _, exists := r.votes[vote.TweetID][vote.UserID]; exists {
+		return domain.ErrAlreadyVoted
+	}
+	r.votes[vote.TweetID][vote.UserID] = vote
+	return nil
+}
+
+func (r *InMemoryPollVoteRepository) FindByUserID(ctx context.Context, userID string, tweetIDs []string) (map[string]*domain.PollVote, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	votes := make(map[string]*domain.PollVote)
+	for _, tweetID := range tweetIDs {
+		if vote, exists := r.votes[tweetID][userID]; exists {
+			votes[tweetID] = vote
+		}
+	}
+	return votes, nil
+}
+
+func (r *InMemoryPollVoteRepository) Tally(ctx context.Context, tweetIDs []string) (map[string]map[int]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tally := make(map[string]map[int]int)
+	for _, tweetID := range tweetIDs {
+		for _, vote := range r.votes[tweetID] {
+			if tally[tweetID] == nil {
+				tally[tweetID] = make(map[int]int)
+			}
+			tally[tweetID][vote.Option]++
+		}
+	}
+	return tally, nil
+}
+
+func (r *InMemoryPollVoteRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, votes := range r.votes {
+		delete(votes, userID)
+	}
+	return nil
+}
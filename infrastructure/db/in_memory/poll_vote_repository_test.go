@@ -0,0 +1,65 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryPollVoteRepository(t *testing.T) {
+	repo := NewInMemoryPollVoteRepository()
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet1", UserID: "user1", Option: 0, CreatedAt: now}))
+	assert.NoError(t, repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet1", UserID: "user2", Option: 1, CreatedAt: now}))
+	assert.NoError(t, repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet1", UserID: "user3", Option: 1, CreatedAt: now}))
+	assert.NoError(t, repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet2", UserID: "user1", Option: 2, CreatedAt: now}))
+	assert.Equal(t, domain.ErrAlreadyVoted, repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet1", UserID: "user1", Option: 1, CreatedAt: now}))
+
+	votes, err := repo.FindByUserID(context.Background(), "user1", []string{"tweet1", "tweet2", "tweet3"})
+	assert.NoError(t, err)
+	assert.Len(t, votes, 2)
+	assert.Equal(t, 0, votes["tweet1"].Option)
+	assert.Equal(t, 2, votes["tweet2"].Option)
+
+	tally, err := repo.Tally(context.Background(), []string{"tweet1", "tweet3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[int]int{"tweet1": {0: 1, 1: 2}}, tally)
+}
+
+func TestInMemoryPollVoteRepository_ConcurrentVotes(t *testing.T) {
+	repo := NewInMemoryPollVoteRepository()
+
+	const voters = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, voters)
+	for i := 0; i < voters; i++ {
+		wg.Add(1)
+		go func(option int) {
+			defer wg.Done()
+			errs <- repo.Save(context.Background(), &domain.PollVote{TweetID: "tweet1", UserID: "user1", Option: option % 2})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	saved := 0
+	for err := range errs {
+		if err == nil {
+			saved++
+		} else {
+			assert.Equal(t, domain.ErrAlreadyVoted, err)
+		}
+	}
+	assert.Equal(t, 1, saved)
+	tally, _ := repo.Tally(context.Background(), []string{"tweet1"})
+	total := 0
+	for _, count := range tally["tweet1"] {
+		total += count
+	}
+	assert.Equal(t, 1, total)
+}
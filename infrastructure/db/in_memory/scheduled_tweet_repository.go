@@ -0,0 +1,111 @@
+package in_memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// InMemoryScheduledTweetRepository stores copies of the scheduled tweets, so
+// that changes only take effect through Update and versions can be checked.
+type InMemoryScheduledTweetRepository struct {
+	mu     sync.RWMutex
+	tweets map[string]domain.ScheduledTweet
+}
+
+func NewInMemoryScheduledTweetRepository() *InMemoryScheduledTweetRepository {
+	return &InMemoryScheduledTweetRepository{
+		tweets: make(map[string]domain.ScheduledTweet),
+	}
+}
+
+func (r *InMemoryScheduledTweetRepository) Save(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tweets[tweet.ID] = *tweet
+	return nil
+}
+
+func (r *InMemoryScheduledTweetRepository) Update(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, exists := r.tweets[tweet.ID]
+	if !exists {
+		return domain.ErrScheduledTweetNotFound
+	}
+	if stored.Version != tweet.Version {
+		return domain.ErrScheduledTweetConflict
+	}
+	tweet.Version++
+	r.tweets[tweet.ID] = *tweet
+	return nil
+}
+
+func (r *InMemoryScheduledTweetRepository) FindByID(ctx context.Context, id string) (*domain.ScheduledTweet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tweet, exists := r.tweets[id]
+	if !exists {
+		return nil, domain.ErrScheduledTweetNotFound
+	}
+	return &tweet, nil
+}
+
+func (r *InMemoryScheduledTweetRepository) FindPendingByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var all []*domain.ScheduledTweet
+	for _, tweet := range r.tweets {
+		if tweet.UserID == userID && tweet.Status == domain.ScheduledTweetPending {
+			all = append(all, &tweet)
+		}
+	}
+	sortByPublishAt(all)
+
+	var result []*domain.ScheduledTweet
+	for i := offset; i < len(all) && i < offset+limit; i++ {
+		result = append(result, all[i])
+	}
+	return result, nil
+}
+
+func (r *InMemoryScheduledTweetRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.ScheduledTweet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*domain.ScheduledTweet
+	for _, tweet := range r.tweets {
+		if tweet.Due(now) {
+			due = append(due, &tweet)
+		}
+	}
+	sortByPublishAt(due)
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	for _, tweet := range due {
+		tweet.Claim(owner, leaseUntil, now)
+		tweet.Version++
+		r.tweets[tweet.ID] = *tweet
+	}
+	return due, nil
+}
+
+func sortByPublishAt(tweets []*domain.ScheduledTweet) {
+	sort.Slice(tweets, func(i, j int) bool {
+		return tweets[i].PublishAt.Before(tweets[j].PublishAt)
+	})
+}
+
+func (r *InMemoryScheduledTweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, tweet := range r.tweets {
+		if tweet.UserID == userID {
+			delete(r.tweets, id)
+		}
+	}
+	return nil
+}
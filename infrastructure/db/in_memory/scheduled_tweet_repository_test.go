@@ -0,0 +1,74 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryScheduledTweetRepository_ClaimDue(t *testing.T) {
+	repo := NewInMemoryScheduledTweetRepository()
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		tweet, _ := domain.NewScheduledTweet(id, "user1", "hello "+id, now.Add(time.Minute), now)
+		assert.NoError(t, repo.Save(context.Background(), tweet))
+	}
+	notDue, _ := domain.NewScheduledTweet("later", "user1", "later", now.Add(time.Hour), now)
+	assert.NoError(t, repo.Save(context.Background(), notDue))
+
+	due := now.Add(2 * time.Minute)
+	var mu sync.Mutex
+	claims := make(map[string]int)
+	var wg sync.WaitGroup
+	for _, owner := range []string{"scheduler1", "scheduler2", "scheduler3"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				claimed, err := repo.ClaimDue(context.Background(), owner, due, due.Add(time.Minute), 1)
+				assert.NoError(t, err)
+				if len(claimed) == 0 {
+					return
+				}
+				mu.Lock()
+				claims[claimed[0].ID]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1, "d": 1}, claims)
+
+	// Once the lease expires, the tweets are due again.
+	reclaimed, err := repo.ClaimDue(context.Background(), "scheduler1", due.Add(2*time.Minute), due.Add(3*time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, reclaimed, 4)
+}
+
+func TestInMemoryScheduledTweetRepository_Update(t *testing.T) {
+	repo := NewInMemoryScheduledTweetRepository()
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet, _ := domain.NewScheduledTweet("a", "user1", "hello", now.Add(time.Minute), now)
+	assert.NoError(t, repo.Save(context.Background(), tweet))
+
+	stale, _ := repo.FindByID(context.Background(), "a")
+	_, err := repo.ClaimDue(context.Background(), "scheduler1", now.Add(time.Minute), now.Add(2*time.Minute), 10)
+	assert.NoError(t, err)
+
+	assert.NoError(t, stale.Cancel(now))
+	assert.Equal(t, domain.ErrScheduledTweetConflict, repo.Update(context.Background(), stale))
+
+	stored, _ := repo.FindByID(context.Background(), "a")
+	assert.Equal(t, domain.ScheduledTweetPublishing, stored.Status)
+	stored.MarkPublished("a", now)
+	assert.NoError(t, repo.Update(context.Background(), stored))
+
+	pending, err := repo.FindPendingByUserID(context.Background(), "user1", 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
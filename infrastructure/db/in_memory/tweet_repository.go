@@ -1,6 +1,9 @@
 package in_memory
 
-import "github.com/pedro00627/urblog/domain"
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+)
 
 type InMemoryTweetRepository struct {
 	tweets         map[string]*domain.Tweet
@@ -14,24 +17,37 @@ func NewInMemoryTweetRepository() *InMemoryTweetRepository {
 	}
 }
 
-func (r *InMemoryTweetRepository) Save(tweet *domain.Tweet) error {
+func (r *InMemoryTweetRepository) Save(ctx context.Context, tweet *domain.Tweet) error {
+	if _, exists := r.tweets[tweet.ID]; !exists {
+		r.tweetsByUserID[tweet.UserID] = append(r.tweetsByUserID[tweet.UserID], tweet.ID)
+	}
 	r.tweets[tweet.ID] = tweet
-	r.tweetsByUserID[tweet.UserID] = append(r.tweetsByUserID[tweet.UserID], tweet.ID)
 	return nil
 }
 
-func (r *InMemoryTweetRepository) FindByUserID(userID string, limit, offset int) ([]*domain.Tweet, error) {
-	var result []*domain.Tweet
-	count := 0
-
-	for _, tweet := range r.tweets {
-		if tweet.UserID == userID {
-			if count >= offset && count < offset+limit {
-				result = append(result, tweet)
-			}
-			count++
-		}
+func (r *InMemoryTweetRepository) FindByID(ctx context.Context, id string) (*domain.Tweet, error) {
+	tweet, exists := r.tweets[id]
+	if !exists {
+		return nil, domain.ErrTweetNotFound
 	}
+	return tweet, nil
+}
 
+// FindByUserID pages through userID's tweets in the order they were saved,
+// so that consecutive pages neither repeat nor skip tweets.
+func (r *InMemoryTweetRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	var result []*domain.Tweet
+	ids := r.tweetsByUserID[userID]
+	for _, id := range ids[min(offset, len(ids)):min(offset+limit, len(ids))] {
+		result = append(result, r.tweets[id])
+	}
 	return result, nil
 }
+
+func (r *InMemoryTweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	for _, id := range r.tweetsByUserID[userID] {
+		delete(r.tweets, id)
+	}
+	delete(r.tweetsByUserID, userID)
+	return nil
+}
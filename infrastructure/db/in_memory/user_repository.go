@@ -1,6 +1,11 @@
 package in_memory
 
-import "github.com/pedro00627/urblog/domain"
+import (
+	"context"
+	"slices"
+
+	"github.com/pedro00627/urblog/domain"
+)
 
 type InMemoryUserRepository struct {
 	usersByID   map[string]*domain.User
@@ -14,24 +19,70 @@ func NewInMemoryUserRepository() *InMemoryUserRepository {
 	}
 }
 
-func (r *InMemoryUserRepository) Save(user *domain.User) error {
+func (r *InMemoryUserRepository) Save(ctx context.Context, user *domain.User) error {
 	r.usersByID[user.ID] = user
 	r.usersByName[user.Username] = user.ID
 	return nil
 }
 
-func (r *InMemoryUserRepository) FindByID(userID string) (*domain.User, error) {
+func (r *InMemoryUserRepository) SaveAll(ctx context.Context, users []*domain.User) error {
+	for _, user := range users {
+		r.Save(ctx, user)
+	}
+	return nil
+}
+
+func (r *InMemoryUserRepository) FindByID(ctx context.Context, userID string) (*domain.User, error) {
 	user, exists := r.usersByID[userID]
-	if !exists {
+	if !exists || user.Deactivated {
 		return nil, domain.ErrUserNotFound
 	}
 	return user, nil
 }
 
-func (r *InMemoryUserRepository) FindByName(username string) (*domain.User, error) {
+func (r *InMemoryUserRepository) FindByName(ctx context.Context, username string) (*domain.User, error) {
 	userID, exists := r.usersByName[username]
 	if !exists {
 		return nil, domain.ErrUserNotFound
 	}
-	return r.FindByID(userID)
+	return r.FindByID(ctx, userID)
+}
+
+func (r *InMemoryUserRepository) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	ids := make([]string, 0, len(r.usersByID))
+	for id, user := range r.usersByID {
+		if !user.Deactivated {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if offset >= len(ids) {
+		return []*domain.User{}, nil
+	}
+	users := make([]*domain.User, 0, min(limit, len(ids)-offset))
+	for _, id := range ids[offset:min(offset+limit, len(ids))] {
+		users = append(users, r.usersByID[id])
+	}
+	return users, nil
+}
+
+func (r *InMemoryUserRepository) Delete(ctx context.Context, id string) error {
+	user, exists := r.usersByID[id]
+	if !exists {
+		return nil
+	}
+	if r.usersByName[user.Username] == id {
+		delete(r.usersByName, user.Username)
+	}
+	delete(r.usersByID, id)
+	return nil
+}
+
+func (r *InMemoryUserRepository) RemoveRelationships(ctx context.Context, userID string) error {
+	for _, user := range r.usersByID {
+		delete(user.Following, userID)
+		delete(user.Blocked, userID)
+		delete(user.Muted, userID)
+	}
+	return nil
 }
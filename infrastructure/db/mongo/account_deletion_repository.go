@@ -0,0 +1,83 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AccountDeletionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAccountDeletionRepository(db *mongo.Database) *AccountDeletionRepository {
+	return &AccountDeletionRepository{
+		collection: db.Collection("account_deletions"),
+	}
+}
+
+// EnsureIndexes creates the unique index deletions are looked up by, and the
+// index used to find due deletions.
+func (r *AccountDeletionRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "userid", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "purgeat", Value: 1}}},
+	})
+	return err
+}
+
+func (r *AccountDeletionRepository) FindByUserID(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	var deletion domain.AccountDeletion
+	err := r.collection.FindOne(ctx, bson.M{"userid": userID}).Decode(&deletion)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrAccountDeletionNotFound
+	}
+	return &deletion, err
+}
+
+func (r *AccountDeletionRepository) Save(ctx context.Context, deletion *domain.AccountDeletion) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"userid": deletion.UserID},
+		bson.M{"$set": deletion},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// ClaimDue claims the deletions one at a time with findOneAndUpdate, which is
+// atomic per document, so concurrent purgers never claim the same deletion.
+func (r *AccountDeletionRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": domain.AccountDeletionPending, "purgeat": bson.M{"$lte": now}},
+		bson.M{"status": domain.AccountDeletionPurging, "leaseuntil": bson.M{"$lt": now}},
+	}}
+	update := bson.M{"$set": bson.M{
+		"status":     domain.AccountDeletionPurging,
+		"leaseowner": owner,
+		"leaseuntil": leaseUntil,
+		"updatedat":  now,
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "purgeat", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var claimed []*domain.AccountDeletion
+	for len(claimed) < limit {
+		var deletion domain.AccountDeletion
+		err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&deletion)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return claimed, err
+		}
+		claimed = append(claimed, &deletion)
+	}
+	return claimed, nil
+}
@@ -0,0 +1,67 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AccountExportRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAccountExportRepository(db *mongo.Database) *AccountExportRepository {
+	return &AccountExportRepository{
+		collection: db.Collection("account_exports"),
+	}
+}
+
+// EnsureIndexes creates the unique index exports are looked up by, and the
+// index on their user.
+func (r *AccountExportRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "userid", Value: 1}}},
+	})
+	return err
+}
+
+func (r *AccountExportRepository) FindByID(ctx context.Context, id string) (*domain.AccountExport, error) {
+	var export domain.AccountExport
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&export)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrAccountExportNotFound
+	}
+	return &export, err
+}
+
+func (r *AccountExportRepository) Save(ctx context.Context, export *domain.AccountExport) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"id": export.ID},
+		bson.M{"$set": export},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *AccountExportRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.AccountExport, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"userid": userID})
+	if err != nil {
+		return nil, err
+	}
+	exports := []*domain.AccountExport{}
+	if err := cursor.All(ctx, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+func (r *AccountExportRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
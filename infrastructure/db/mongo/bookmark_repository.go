@@ -0,0 +1,100 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type BookmarkRepository struct {
+	collection *mongo.Collection
+}
+
+func NewBookmarkRepository(db *mongo.Database) *BookmarkRepository {
+	return &BookmarkRepository{
+		collection: db.Collection("bookmarks"),
+	}
+}
+
+// EnsureIndexes creates the index that keeps one bookmark per user and tweet
+// and serves the bookmark pages.
+func (r *BookmarkRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userid", Value: 1}, {Key: "tweetid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "userid", Value: 1}, {Key: "createdat", Value: -1}, {Key: "tweetid", Value: -1}},
+		},
+	})
+	return err
+}
+
+func (r *BookmarkRepository) Save(ctx context.Context, bookmark *domain.Bookmark) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"userid": bookmark.UserID, "tweetid": bookmark.TweetID},
+		bson.M{"$set": bookmark},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *BookmarkRepository) Delete(ctx context.Context, userID, tweetID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"userid": userID, "tweetid": tweetID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrBookmarkNotFound
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) Find(ctx context.Context, userID, tweetID string) (*domain.Bookmark, error) {
+	var bookmark domain.Bookmark
+	err := r.collection.FindOne(ctx, bson.M{"userid": userID, "tweetid": tweetID}).Decode(&bookmark)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrBookmarkNotFound
+	}
+	return &bookmark, err
+}
+
+func (r *BookmarkRepository) FindByUserID(ctx context.Context, userID string, after *domain.BookmarkCursor, limit int) ([]*domain.Bookmark, error) {
+	filter := bson.M{"userid": userID}
+	if after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"createdat": bson.M{"$lt": after.CreatedAt}},
+			bson.M{"createdat": after.CreatedAt, "tweetid": bson.M{"$lt": after.TweetID}},
+		}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdat", Value: -1}, {Key: "tweetid", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var bookmarks []*domain.Bookmark
+	for cursor.Next(ctx) {
+		var bookmark domain.Bookmark
+		if err := cursor.Decode(&bookmark); err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, &bookmark)
+	}
+	return bookmarks, cursor.Err()
+}
+
+func (r *BookmarkRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"userid": userID})
+	return err
+}
@@ -0,0 +1,78 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type FollowRequestRepository struct {
+	collection *mongo.Collection
+}
+
+func NewFollowRequestRepository(db *mongo.Database) *FollowRequestRepository {
+	return &FollowRequestRepository{
+		collection: db.Collection("follow_requests"),
+	}
+}
+
+func (r *FollowRequestRepository) Save(ctx context.Context, request *domain.FollowRequest) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"id": request.ID},
+		bson.M{"$set": request},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *FollowRequestRepository) FindByID(ctx context.Context, id string) (*domain.FollowRequest, error) {
+	return r.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *FollowRequestRepository) FindPending(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	return r.findOne(ctx, bson.M{"followerid": followerID, "followeeid": followeeID, "status": domain.FollowRequestRequested})
+}
+
+func (r *FollowRequestRepository) FindPendingByFolloweeID(ctx context.Context, followeeID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	filter := bson.M{"followeeid": followeeID, "status": domain.FollowRequestRequested}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdat", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var requests []*domain.FollowRequest
+	for cursor.Next(ctx) {
+		var request domain.FollowRequest
+		if err := cursor.Decode(&request); err != nil {
+			return nil, err
+		}
+		requests = append(requests, &request)
+	}
+	return requests, cursor.Err()
+}
+
+func (r *FollowRequestRepository) findOne(ctx context.Context, filter bson.M) (*domain.FollowRequest, error) {
+	var request domain.FollowRequest
+	err := r.collection.FindOne(ctx, filter).Decode(&request)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrFollowRequestNotFound
+	}
+	return &request, err
+}
+
+func (r *FollowRequestRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": bson.A{bson.M{"followerid": userID}, bson.M{"followeeid": userID}}}
+	_, err := r.collection.DeleteMany(ctx, filter)
+	return err
+}
@@ -0,0 +1,85 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ImportJobRepository struct {
+	jobs *mongo.Collection
+	rows *mongo.Collection
+}
+
+func NewImportJobRepository(db *mongo.Database) *ImportJobRepository {
+	return &ImportJobRepository{
+		jobs: db.Collection("import_jobs"),
+		rows: db.Collection("import_rows"),
+	}
+}
+
+// EnsureIndexes creates the unique index jobs are looked up by and the index
+// their reports are read in line order with.
+func (r *ImportJobRepository) EnsureIndexes(ctx context.Context) error {
+	if _, err := r.jobs.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		return err
+	}
+	_, err := r.rows.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "jobid", Value: 1}, {Key: "line", Value: 1}},
+	})
+	return err
+}
+
+func (r *ImportJobRepository) FindByID(ctx context.Context, id string) (*domain.ImportJob, error) {
+	var job domain.ImportJob
+	err := r.jobs.FindOne(ctx, bson.M{"id": id}).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrImportJobNotFound
+	}
+	return &job, err
+}
+
+func (r *ImportJobRepository) Save(ctx context.Context, job *domain.ImportJob) error {
+	_, err := r.jobs.UpdateOne(
+		ctx,
+		bson.M{"id": job.ID},
+		bson.M{"$set": job},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *ImportJobRepository) SaveRows(ctx context.Context, rows []domain.ImportRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(rows))
+	for i := range rows {
+		docs[i] = rows[i]
+	}
+	_, err := r.rows.InsertMany(ctx, docs)
+	return err
+}
+
+func (r *ImportJobRepository) FindRows(ctx context.Context, jobID string, limit, offset int) ([]domain.ImportRow, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "line", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.rows.Find(ctx, bson.M{"jobid": jobID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	rows := []domain.ImportRow{}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
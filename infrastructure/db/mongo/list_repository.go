@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ListRepository struct {
+	collection *mongo.Collection
+}
+
+func NewListRepository(db *mongo.Database) *ListRepository {
+	return &ListRepository{
+		collection: db.Collection("lists"),
+	}
+}
+
+func (r *ListRepository) Save(ctx context.Context, list *domain.List) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"id": list.ID},
+		bson.M{"$set": list},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *ListRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrListNotFound
+	}
+	return nil
+}
+
+func (r *ListRepository) FindByID(ctx context.Context, id string) (*domain.List, error) {
+	var list domain.List
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&list)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrListNotFound
+	}
+	return &list, err
+}
+
+func (r *ListRepository) FindByOwnerID(ctx context.Context, ownerID string) ([]*domain.List, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"ownerid": ownerID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var lists []*domain.List
+	for cursor.Next(ctx) {
+		var list domain.List
+		if err := cursor.Decode(&list); err != nil {
+			return nil, err
+		}
+		lists = append(lists, &list)
+	}
+	return lists, cursor.Err()
+}
+
+func (r *ListRepository) RemoveMember(ctx context.Context, userID string) error {
+	field := "members." + userID
+	_, err := r.collection.UpdateMany(ctx, bson.M{field: bson.M{"$exists": true}}, bson.M{"$unset": bson.M{field: ""}})
+	return err
+}
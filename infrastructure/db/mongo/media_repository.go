@@ -0,0 +1,67 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MediaRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMediaRepository(db *mongo.Database) *MediaRepository {
+	return &MediaRepository{
+		collection: db.Collection("media"),
+	}
+}
+
+// EnsureIndexes creates the unique index media are looked up by, and the
+// index on their uploader.
+func (r *MediaRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "userid", Value: 1}}},
+	})
+	return err
+}
+
+func (r *MediaRepository) Save(ctx context.Context, media *domain.Media) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"id": media.ID},
+		bson.M{"$set": media},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *MediaRepository) FindByID(ctx context.Context, id string) (*domain.Media, error) {
+	var media domain.Media
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&media)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrMediaNotFound
+	}
+	return &media, err
+}
+
+func (r *MediaRepository) FindByUserID(ctx context.Context, userID string, limit int) ([]*domain.Media, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"userid": userID}, options.Find().SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	media := []*domain.Media{}
+	if err := cursor.All(ctx, &media); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (r *MediaRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NotificationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewNotificationRepository(db *mongo.Database) *NotificationRepository {
+	return &NotificationRepository{
+		collection: db.Collection("notifications"),
+	}
+}
+
+func (r *NotificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"id": notification.ID},
+		bson.M{"$set": notification},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *NotificationRepository) FindUnread(ctx context.Context, userID string, notificationType domain.NotificationType, tweetID string) (*domain.Notification, error) {
+	filter := bson.M{"userid": userID, "type": notificationType, "tweetid": tweetID, "read": false}
+	var notification domain.Notification
+	err := r.collection.FindOne(ctx, filter).Decode(&notification)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrNotificationNotFound
+	}
+	return &notification, err
+}
+
+func (r *NotificationRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, error) {
+	filter := bson.M{"userid": userID}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updatedat", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []*domain.Notification
+	for cursor.Next(ctx) {
+		var notification domain.Notification
+		if err := cursor.Decode(&notification); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &notification)
+	}
+	return notifications, cursor.Err()
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"userid": userID, "read": false})
+	return int(count), err
+}
+
+// MarkRead marks the given notifications of userID as read, or all of them
+// when ids is empty.
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID string, ids []string) error {
+	filter := bson.M{"userid": userID}
+	if len(ids) > 0 {
+		filter["id"] = bson.M{"$in": ids}
+	}
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"read": true}})
+	return err
+}
+
+func (r *NotificationRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"userid": userID}); err != nil {
+		return err
+	}
+	update := bson.M{
+		"$pull": bson.M{"actorids": userID},
+		"$inc":  bson.M{"actorcount": -1},
+	}
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"actorids": userID}, update); err != nil {
+		return err
+	}
+	_, err := r.collection.DeleteMany(ctx, bson.M{"actorcount": bson.M{"$lte": 0}})
+	return err
+}
@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PollVoteRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPollVoteRepository(db *mongo.Database) *PollVoteRepository {
+	return &PollVoteRepository{
+		collection: db.Collection("poll_votes"),
+	}
+}
+
+// EnsureIndexes creates the unique index that allows one vote per user and
+// poll, which also serves tallies.
+func (r *PollVoteRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tweetid", Value: 1}, {Key: "userid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+func (r *PollVoteRepository) Save(ctx context.Context, vote *domain.PollVote) error {
+	_, err := r.collection.InsertOne(ctx, vote)
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.ErrAlreadyVoted
+	}
+	return err
+}
+
+func (r *PollVoteRepository) FindByUserID(ctx context.Context, userID string, tweetIDs []string) (map[string]*domain.PollVote, error) {
+	filter := bson.M{"userid": userID, "tweetid": bson.M{"$in": tweetIDs}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	votes := make(map[string]*domain.PollVote)
+	for cursor.Next(ctx) {
+		var vote domain.PollVote
+		if err := cursor.Decode(&vote); err != nil {
+			return nil, err
+		}
+		votes[vote.TweetID] = &vote
+	}
+	return votes, cursor.Err()
+}
+
+func (r *PollVoteRepository) Tally(ctx context.Context, tweetIDs []string) (map[string]map[int]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"tweetid": bson.M{"$in": tweetIDs}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"tweetid": "$tweetid", "option": "$option"},
+			"votes": bson.M{"$sum": 1},
+		}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	tally := make(map[string]map[int]int)
+	for cursor.Next(ctx) {
+		var row struct {
+			ID struct {
+				TweetID string `bson:"tweetid"`
+				Option  int    `bson:"option"`
+			} `bson:"_id"`
+			Votes int `bson:"votes"`
+		}
+		if err := cursor.Decode(&row); err != nil {
+			return nil, err
+		}
+		if tally[row.ID.TweetID] == nil {
+			tally[row.ID.TweetID] = make(map[int]int)
+		}
+		tally[row.ID.TweetID][row.ID.Option] = row.Votes
+	}
+	return tally, cursor.Err()
+}
+
+func (r *PollVoteRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"userid": userID})
+	return err
+}
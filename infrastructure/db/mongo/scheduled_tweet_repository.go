@@ -0,0 +1,125 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ScheduledTweetRepository struct {
+	collection *mongo.Collection
+}
+
+func NewScheduledTweetRepository(db *mongo.Database) *ScheduledTweetRepository {
+	return &ScheduledTweetRepository{
+		collection: db.Collection("scheduled_tweets"),
+	}
+}
+
+// EnsureIndexes creates the indexes used to find due and pending tweets.
+func (r *ScheduledTweetRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "publishat", Value: 1}}},
+		{Keys: bson.D{{Key: "userid", Value: 1}, {Key: "status", Value: 1}, {Key: "publishat", Value: 1}}},
+	})
+	return err
+}
+
+func (r *ScheduledTweetRepository) Save(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	_, err := r.collection.InsertOne(ctx, tweet)
+	return err
+}
+
+func (r *ScheduledTweetRepository) Update(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	version := tweet.Version
+	tweet.Version++
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"id": tweet.ID, "version": version}, tweet)
+	if err != nil {
+		tweet.Version = version
+		return err
+	}
+	if result.MatchedCount == 0 {
+		tweet.Version = version
+		return domain.ErrScheduledTweetConflict
+	}
+	return nil
+}
+
+func (r *ScheduledTweetRepository) FindByID(ctx context.Context, id string) (*domain.ScheduledTweet, error) {
+	var tweet domain.ScheduledTweet
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&tweet)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrScheduledTweetNotFound
+	}
+	return &tweet, err
+}
+
+func (r *ScheduledTweetRepository) FindPendingByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	filter := bson.M{"userid": userID, "status": domain.ScheduledTweetPending}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "publishat", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tweets []*domain.ScheduledTweet
+	for cursor.Next(ctx) {
+		var tweet domain.ScheduledTweet
+		if err := cursor.Decode(&tweet); err != nil {
+			return nil, err
+		}
+		tweets = append(tweets, &tweet)
+	}
+	return tweets, cursor.Err()
+}
+
+// ClaimDue claims the tweets one at a time with findOneAndUpdate, which is
+// atomic per document, so concurrent schedulers never claim the same tweet.
+func (r *ScheduledTweetRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.ScheduledTweet, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": domain.ScheduledTweetPending, "publishat": bson.M{"$lte": now}},
+		bson.M{"status": domain.ScheduledTweetPublishing, "leaseuntil": bson.M{"$lt": now}},
+	}}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     domain.ScheduledTweetPublishing,
+			"leaseowner": owner,
+			"leaseuntil": leaseUntil,
+			"updatedat":  now,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "publishat", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var claimed []*domain.ScheduledTweet
+	for len(claimed) < limit {
+		var tweet domain.ScheduledTweet
+		err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&tweet)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return claimed, err
+		}
+		claimed = append(claimed, &tweet)
+	}
+	return claimed, nil
+}
+
+func (r *ScheduledTweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"userid": userID})
+	return err
+}
@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 
 	"github.com/pedro00627/urblog/domain"
 	"go.mongodb.org/mongo-driver/bson"
@@ -19,16 +20,25 @@ func NewTweetRepository(db *mongo.Database) *TweetRepository {
 	}
 }
 
-func (r *TweetRepository) Save(tweet *domain.Tweet) error {
-	_, err := r.collection.InsertOne(context.TODO(), tweet)
+func (r *TweetRepository) Save(ctx context.Context, tweet *domain.Tweet) error {
+	_, err := r.collection.InsertOne(ctx, tweet)
 	return err
 }
 
-func (r *TweetRepository) FindByUserID(userID string, limit, offset int) ([]*domain.Tweet, error) {
+func (r *TweetRepository) FindByID(ctx context.Context, id string) (*domain.Tweet, error) {
+	var tweet domain.Tweet
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&tweet)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrTweetNotFound
+	}
+	return &tweet, err
+}
+
+func (r *TweetRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
 	filter := bson.M{"userid": userID}
 	opts := options.Find().SetLimit(int64(limit)).SetSkip(int64(offset))
 
-	cursor, err := r.collection.Find(context.TODO(), filter, opts)
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -37,10 +47,10 @@ func (r *TweetRepository) FindByUserID(userID string, limit, offset int) ([]*dom
 		if closeErr != nil {
 
 		}
-	}(cursor, context.TODO())
+	}(cursor, ctx)
 
 	var tweets []*domain.Tweet
-	for cursor.Next(context.TODO()) {
+	for cursor.Next(ctx) {
 		var tweet domain.Tweet
 		err := cursor.Decode(&tweet)
 		if err != nil {
@@ -50,3 +60,8 @@ func (r *TweetRepository) FindByUserID(userID string, limit, offset int) ([]*dom
 	}
 	return tweets, nil
 }
+
+func (r *TweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"userid": userID})
+	return err
+}
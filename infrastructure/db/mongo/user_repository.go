@@ -20,9 +20,9 @@ func NewUserRepository(db *mongo.Database) *UserRepository {
 	}
 }
 
-func (r *UserRepository) Save(user *domain.User) error {
+func (r *UserRepository) Save(ctx context.Context, user *domain.User) error {
 	_, err := r.collection.UpdateOne(
-		context.TODO(),
+		ctx,
 		bson.M{"id": user.ID},
 		bson.M{"$set": user},
 		options.Update().SetUpsert(true),
@@ -30,22 +30,79 @@ func (r *UserRepository) Save(user *domain.User) error {
 	return err
 }
 
-func (r *UserRepository) FindByID(userID string) (*domain.User, error) {
-	filter := bson.M{"id": userID}
+// SaveAll saves users in a transaction, which needs MongoDB to run as a
+// replica set.
+func (r *UserRepository) SaveAll(ctx context.Context, users []*domain.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(users))
+	for i, user := range users {
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"id": user.ID}).
+			SetUpdate(bson.M{"$set": user}).
+			SetUpsert(true)
+	}
+
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return r.collection.BulkWrite(ctx, models)
+	})
+	return err
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, userID string) (*domain.User, error) {
+	filter := bson.M{"id": userID, "deactivated": bson.M{"$ne": true}}
 	var user domain.User
-	err := r.collection.FindOne(context.TODO(), filter).Decode(&user)
+	err := r.collection.FindOne(ctx, filter).Decode(&user)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrUserNotFound
 	}
 	return &user, err
 }
 
-func (r *UserRepository) FindByName(s string) (*domain.User, error) {
-	filter := bson.M{"username": s}
+func (r *UserRepository) FindByName(ctx context.Context, s string) (*domain.User, error) {
+	filter := bson.M{"username": s, "deactivated": bson.M{"$ne": true}}
 	var user domain.User
-	err := r.collection.FindOne(context.TODO(), filter).Decode(&user)
+	err := r.collection.FindOne(ctx, filter).Decode(&user)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, domain.ErrUserNotFound
 	}
 	return &user, err
 }
+
+func (r *UserRepository) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, bson.M{"deactivated": bson.M{"$ne": true}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	users := []*domain.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *UserRepository) RemoveRelationships(ctx context.Context, userID string) error {
+	var filter bson.A
+	unset := bson.M{}
+	for _, field := range []string{"following", "blocked", "muted"} {
+		filter = append(filter, bson.M{field + "." + userID: bson.M{"$exists": true}})
+		unset[field+"."+userID] = ""
+	}
+	_, err := r.collection.UpdateMany(ctx, bson.M{"$or": filter}, bson.M{"$unset": unset})
+	return err
+}
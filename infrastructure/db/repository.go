@@ -1,17 +1,155 @@
 package db
 
-import "github.com/pedro00627/urblog/domain"
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+)
 
 //go:generate mockgen -destination=./mocks/mock_tweet_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories TweetRepository
 //go:generate mockgen -destination=./mocks/mock_user_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories UserRepository
+//go:generate mockgen -destination=./mocks/mock_notification_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories NotificationRepository
+//go:generate mockgen -destination=./mocks/mock_follow_request_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories FollowRequestRepository
+//go:generate mockgen -destination=./mocks/mock_list_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories ListRepository
+//go:generate mockgen -destination=./mocks/mock_bookmark_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories BookmarkRepository
+//go:generate mockgen -destination=./mocks/mock_scheduled_tweet_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories ScheduledTweetRepository
+//go:generate mockgen -destination=./mocks/mock_media_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories MediaRepository
+//go:generate mockgen -destination=./mocks/mock_poll_vote_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories PollVoteRepository
+//go:generate mockgen -destination=./mocks/mock_import_job_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories ImportJobRepository
+//go:generate mockgen -destination=./mocks/mock_account_export_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories AccountExportRepository
+//go:generate mockgen -destination=./mocks/mock_account_deletion_repository.go -package=mocks github.com/pedro00627/urblog/infrastructure/repositories AccountDeletionRepository
 
 type TweetRepository interface {
-	FindByUserID(string, int, int) ([]*domain.Tweet, error)
-	Save(*domain.Tweet) error
+	FindByID(ctx context.Context, id string) (*domain.Tweet, error)
+	FindByUserID(context.Context, string, int, int) ([]*domain.Tweet, error)
+	Save(context.Context, *domain.Tweet) error
+	DeleteByUserID(ctx context.Context, userID string) error
 }
 
+// UserRepository hides deactivated users: finding one returns
+// domain.ErrUserNotFound.
 type UserRepository interface {
-	FindByID(string) (*domain.User, error)
-	FindByName(s string) (*domain.User, error)
-	Save(*domain.User) error
+	FindByID(context.Context, string) (*domain.User, error)
+	FindByName(ctx context.Context, s string) (*domain.User, error)
+	// FindAll returns a page of all users, in ID order.
+	FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error)
+	Save(context.Context, *domain.User) error
+	// SaveAll saves either all of users or, if it fails, none of them.
+	SaveAll(context.Context, []*domain.User) error
+	Delete(ctx context.Context, id string) error
+	// RemoveRelationships removes userID from whom every user follows, blocks
+	// and mutes.
+	RemoveRelationships(ctx context.Context, userID string) error
+}
+
+type NotificationRepository interface {
+	FindUnread(ctx context.Context, userID string, notificationType domain.NotificationType, tweetID string) (*domain.Notification, error)
+	FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	MarkRead(ctx context.Context, userID string, ids []string) error
+	Save(context.Context, *domain.Notification) error
+	// DeleteByUserID deletes the notifications of userID, and removes userID
+	// from the actors of the others. Those left without actors are deleted.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type FollowRequestRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.FollowRequest, error)
+	// FindPending returns the pending request from followerID to followeeID.
+	FindPending(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error)
+	// FindPendingByFolloweeID returns the pending requests to followeeID,
+	// oldest first.
+	FindPendingByFolloweeID(ctx context.Context, followeeID string, limit, offset int) ([]*domain.FollowRequest, error)
+	Save(context.Context, *domain.FollowRequest) error
+	// DeleteByUserID deletes the requests from and to userID.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type ListRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.List, error)
+	// FindByOwnerID returns the lists owned by ownerID, oldest first.
+	FindByOwnerID(ctx context.Context, ownerID string) ([]*domain.List, error)
+	Save(context.Context, *domain.List) error
+	Delete(ctx context.Context, id string) error
+	// RemoveMember removes userID from every list they are a member of.
+	RemoveMember(ctx context.Context, userID string) error
+}
+
+type BookmarkRepository interface {
+	Find(ctx context.Context, userID, tweetID string) (*domain.Bookmark, error)
+	// FindByUserID returns up to limit of userID's bookmarks, most recent
+	// first, starting right after the after cursor when it is not nil.
+	FindByUserID(ctx context.Context, userID string, after *domain.BookmarkCursor, limit int) ([]*domain.Bookmark, error)
+	Save(context.Context, *domain.Bookmark) error
+	Delete(ctx context.Context, userID, tweetID string) error
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type ScheduledTweetRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.ScheduledTweet, error)
+	// FindPendingByUserID returns userID's pending tweets, soonest first.
+	FindPendingByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error)
+	// Save stores a new scheduled tweet.
+	Save(context.Context, *domain.ScheduledTweet) error
+	// Update stores a changed scheduled tweet if nobody else changed it since
+	// it was read, and returns domain.ErrScheduledTweetConflict otherwise.
+	Update(context.Context, *domain.ScheduledTweet) error
+	// ClaimDue atomically claims up to limit tweets that are due at now for
+	// owner until leaseUntil, so that no other owner publishes them.
+	ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.ScheduledTweet, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type MediaRepository interface {
+	// FindByID returns domain.ErrMediaNotFound when there is no media with id.
+	FindByID(ctx context.Context, id string) (*domain.Media, error)
+	// FindByUserID returns up to limit of the media userID uploaded.
+	FindByUserID(ctx context.Context, userID string, limit int) ([]*domain.Media, error)
+	Save(context.Context, *domain.Media) error
+	Delete(ctx context.Context, id string) error
+}
+
+type PollVoteRepository interface {
+	// Save stores a vote, or returns domain.ErrAlreadyVoted if the user
+	// already voted in the poll.
+	Save(context.Context, *domain.PollVote) error
+	// FindByUserID returns userID's votes in the polls of tweetIDs, by tweet
+	// ID.
+	FindByUserID(ctx context.Context, userID string, tweetIDs []string) (map[string]*domain.PollVote, error)
+	// Tally counts the votes for each option of the polls of tweetIDs, by
+	// tweet ID.
+	Tally(ctx context.Context, tweetIDs []string) (map[string]map[int]int, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type ImportJobRepository interface {
+	// FindByID returns domain.ErrImportJobNotFound when there is no job with
+	// id.
+	FindByID(ctx context.Context, id string) (*domain.ImportJob, error)
+	Save(context.Context, *domain.ImportJob) error
+	// SaveRows adds rows to the report of their job.
+	SaveRows(ctx context.Context, rows []domain.ImportRow) error
+	// FindRows returns the report of a job, in line order.
+	FindRows(ctx context.Context, jobID string, limit, offset int) ([]domain.ImportRow, error)
+}
+
+type AccountExportRepository interface {
+	// FindByID returns domain.ErrAccountExportNotFound when there is no export
+	// with id.
+	FindByID(ctx context.Context, id string) (*domain.AccountExport, error)
+	FindByUserID(ctx context.Context, userID string) ([]*domain.AccountExport, error)
+	Save(context.Context, *domain.AccountExport) error
+	Delete(ctx context.Context, id string) error
+}
+
+type AccountDeletionRepository interface {
+	// FindByUserID returns domain.ErrAccountDeletionNotFound when userID was
+	// never deleted.
+	FindByUserID(ctx context.Context, userID string) (*domain.AccountDeletion, error)
+	Save(context.Context, *domain.AccountDeletion) error
+	// ClaimDue atomically claims up to limit deletions that are due at now
+	// for owner until leaseUntil, so that no other owner purges them. Purges
+	// whose lease ran out are claimed again.
+	ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.AccountDeletion, error)
 }
@@ -0,0 +1,11 @@
+package infrastructure
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+)
+
+//go:generate mockgen -destination=./mocks/mock_event_publisher.go -package=mocks github.com/pedro00627/urblog/infrastructure EventPublisher
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event) error
+}
@@ -0,0 +1,38 @@
+package in_memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type Handler func(ctx context.Context, event domain.Event)
+
+// Bus dispatches every published event to all subscribed handlers, in the
+// publisher's goroutine.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *Bus) Publish(ctx context.Context, event domain.Event) error {
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+	return nil
+}
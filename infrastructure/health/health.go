@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a dependency of urblog, such as its database, can be
+// used, returning why not when it cannot.
+type Check func(ctx context.Context) error
+
+// Health answers the liveness and readiness probes of orchestrators. urblog is
+// live as long as it serves requests at all, and ready while every check
+// passes and it is not shutting down.
+type Health struct {
+	timeout      time.Duration
+	names        []string
+	checks       []Check
+	shuttingDown atomic.Bool
+}
+
+// New returns a Health whose checks fail when they take longer than timeout.
+func New(timeout time.Duration) *Health {
+	return &Health{timeout: timeout}
+}
+
+// Add makes readiness depend on check, reported under name. It must be called
+// before the probes are served.
+func (h *Health) Add(name string, check Check) {
+	h.names = append(h.names, name)
+	h.checks = append(h.checks, check)
+}
+
+// ShutDown makes readiness fail from now on, so that orchestrators stop
+// sending requests while the server drains the ones it has.
+func (h *Health) ShutDown() {
+	h.shuttingDown.Store(true)
+}
+
+type response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Live answers the liveness probe, which passes whenever urblog answers.
+func (h *Health) Live(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, response{Status: "ok"})
+}
+
+// Ready answers the readiness probe with the result of every check, which run
+// at once. It answers 503 Service Unavailable when any of them fails or when
+// urblog is shutting down.
+func (h *Health) Ready(w http.ResponseWriter, r *http.Request) {
+	if h.shuttingDown.Load() {
+		writeResponse(w, http.StatusServiceUnavailable, response{Status: "shutting down"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+	errs := make([]error, len(h.checks))
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	status, body := http.StatusOK, response{Status: "ok", Checks: make(map[string]string, len(h.checks))}
+	for i, err := range errs {
+		if err != nil {
+			status, body.Status = http.StatusServiceUnavailable, "unavailable"
+			body.Checks[h.names[i]] = err.Error()
+		} else {
+			body.Checks[h.names[i]] = "ok"
+		}
+	}
+	writeResponse(w, status, body)
+}
+
+func writeResponse(w http.ResponseWriter, status int, body response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
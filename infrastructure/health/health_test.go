@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func probe(t *testing.T, handler http.HandlerFunc) (int, response) {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	var body response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func TestLive(t *testing.T) {
+	h := New(time.Second)
+	h.Add("mongo", func(context.Context) error { return errors.New("down") })
+
+	status, body := probe(t, h.Live)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "ok", body.Status)
+}
+
+func TestReadyWhenEveryCheckPasses(t *testing.T) {
+	h := New(time.Second)
+	h.Add("mongo", func(context.Context) error { return nil })
+	h.Add("kafka", func(context.Context) error { return nil })
+
+	status, body := probe(t, h.Ready)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, response{Status: "ok", Checks: map[string]string{"mongo": "ok", "kafka": "ok"}}, body)
+}
+
+func TestNotReadyWhenACheckFails(t *testing.T) {
+	h := New(time.Second)
+	h.Add("mongo", func(context.Context) error { return nil })
+	h.Add("kafka", func(context.Context) error { return errors.New("connection refused") })
+
+	status, body := probe(t, h.Ready)
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, response{Status: "unavailable", Checks: map[string]string{"mongo": "ok", "kafka": "connection refused"}}, body)
+}
+
+func TestChecksTimeOut(t *testing.T) {
+	h := New(10 * time.Millisecond)
+	h.Add("mongo", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	status, body := probe(t, h.Ready)
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), body.Checks["mongo"])
+}
+
+func TestNotReadyWhileShuttingDown(t *testing.T) {
+	h := New(time.Second)
+	h.ShutDown()
+
+	status, body := probe(t, h.Ready)
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, "shutting down", body.Status)
+}
@@ -0,0 +1,59 @@
+package httputil
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+)
+
+// StatusRecorder remembers the status a handler answered with, for
+// middlewares that report it. It keeps the Flusher and Hijacker of the writer
+// it wraps, which timeline streams and WebSockets need.
+type StatusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+// NewStatusRecorder wraps w, assuming 200 OK until the handler says otherwise.
+func NewStatusRecorder(w http.ResponseWriter) *StatusRecorder {
+	return &StatusRecorder{ResponseWriter: w, status: http.StatusOK}
+}
+
+// Status returns the status the handler answered with.
+func (rw *StatusRecorder) Status() int {
+	return rw.status
+}
+
+func (rw *StatusRecorder) WriteHeader(status int) {
+	if !rw.wroteHeader {
+		rw.status = status
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *StatusRecorder) Write(data []byte) (int, error) {
+	rw.wroteHeader = true
+	return rw.ResponseWriter.Write(data)
+}
+
+func (rw *StatusRecorder) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (rw *StatusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	// A hijacked connection switches protocols.
+	rw.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+func (rw *StatusRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
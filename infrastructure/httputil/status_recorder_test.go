@@ -0,0 +1,34 @@
+package httputil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusRecorder(t *testing.T) {
+	t.Run("defaults to 200 OK", func(t *testing.T) {
+		rw := NewStatusRecorder(httptest.NewRecorder())
+		rw.Write([]byte("hola"))
+		assert.Equal(t, http.StatusOK, rw.Status())
+	})
+
+	t.Run("keeps the first status", func(t *testing.T) {
+		rw := NewStatusRecorder(httptest.NewRecorder())
+		rw.WriteHeader(http.StatusNotFound)
+		rw.WriteHeader(http.StatusInternalServerError)
+		assert.Equal(t, http.StatusNotFound, rw.Status())
+	})
+
+	t.Run("keeps the writer it wraps", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		rw := NewStatusRecorder(w)
+		rw.Flush()
+		assert.True(t, w.Flushed)
+		assert.Equal(t, w, rw.Unwrap())
+		_, _, err := rw.Hijack()
+		assert.ErrorIs(t, err, http.ErrNotSupported)
+	})
+}
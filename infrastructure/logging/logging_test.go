@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLinesCarryTheRequestID(t *testing.T) {
+	var out bytes.Buffer
+	logger := New(&out, "json", slog.LevelInfo).With("component", "timeline")
+
+	logger.InfoContext(WithRequestID(context.Background(), "abc-123"), "served", "status", 200)
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &line))
+	assert.Equal(t, "served", line["msg"])
+	assert.Equal(t, "abc-123", line["request_id"])
+	assert.Equal(t, "timeline", line["component"])
+	assert.Equal(t, float64(200), line["status"])
+}
+
+func TestLinesWithoutRequestID(t *testing.T) {
+	var out bytes.Buffer
+	logger := New(&out, "text", slog.LevelInfo)
+
+	logger.Info("starting server", "addr", ":8080")
+
+	assert.Contains(t, out.String(), `msg="starting server" addr=:8080`)
+	assert.NotContains(t, out.String(), "request_id")
+}
+
+func TestLinesBelowTheLevelAreDropped(t *testing.T) {
+	var out bytes.Buffer
+	logger := New(&out, "text", slog.LevelWarn)
+
+	logger.Info("dropped")
+	logger.Warn("kept")
+
+	assert.NotContains(t, out.String(), "dropped")
+	assert.Contains(t, out.String(), "kept")
+}
+
+func TestMiddlewareKeepsTheRequestID(t *testing.T) {
+	var seen string
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestID(r.Context())
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/users/user1/timeline", nil)
+	req.Header.Set(RequestIDHeader, "abc-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, "abc-123", seen)
+	assert.Equal(t, "abc-123", rec.Header().Get(RequestIDHeader))
+}
+
+func TestMiddlewareGeneratesRequestIDs(t *testing.T) {
+	for name, header := range map[string]string{
+		"missing":   "",
+		"too long":  strings.Repeat("a", maxRequestIDLength+1),
+		"with tabs": "abc\t123",
+		"with line": "abc\nrequest_id=forged",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var seen string
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = RequestID(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/tweets", nil)
+			req.Header.Set(RequestIDHeader, header)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			_, err := uuid.Parse(seen)
+			assert.NoError(t, err)
+			assert.Equal(t, seen, rec.Header().Get(RequestIDHeader))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the ID of a request, both in the request and in
+// its response.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the IDs taken from clients, which end up in every
+// log line of their request.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns ctx carrying the request ID id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID ctx carries, or "" if it carries none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Middleware gives each request next serves an ID, which its context carries
+// to every line logged while serving it, and which its response returns. The
+// ID in the X-Request-ID header of the request is kept, so that a request can
+// be followed across services; requests without a valid one get a new ID.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.New().String()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID reports whether id is short and made of printable ASCII
+// only, so that clients cannot forge or break log lines with it.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+// errorTypes names the domain errors for metric labels. Errors are labelled
+// with the first of these they wrap, and errors that wrap others come before
+// them, so ErrEmptyTweetContent counts as empty_tweet_content rather than
+// invalid_tweet_content. A slice matched with errors.Is is used rather than a
+// map, since errors such as those of MongoDB cannot be map keys.
+var errorTypes = []struct {
+	err  error
+	name string
+}{
+	{domain.ErrEmptyTweetContent, "empty_tweet_content"},
+	{domain.ErrInvalidFollowAction, "invalid_follow_action"},
+	{domain.ErrAlreadyFollowing, "already_following"},
+	{domain.ErrUserNotFound, "user_not_found"},
+	{domain.ErrInvalidBlockAction, "invalid_block_action"},
+	{domain.ErrAlreadyBlocked, "already_blocked"},
+	{domain.ErrNotBlocked, "not_blocked"},
+	{domain.ErrUserBlocked, "user_blocked"},
+	{domain.ErrInvalidMuteAction, "invalid_mute_action"},
+	{domain.ErrAlreadyMuted, "already_muted"},
+	{domain.ErrNotMuted, "not_muted"},
+	{domain.ErrProtectedAccount, "protected_account"},
+	{domain.ErrFollowRequestNotFound, "follow_request_not_found"},
+	{domain.ErrFollowRequestPending, "follow_request_pending"},
+	{domain.ErrInvalidFollowRequestAction, "invalid_follow_request_action"},
+	{domain.ErrNotificationNotFound, "notification_not_found"},
+	{domain.ErrListNotFound, "list_not_found"},
+	{domain.ErrInvalidListName, "invalid_list_name"},
+	{domain.ErrNotListOwner, "not_list_owner"},
+	{domain.ErrAlreadyListMember, "already_list_member"},
+	{domain.ErrNotListMember, "not_list_member"},
+	{domain.ErrListFull, "list_full"},
+	{domain.ErrTweetNotFound, "tweet_not_found"},
+	{domain.ErrAlreadyBookmarked, "already_bookmarked"},
+	{domain.ErrBookmarkNotFound, "bookmark_not_found"},
+	{domain.ErrInvalidBookmarkCursor, "invalid_bookmark_cursor"},
+	{domain.ErrScheduledTweetNotFound, "scheduled_tweet_not_found"},
+	{domain.ErrInvalidPublishTime, "invalid_publish_time"},
+	{domain.ErrScheduledTweetNotPending, "scheduled_tweet_not_pending"},
+	{domain.ErrScheduledTweetConflict, "scheduled_tweet_conflict"},
+	{domain.ErrMediaNotFound, "media_not_found"},
+	{domain.ErrUnsupportedMediaType, "unsupported_media_type"},
+	{domain.ErrMediaTooLarge, "media_too_large"},
+	{domain.ErrTooManyMedia, "too_many_media"},
+	{domain.ErrInvalidPoll, "invalid_poll"},
+	{domain.ErrPollWithMedia, "poll_with_media"},
+	{domain.ErrTweetHasNoPoll, "tweet_has_no_poll"},
+	{domain.ErrPollClosed, "poll_closed"},
+	{domain.ErrInvalidPollOption, "invalid_poll_option"},
+	{domain.ErrAlreadyVoted, "already_voted"},
+	{domain.ErrInvalidSearchQuery, "invalid_search_query"},
+	{domain.ErrInvalidSearchCursor, "invalid_search_cursor"},
+	{domain.ErrInvalidTrendWindow, "invalid_trend_window"},
+	{domain.ErrImportTooLarge, "import_too_large"},
+	{domain.ErrImportJobNotFound, "import_job_not_found"},
+	{domain.ErrUnsupportedImportFormat, "unsupported_import_format"},
+	{domain.ErrAccountExportNotFound, "account_export_not_found"},
+	{domain.ErrAccountExportNotReady, "account_export_not_ready"},
+	{domain.ErrAccountDeletionNotFound, "account_deletion_not_found"},
+	{domain.ErrInvalidTweetContent, "invalid_tweet_content"},
+	{domain.ErrInvalidMedia, "invalid_media"},
+	{domain.ErrInvalidImport, "invalid_import"},
+}
+
+// errorType returns the label of err: the domain error it wraps, the
+// cancellation or deadline that stopped it, or "internal" for anything else,
+// so that errors carrying IDs do not make a series each.
+func errorType(err error) string {
+	for _, errorType := range errorTypes {
+		if errors.Is(err, errorType.err) {
+			return errorType.name
+		}
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	}
+	return "internal"
+}
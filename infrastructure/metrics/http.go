@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pedro00627/urblog/infrastructure/httputil"
+)
+
+// Middleware counts and times the requests next serves. Requests are
+// labelled with the pattern of the route they matched rather than their path,
+// so that IDs in paths do not make a series each.
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rw := httputil.NewStatusRecorder(w)
+		next.ServeHTTP(rw, r)
+
+		route := r.Pattern
+		if route == "" {
+			route = "unmatched"
+		}
+		labels := []string{route, r.Method, strconv.Itoa(rw.Status())}
+		m.httpRequests.WithLabelValues(labels...).Inc()
+		m.httpRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	})
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors of urblog. Requests, use cases,
+// repositories and the queue are measured by decorators that wrap them, so
+// that neither handlers nor adapters know about Prometheus.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	useCaseDuration     *prometheus.HistogramVec
+	useCaseErrors       *prometheus.CounterVec
+	repositoryDuration  *prometheus.HistogramVec
+	repositoryErrors    *prometheus.CounterVec
+	queuePublished      *prometheus.CounterVec
+	queueDuration       *prometheus.HistogramVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "urblog_http_requests_total",
+			Help: "HTTP requests served, by route, method and status.",
+		}, []string{"route", "method", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "urblog_http_request_duration_seconds",
+			Help:    "Time taken to serve HTTP requests, by route, method and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		useCaseDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "urblog_use_case_duration_seconds",
+			Help:    "Time taken to run use cases, by use case.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"use_case"}),
+		useCaseErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "urblog_use_case_errors_total",
+			Help: "Use cases that failed, by use case and error type.",
+		}, []string{"use_case", "error"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "urblog_repository_operation_duration_seconds",
+			Help:    "Time taken by repository operations, by repository, operation and adapter.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"repository", "operation", "adapter"}),
+		repositoryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "urblog_repository_operation_errors_total",
+			Help: "Repository operations that failed, by repository, operation, adapter and error type.",
+		}, []string{"repository", "operation", "adapter", "error"}),
+		queuePublished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "urblog_queue_published_total",
+			Help: "Messages written to the queue, by result.",
+		}, []string{"result"}),
+		queueDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "urblog_queue_publish_duration_seconds",
+			Help:    "Time taken to write messages to the queue, by result.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"result"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.useCaseDuration,
+		m.useCaseErrors,
+		m.repositoryDuration,
+		m.repositoryErrors,
+		m.queuePublished,
+		m.queueDuration,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
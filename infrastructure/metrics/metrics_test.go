@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	appmocks "github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestMiddleware(t *testing.T) {
+	m := New()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}/tweets", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") == "ghost" {
+			http.Error(w, "user not found", http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("GET /stream", func(w http.ResponseWriter, r *http.Request) {
+		_, ok := w.(http.Flusher)
+		assert.True(t, ok, "streams can still flush")
+	})
+	handler := m.Middleware(mux)
+
+	for _, path := range []string{"/users/user1/tweets", "/users/user2/tweets", "/users/ghost/tweets", "/stream", "/nowhere"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET /users/{id}/tweets", "GET", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET /users/{id}/tweets", "GET", "400")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET /stream", "GET", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("unmatched", "GET", "404")))
+	assert.Equal(t, 4, testutil.CollectAndCount(m.httpRequestDuration))
+}
+
+func TestUseCases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := New()
+	followUser := appmocks.NewMockFollowUser(ctrl)
+	gomock.InOrder(
+		followUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil, nil).Times(1),
+		followUser.EXPECT().Execute(gomock.Any(), "user1", "user1").Return(nil, domain.ErrInvalidFollowAction).Times(1),
+		followUser.EXPECT().Execute(gomock.Any(), "user1", "user3").Return(nil, fmt.Errorf("following user3: %w", domain.ErrUserNotFound)).Times(1),
+		followUser.EXPECT().Execute(gomock.Any(), "user1", "user4").Return(nil, errors.New("connection reset by peer")).Times(1),
+	)
+	decorated := m.FollowUser(followUser)
+
+	_, err := decorated.Execute(context.Background(), "user1", "user2")
+	assert.NoError(t, err)
+	_, err = decorated.Execute(context.Background(), "user1", "user1")
+	assert.Equal(t, domain.ErrInvalidFollowAction, err)
+	_, err = decorated.Execute(context.Background(), "user1", "user3")
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	_, err = decorated.Execute(context.Background(), "user1", "user4")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.useCaseDuration))
+	assert.NoError(t, testutil.CollectAndCompare(m.useCaseErrors, strings.NewReader(`
+# HELP urblog_use_case_errors_total Use cases that failed, by use case and error type.
+# TYPE urblog_use_case_errors_total counter
+urblog_use_case_errors_total{error="internal",use_case="follow_user"} 1
+urblog_use_case_errors_total{error="invalid_follow_action",use_case="follow_user"} 1
+urblog_use_case_errors_total{error="user_not_found",use_case="follow_user"} 1
+`)))
+}
+
+func TestRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := New()
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(domain.NewUser("user1", "ana"), nil).Times(1)
+	userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+	users := m.UserRepository(userRepo, "mongo")
+	tweetRepo := mocks.NewMockTweetRepository(ctrl)
+	tweetRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tweets := m.TweetRepository(tweetRepo, "in_memory")
+
+	user, err := users.FindByID(context.Background(), "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ana", user.Username)
+	_, err = users.FindByID(context.Background(), "ghost")
+	assert.Equal(t, domain.ErrUserNotFound, err)
+	assert.NoError(t, tweets.Save(context.Background(), &domain.Tweet{ID: "tweet1"}))
+
+	assert.Equal(t, 2, testutil.CollectAndCount(m.repositoryDuration))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.repositoryErrors.WithLabelValues("users", "find_by_id", "mongo", "user_not_found")))
+}
+
+func TestQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := New()
+	next := mocks.NewMockQueue(ctrl)
+	gomock.InOrder(
+		next.EXPECT().WriteMessage(gomock.Any(), []byte("a")).Return(nil).Times(2),
+		next.EXPECT().WriteMessage(gomock.Any(), []byte("b")).Return(errors.New("broker down")).Times(1),
+	)
+	queue := m.Queue(next)
+
+	assert.NoError(t, queue.WriteMessage(context.Background(), []byte("a")))
+	assert.NoError(t, queue.WriteMessage(context.Background(), []byte("a")))
+	assert.EqualError(t, queue.WriteMessage(context.Background(), []byte("b")), "broker down")
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.queuePublished.WithLabelValues("success")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.queuePublished.WithLabelValues("failure")))
+}
+
+func TestHandler(t *testing.T) {
+	m := New()
+	m.Middleware(http.NotFoundHandler()).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	w := httptest.NewRecorder()
+
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `urblog_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, w.Body.String(), "go_goroutines")
+}
+
+func TestErrorType(t *testing.T) {
+	commandErr := mongo.CommandError{Code: 11000, Message: "duplicate key", Labels: []string{"RetryableWriteError"}}
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{domain.ErrUserNotFound, "user_not_found"},
+		{fmt.Errorf("finding user1: %w", domain.ErrUserNotFound), "user_not_found"},
+		{domain.ErrEmptyTweetContent, "empty_tweet_content"},
+		{domain.ErrUnsupportedImportFormat, "unsupported_import_format"},
+		{domain.ErrInvalidTweetContent, "invalid_tweet_content"},
+		{fmt.Errorf("saving: %w", context.DeadlineExceeded), "deadline_exceeded"},
+		{commandErr, "internal"},
+		{fmt.Errorf("saving user1: %w", commandErr), "internal"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, errorType(tt.err), tt.err.Error())
+	}
+}
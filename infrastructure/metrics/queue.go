@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/infrastructure"
+)
+
+type queue struct {
+	next    infrastructure.Queue
+	metrics *Metrics
+}
+
+// Queue counts and times the messages written to next.
+func (m *Metrics) Queue(next infrastructure.Queue) infrastructure.Queue {
+	return &queue{next: next, metrics: m}
+}
+
+func (q *queue) WriteMessage(ctx context.Context, message []byte) error {
+	start := time.Now()
+	err := q.next.WriteMessage(ctx, message)
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	q.metrics.queuePublished.WithLabelValues(result).Inc()
+	q.metrics.queueDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+	return err
+}
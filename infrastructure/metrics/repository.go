@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+)
+
+// observeRepository records an operation of repository on adapter that
+// started at start and failed with err, if it did.
+func (m *Metrics) observeRepository(repository, operation, adapter string, start time.Time, err error) {
+	m.repositoryDuration.WithLabelValues(repository, operation, adapter).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.repositoryErrors.WithLabelValues(repository, operation, adapter, errorType(err)).Inc()
+	}
+}
+
+type tweetRepository struct {
+	next    db.TweetRepository
+	adapter string
+	metrics *Metrics
+}
+
+// TweetRepository times the operations of next, labelled with adapter, such
+// as "mongo" or "in_memory".
+func (m *Metrics) TweetRepository(next db.TweetRepository, adapter string) db.TweetRepository {
+	return &tweetRepository{next: next, adapter: adapter, metrics: m}
+}
+
+func (r *tweetRepository) observe(operation string, start time.Time, err error) {
+	r.metrics.observeRepository("tweets", operation, r.adapter, start, err)
+}
+
+func (r *tweetRepository) FindByID(ctx context.Context, id string) (*domain.Tweet, error) {
+	start := time.Now()
+	tweet, err := r.next.FindByID(ctx, id)
+	r.observe("find_by_id", start, err)
+	return tweet, err
+}
+
+func (r *tweetRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	start := time.Now()
+	tweets, err := r.next.FindByUserID(ctx, userID, limit, offset)
+	r.observe("find_by_user_id", start, err)
+	return tweets, err
+}
+
+func (r *tweetRepository) Save(ctx context.Context, tweet *domain.Tweet) error {
+	start := time.Now()
+	err := r.next.Save(ctx, tweet)
+	r.observe("save", start, err)
+	return err
+}
+
+func (r *tweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.DeleteByUserID(ctx, userID)
+	r.observe("delete_by_user_id", start, err)
+	return err
+}
+
+type userRepository struct {
+	next    db.UserRepository
+	adapter string
+	metrics *Metrics
+}
+
+// UserRepository times the operations of next, labelled with adapter, such
+// as "mongo" or "in_memory".
+func (m *Metrics) UserRepository(next db.UserRepository, adapter string) db.UserRepository {
+	return &userRepository{next: next, adapter: adapter, metrics: m}
+}
+
+func (r *userRepository) observe(operation string, start time.Time, err error) {
+	r.metrics.observeRepository("users", operation, r.adapter, start, err)
+}
+
+func (r *userRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	start := time.Now()
+	user, err := r.next.FindByID(ctx, id)
+	r.observe("find_by_id", start, err)
+	return user, err
+}
+
+func (r *userRepository) FindByName(ctx context.Context, username string) (*domain.User, error) {
+	start := time.Now()
+	user, err := r.next.FindByName(ctx, username)
+	r.observe("find_by_name", start, err)
+	return user, err
+}
+
+func (r *userRepository) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	start := time.Now()
+	users, err := r.next.FindAll(ctx, limit, offset)
+	r.observe("find_all", start, err)
+	return users, err
+}
+
+func (r *userRepository) Save(ctx context.Context, user *domain.User) error {
+	start := time.Now()
+	err := r.next.Save(ctx, user)
+	r.observe("save", start, err)
+	return err
+}
+
+func (r *userRepository) SaveAll(ctx context.Context, users []*domain.User) error {
+	start := time.Now()
+	err := r.next.SaveAll(ctx, users)
+	r.observe("save_all", start, err)
+	return err
+}
+
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, id)
+	r.observe("delete", start, err)
+	return err
+}
+
+func (r *userRepository) RemoveRelationships(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.RemoveRelationships(ctx, userID)
+	r.observe("remove_relationships", start, err)
+	return err
+}
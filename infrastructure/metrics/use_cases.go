@@ -0,0 +1,740 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+// observeUseCase records a run of useCase that started at start and failed
+// with err, if it did. Each decorator below times the use case it wraps under
+// its name in snake case.
+func (m *Metrics) observeUseCase(useCase string, start time.Time, err error) {
+	m.useCaseDuration.WithLabelValues(useCase).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.useCaseErrors.WithLabelValues(useCase, errorType(err)).Inc()
+	}
+}
+
+type addListMember struct {
+	next    application.AddListMember
+	metrics *Metrics
+}
+
+func (m *Metrics) AddListMember(next application.AddListMember) application.AddListMember {
+	return &addListMember{next: next, metrics: m}
+}
+
+func (uc *addListMember) Execute(ctx context.Context, userID, listID, memberID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, listID, memberID)
+	uc.metrics.observeUseCase("add_list_member", start, err)
+	return err
+}
+
+type blockUser struct {
+	next    application.BlockUser
+	metrics *Metrics
+}
+
+func (m *Metrics) BlockUser(next application.BlockUser) application.BlockUser {
+	return &blockUser{next: next, metrics: m}
+}
+
+func (uc *blockUser) Execute(ctx context.Context, userID, targetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, targetID)
+	uc.metrics.observeUseCase("block_user", start, err)
+	return err
+}
+
+type bookmarkTweet struct {
+	next    application.BookmarkTweet
+	metrics *Metrics
+}
+
+func (m *Metrics) BookmarkTweet(next application.BookmarkTweet) application.BookmarkTweet {
+	return &bookmarkTweet{next: next, metrics: m}
+}
+
+func (uc *bookmarkTweet) Execute(ctx context.Context, userID, tweetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, tweetID)
+	uc.metrics.observeUseCase("bookmark_tweet", start, err)
+	return err
+}
+
+type buildNotifications struct {
+	next    application.BuildNotifications
+	metrics *Metrics
+}
+
+func (m *Metrics) BuildNotifications(next application.BuildNotifications) application.BuildNotifications {
+	return &buildNotifications{next: next, metrics: m}
+}
+
+func (uc *buildNotifications) Execute(ctx context.Context, event domain.Event) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, event)
+	uc.metrics.observeUseCase("build_notifications", start, err)
+	return err
+}
+
+type cancelScheduledTweet struct {
+	next    application.CancelScheduledTweet
+	metrics *Metrics
+}
+
+func (m *Metrics) CancelScheduledTweet(next application.CancelScheduledTweet) application.CancelScheduledTweet {
+	return &cancelScheduledTweet{next: next, metrics: m}
+}
+
+func (uc *cancelScheduledTweet) Execute(ctx context.Context, userID, id string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, id)
+	uc.metrics.observeUseCase("cancel_scheduled_tweet", start, err)
+	return err
+}
+
+type createList struct {
+	next    application.CreateList
+	metrics *Metrics
+}
+
+func (m *Metrics) CreateList(next application.CreateList) application.CreateList {
+	return &createList{next: next, metrics: m}
+}
+
+func (uc *createList) Execute(ctx context.Context, ownerID, name string, private bool) (*domain.List, error) {
+	start := time.Now()
+	list, err := uc.next.Execute(ctx, ownerID, name, private)
+	uc.metrics.observeUseCase("create_list", start, err)
+	return list, err
+}
+
+type createTweet struct {
+	next    application.CreateTweet
+	metrics *Metrics
+}
+
+func (m *Metrics) CreateTweet(next application.CreateTweet) application.CreateTweet {
+	return &createTweet{next: next, metrics: m}
+}
+
+func (uc *createTweet) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments) (*domain.Tweet, error) {
+	start := time.Now()
+	tweet, err := uc.next.Execute(ctx, userID, content, attachments)
+	uc.metrics.observeUseCase("create_tweet", start, err)
+	return tweet, err
+}
+
+type deleteList struct {
+	next    application.DeleteList
+	metrics *Metrics
+}
+
+func (m *Metrics) DeleteList(next application.DeleteList) application.DeleteList {
+	return &deleteList{next: next, metrics: m}
+}
+
+func (uc *deleteList) Execute(ctx context.Context, userID, listID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, listID)
+	uc.metrics.observeUseCase("delete_list", start, err)
+	return err
+}
+
+type deleteUser struct {
+	next    application.DeleteUser
+	metrics *Metrics
+}
+
+func (m *Metrics) DeleteUser(next application.DeleteUser) application.DeleteUser {
+	return &deleteUser{next: next, metrics: m}
+}
+
+func (uc *deleteUser) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	start := time.Now()
+	deletion, err := uc.next.Execute(ctx, userID)
+	uc.metrics.observeUseCase("delete_user", start, err)
+	return deletion, err
+}
+
+type exportAccount struct {
+	next    application.ExportAccount
+	metrics *Metrics
+}
+
+func (m *Metrics) ExportAccount(next application.ExportAccount) application.ExportAccount {
+	return &exportAccount{next: next, metrics: m}
+}
+
+func (uc *exportAccount) Execute(ctx context.Context, userID string) (*domain.AccountExport, []byte, error) {
+	start := time.Now()
+	export, data, err := uc.next.Execute(ctx, userID)
+	uc.metrics.observeUseCase("export_account", start, err)
+	return export, data, err
+}
+
+type exportUsers struct {
+	next    application.ExportUsers
+	metrics *Metrics
+}
+
+func (m *Metrics) ExportUsers(next application.ExportUsers) application.ExportUsers {
+	return &exportUsers{next: next, metrics: m}
+}
+
+func (uc *exportUsers) Execute(ctx context.Context, w io.Writer, format domain.ImportFormat) (int, error) {
+	start := time.Now()
+	count, err := uc.next.Execute(ctx, w, format)
+	uc.metrics.observeUseCase("export_users", start, err)
+	return count, err
+}
+
+type followUser struct {
+	next    application.FollowUser
+	metrics *Metrics
+}
+
+func (m *Metrics) FollowUser(next application.FollowUser) application.FollowUser {
+	return &followUser{next: next, metrics: m}
+}
+
+func (uc *followUser) Execute(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	start := time.Now()
+	request, err := uc.next.Execute(ctx, followerID, followeeID)
+	uc.metrics.observeUseCase("follow_user", start, err)
+	return request, err
+}
+
+type getAccountDeletion struct {
+	next    application.GetAccountDeletion
+	metrics *Metrics
+}
+
+func (m *Metrics) GetAccountDeletion(next application.GetAccountDeletion) application.GetAccountDeletion {
+	return &getAccountDeletion{next: next, metrics: m}
+}
+
+func (uc *getAccountDeletion) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	start := time.Now()
+	deletion, err := uc.next.Execute(ctx, userID)
+	uc.metrics.observeUseCase("get_account_deletion", start, err)
+	return deletion, err
+}
+
+type getAccountExport struct {
+	next    application.GetAccountExport
+	metrics *Metrics
+}
+
+func (m *Metrics) GetAccountExport(next application.GetAccountExport) application.GetAccountExport {
+	return &getAccountExport{next: next, metrics: m}
+}
+
+func (uc *getAccountExport) Execute(ctx context.Context, id string, archive bool) (*domain.AccountExport, []byte, error) {
+	start := time.Now()
+	export, data, err := uc.next.Execute(ctx, id, archive)
+	uc.metrics.observeUseCase("get_account_export", start, err)
+	return export, data, err
+}
+
+type getBookmarks struct {
+	next    application.GetBookmarks
+	metrics *Metrics
+}
+
+func (m *Metrics) GetBookmarks(next application.GetBookmarks) application.GetBookmarks {
+	return &getBookmarks{next: next, metrics: m}
+}
+
+func (uc *getBookmarks) Execute(ctx context.Context, userID string, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	start := time.Now()
+	tweets, cursor, err := uc.next.Execute(ctx, userID, limit, cursor)
+	uc.metrics.observeUseCase("get_bookmarks", start, err)
+	return tweets, cursor, err
+}
+
+type getFollowRequests struct {
+	next    application.GetFollowRequests
+	metrics *Metrics
+}
+
+func (m *Metrics) GetFollowRequests(next application.GetFollowRequests) application.GetFollowRequests {
+	return &getFollowRequests{next: next, metrics: m}
+}
+
+func (uc *getFollowRequests) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	start := time.Now()
+	requests, err := uc.next.Execute(ctx, userID, limit, offset)
+	uc.metrics.observeUseCase("get_follow_requests", start, err)
+	return requests, err
+}
+
+type getImportJob struct {
+	next    application.GetImportJob
+	metrics *Metrics
+}
+
+func (m *Metrics) GetImportJob(next application.GetImportJob) application.GetImportJob {
+	return &getImportJob{next: next, metrics: m}
+}
+
+func (uc *getImportJob) Execute(ctx context.Context, id string, limit, offset int) (*domain.ImportJob, []domain.ImportRow, error) {
+	start := time.Now()
+	job, rows, err := uc.next.Execute(ctx, id, limit, offset)
+	uc.metrics.observeUseCase("get_import_job", start, err)
+	return job, rows, err
+}
+
+type getList struct {
+	next    application.GetList
+	metrics *Metrics
+}
+
+func (m *Metrics) GetList(next application.GetList) application.GetList {
+	return &getList{next: next, metrics: m}
+}
+
+func (uc *getList) Execute(ctx context.Context, viewerID, listID string) (*domain.List, error) {
+	start := time.Now()
+	list, err := uc.next.Execute(ctx, viewerID, listID)
+	uc.metrics.observeUseCase("get_list", start, err)
+	return list, err
+}
+
+type getListTimeline struct {
+	next    application.GetListTimeline
+	metrics *Metrics
+}
+
+func (m *Metrics) GetListTimeline(next application.GetListTimeline) application.GetListTimeline {
+	return &getListTimeline{next: next, metrics: m}
+}
+
+func (uc *getListTimeline) Execute(ctx context.Context, viewerID, listID string, limit, offset int) ([]*domain.Tweet, error) {
+	start := time.Now()
+	tweets, err := uc.next.Execute(ctx, viewerID, listID, limit, offset)
+	uc.metrics.observeUseCase("get_list_timeline", start, err)
+	return tweets, err
+}
+
+type getMedia struct {
+	next    application.GetMedia
+	metrics *Metrics
+}
+
+func (m *Metrics) GetMedia(next application.GetMedia) application.GetMedia {
+	return &getMedia{next: next, metrics: m}
+}
+
+func (uc *getMedia) Execute(ctx context.Context, id string, thumbnail bool) (*domain.Media, []byte, error) {
+	start := time.Now()
+	media, data, err := uc.next.Execute(ctx, id, thumbnail)
+	uc.metrics.observeUseCase("get_media", start, err)
+	return media, data, err
+}
+
+type getNotifications struct {
+	next    application.GetNotifications
+	metrics *Metrics
+}
+
+func (m *Metrics) GetNotifications(next application.GetNotifications) application.GetNotifications {
+	return &getNotifications{next: next, metrics: m}
+}
+
+func (uc *getNotifications) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, int, error) {
+	start := time.Now()
+	notifications, unread, err := uc.next.Execute(ctx, userID, limit, offset)
+	uc.metrics.observeUseCase("get_notifications", start, err)
+	return notifications, unread, err
+}
+
+type getScheduledTweets struct {
+	next    application.GetScheduledTweets
+	metrics *Metrics
+}
+
+func (m *Metrics) GetScheduledTweets(next application.GetScheduledTweets) application.GetScheduledTweets {
+	return &getScheduledTweets{next: next, metrics: m}
+}
+
+func (uc *getScheduledTweets) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	start := time.Now()
+	tweets, err := uc.next.Execute(ctx, userID, limit, offset)
+	uc.metrics.observeUseCase("get_scheduled_tweets", start, err)
+	return tweets, err
+}
+
+type getTimeline struct {
+	next    application.GetTimeline
+	metrics *Metrics
+}
+
+func (m *Metrics) GetTimeline(next application.GetTimeline) application.GetTimeline {
+	return &getTimeline{next: next, metrics: m}
+}
+
+func (uc *getTimeline) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	start := time.Now()
+	tweets, err := uc.next.Execute(ctx, userID, limit, offset)
+	uc.metrics.observeUseCase("get_timeline", start, err)
+	return tweets, err
+}
+
+type getTrends struct {
+	next    application.GetTrends
+	metrics *Metrics
+}
+
+func (m *Metrics) GetTrends(next application.GetTrends) application.GetTrends {
+	return &getTrends{next: next, metrics: m}
+}
+
+func (uc *getTrends) Execute(ctx context.Context, window string, limit int) ([]domain.Trend, error) {
+	start := time.Now()
+	trends, err := uc.next.Execute(ctx, window, limit)
+	uc.metrics.observeUseCase("get_trends", start, err)
+	return trends, err
+}
+
+type getUserLists struct {
+	next    application.GetUserLists
+	metrics *Metrics
+}
+
+func (m *Metrics) GetUserLists(next application.GetUserLists) application.GetUserLists {
+	return &getUserLists{next: next, metrics: m}
+}
+
+func (uc *getUserLists) Execute(ctx context.Context, viewerID, ownerID string) ([]*domain.List, error) {
+	start := time.Now()
+	lists, err := uc.next.Execute(ctx, viewerID, ownerID)
+	uc.metrics.observeUseCase("get_user_lists", start, err)
+	return lists, err
+}
+
+type getUserTweets struct {
+	next    application.GetUserTweets
+	metrics *Metrics
+}
+
+func (m *Metrics) GetUserTweets(next application.GetUserTweets) application.GetUserTweets {
+	return &getUserTweets{next: next, metrics: m}
+}
+
+func (uc *getUserTweets) Execute(ctx context.Context, viewerID, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	start := time.Now()
+	tweets, err := uc.next.Execute(ctx, viewerID, userID, limit, offset)
+	uc.metrics.observeUseCase("get_user_tweets", start, err)
+	return tweets, err
+}
+
+type importUsers struct {
+	next    application.ImportUsers
+	metrics *Metrics
+}
+
+func (m *Metrics) ImportUsers(next application.ImportUsers) application.ImportUsers {
+	return &importUsers{next: next, metrics: m}
+}
+
+func (uc *importUsers) Execute(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, error) {
+	start := time.Now()
+	job, err := uc.next.Execute(ctx, r, options)
+	uc.metrics.observeUseCase("import_users", start, err)
+	return job, err
+}
+
+type indexTweets struct {
+	next    application.IndexTweets
+	metrics *Metrics
+}
+
+func (m *Metrics) IndexTweets(next application.IndexTweets) application.IndexTweets {
+	return &indexTweets{next: next, metrics: m}
+}
+
+func (uc *indexTweets) Execute(ctx context.Context, event domain.Event) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, event)
+	uc.metrics.observeUseCase("index_tweets", start, err)
+	return err
+}
+
+type listBlockedUsers struct {
+	next    application.ListBlockedUsers
+	metrics *Metrics
+}
+
+func (m *Metrics) ListBlockedUsers(next application.ListBlockedUsers) application.ListBlockedUsers {
+	return &listBlockedUsers{next: next, metrics: m}
+}
+
+func (uc *listBlockedUsers) Execute(ctx context.Context, userID string) ([]string, error) {
+	start := time.Now()
+	ids, err := uc.next.Execute(ctx, userID)
+	uc.metrics.observeUseCase("list_blocked_users", start, err)
+	return ids, err
+}
+
+type listMutedUsers struct {
+	next    application.ListMutedUsers
+	metrics *Metrics
+}
+
+func (m *Metrics) ListMutedUsers(next application.ListMutedUsers) application.ListMutedUsers {
+	return &listMutedUsers{next: next, metrics: m}
+}
+
+func (uc *listMutedUsers) Execute(ctx context.Context, userID string) ([]string, error) {
+	start := time.Now()
+	ids, err := uc.next.Execute(ctx, userID)
+	uc.metrics.observeUseCase("list_muted_users", start, err)
+	return ids, err
+}
+
+type markNotificationsRead struct {
+	next    application.MarkNotificationsRead
+	metrics *Metrics
+}
+
+func (m *Metrics) MarkNotificationsRead(next application.MarkNotificationsRead) application.MarkNotificationsRead {
+	return &markNotificationsRead{next: next, metrics: m}
+}
+
+func (uc *markNotificationsRead) Execute(ctx context.Context, userID string, ids []string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, ids)
+	uc.metrics.observeUseCase("mark_notifications_read", start, err)
+	return err
+}
+
+type muteUser struct {
+	next    application.MuteUser
+	metrics *Metrics
+}
+
+func (m *Metrics) MuteUser(next application.MuteUser) application.MuteUser {
+	return &muteUser{next: next, metrics: m}
+}
+
+func (uc *muteUser) Execute(ctx context.Context, userID, targetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, targetID)
+	uc.metrics.observeUseCase("mute_user", start, err)
+	return err
+}
+
+type removeBookmark struct {
+	next    application.RemoveBookmark
+	metrics *Metrics
+}
+
+func (m *Metrics) RemoveBookmark(next application.RemoveBookmark) application.RemoveBookmark {
+	return &removeBookmark{next: next, metrics: m}
+}
+
+func (uc *removeBookmark) Execute(ctx context.Context, userID, tweetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, tweetID)
+	uc.metrics.observeUseCase("remove_bookmark", start, err)
+	return err
+}
+
+type removeListMember struct {
+	next    application.RemoveListMember
+	metrics *Metrics
+}
+
+func (m *Metrics) RemoveListMember(next application.RemoveListMember) application.RemoveListMember {
+	return &removeListMember{next: next, metrics: m}
+}
+
+func (uc *removeListMember) Execute(ctx context.Context, userID, listID, memberID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, listID, memberID)
+	uc.metrics.observeUseCase("remove_list_member", start, err)
+	return err
+}
+
+type resolveFollowRequest struct {
+	next    application.ResolveFollowRequest
+	metrics *Metrics
+}
+
+func (m *Metrics) ResolveFollowRequest(next application.ResolveFollowRequest) application.ResolveFollowRequest {
+	return &resolveFollowRequest{next: next, metrics: m}
+}
+
+func (uc *resolveFollowRequest) Execute(ctx context.Context, userID, requestID string, status domain.FollowRequestStatus) (*domain.FollowRequest, error) {
+	start := time.Now()
+	request, err := uc.next.Execute(ctx, userID, requestID, status)
+	uc.metrics.observeUseCase("resolve_follow_request", start, err)
+	return request, err
+}
+
+type scheduleTweet struct {
+	next    application.ScheduleTweet
+	metrics *Metrics
+}
+
+func (m *Metrics) ScheduleTweet(next application.ScheduleTweet) application.ScheduleTweet {
+	return &scheduleTweet{next: next, metrics: m}
+}
+
+func (uc *scheduleTweet) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments, publishAt time.Time) (*domain.ScheduledTweet, error) {
+	start := time.Now()
+	tweet, err := uc.next.Execute(ctx, userID, content, attachments, publishAt)
+	uc.metrics.observeUseCase("schedule_tweet", start, err)
+	return tweet, err
+}
+
+type searchTweets struct {
+	next    application.SearchTweets
+	metrics *Metrics
+}
+
+func (m *Metrics) SearchTweets(next application.SearchTweets) application.SearchTweets {
+	return &searchTweets{next: next, metrics: m}
+}
+
+func (uc *searchTweets) Execute(ctx context.Context, q string, sort domain.SearchSort, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	start := time.Now()
+	tweets, cursor, err := uc.next.Execute(ctx, q, sort, limit, cursor)
+	uc.metrics.observeUseCase("search_tweets", start, err)
+	return tweets, cursor, err
+}
+
+type setAccountPrivacy struct {
+	next    application.SetAccountPrivacy
+	metrics *Metrics
+}
+
+func (m *Metrics) SetAccountPrivacy(next application.SetAccountPrivacy) application.SetAccountPrivacy {
+	return &setAccountPrivacy{next: next, metrics: m}
+}
+
+func (uc *setAccountPrivacy) Execute(ctx context.Context, userID string, protected bool) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, protected)
+	uc.metrics.observeUseCase("set_account_privacy", start, err)
+	return err
+}
+
+type suggestUsers struct {
+	next    application.SuggestUsers
+	metrics *Metrics
+}
+
+func (m *Metrics) SuggestUsers(next application.SuggestUsers) application.SuggestUsers {
+	return &suggestUsers{next: next, metrics: m}
+}
+
+func (uc *suggestUsers) Execute(ctx context.Context, userID string, limit int) ([]domain.Suggestion, error) {
+	start := time.Now()
+	suggestions, err := uc.next.Execute(ctx, userID, limit)
+	uc.metrics.observeUseCase("suggest_users", start, err)
+	return suggestions, err
+}
+
+type unblockUser struct {
+	next    application.UnblockUser
+	metrics *Metrics
+}
+
+func (m *Metrics) UnblockUser(next application.UnblockUser) application.UnblockUser {
+	return &unblockUser{next: next, metrics: m}
+}
+
+func (uc *unblockUser) Execute(ctx context.Context, userID, targetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, targetID)
+	uc.metrics.observeUseCase("unblock_user", start, err)
+	return err
+}
+
+type unmuteUser struct {
+	next    application.UnmuteUser
+	metrics *Metrics
+}
+
+func (m *Metrics) UnmuteUser(next application.UnmuteUser) application.UnmuteUser {
+	return &unmuteUser{next: next, metrics: m}
+}
+
+func (uc *unmuteUser) Execute(ctx context.Context, userID, targetID string) error {
+	start := time.Now()
+	err := uc.next.Execute(ctx, userID, targetID)
+	uc.metrics.observeUseCase("unmute_user", start, err)
+	return err
+}
+
+type updateList struct {
+	next    application.UpdateList
+	metrics *Metrics
+}
+
+func (m *Metrics) UpdateList(next application.UpdateList) application.UpdateList {
+	return &updateList{next: next, metrics: m}
+}
+
+func (uc *updateList) Execute(ctx context.Context, userID, listID string, name *string, private *bool) (*domain.List, error) {
+	start := time.Now()
+	list, err := uc.next.Execute(ctx, userID, listID, name, private)
+	uc.metrics.observeUseCase("update_list", start, err)
+	return list, err
+}
+
+type updateScheduledTweet struct {
+	next    application.UpdateScheduledTweet
+	metrics *Metrics
+}
+
+func (m *Metrics) UpdateScheduledTweet(next application.UpdateScheduledTweet) application.UpdateScheduledTweet {
+	return &updateScheduledTweet{next: next, metrics: m}
+}
+
+func (uc *updateScheduledTweet) Execute(ctx context.Context, userID, id string, content *string, publishAt *time.Time) (*domain.ScheduledTweet, error) {
+	start := time.Now()
+	tweet, err := uc.next.Execute(ctx, userID, id, content, publishAt)
+	uc.metrics.observeUseCase("update_scheduled_tweet", start, err)
+	return tweet, err
+}
+
+type uploadMedia struct {
+	next    application.UploadMedia
+	metrics *Metrics
+}
+
+func (m *Metrics) UploadMedia(next application.UploadMedia) application.UploadMedia {
+	return &uploadMedia{next: next, metrics: m}
+}
+
+func (uc *uploadMedia) Execute(ctx context.Context, userID string, data []byte) (*domain.Media, error) {
+	start := time.Now()
+	media, err := uc.next.Execute(ctx, userID, data)
+	uc.metrics.observeUseCase("upload_media", start, err)
+	return media, err
+}
+
+type votePoll struct {
+	next    application.VotePoll
+	metrics *Metrics
+}
+
+func (m *Metrics) VotePoll(next application.VotePoll) application.VotePoll {
+	return &votePoll{next: next, metrics: m}
+}
+
+func (uc *votePoll) Execute(ctx context.Context, userID, tweetID string, option int) (*domain.Tweet, error) {
+	start := time.Now()
+	tweet, err := uc.next.Execute(ctx, userID, tweetID, option)
+	uc.metrics.observeUseCase("vote_poll", start, err)
+	return tweet, err
+}
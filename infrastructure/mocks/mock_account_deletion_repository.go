@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: AccountDeletionRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockAccountDeletionRepository is a mock of AccountDeletionRepository interface.
+type MockAccountDeletionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountDeletionRepositoryMockRecorder
+}
+
+// MockAccountDeletionRepositoryMockRecorder is the mock recorder for MockAccountDeletionRepository.
+type MockAccountDeletionRepositoryMockRecorder struct {
+	mock *MockAccountDeletionRepository
+}
+
+// NewMockAccountDeletionRepository creates a new mock instance.
+func NewMockAccountDeletionRepository(ctrl *gomock.Controller) *MockAccountDeletionRepository {
+	mock := &MockAccountDeletionRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountDeletionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountDeletionRepository) EXPECT() *MockAccountDeletionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockAccountDeletionRepository) ClaimDue(arg0 context.Context, arg1 string, arg2, arg3 time.Time, arg4 int) ([]*domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockAccountDeletionRepositoryMockRecorder) ClaimDue(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockAccountDeletionRepository)(nil).ClaimDue), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindByUserID mocks base method.
+func (m *MockAccountDeletionRepository) FindByUserID(arg0 context.Context, arg1 string) (*domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockAccountDeletionRepositoryMockRecorder) FindByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockAccountDeletionRepository)(nil).FindByUserID), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockAccountDeletionRepository) Save(arg0 context.Context, arg1 *domain.AccountDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAccountDeletionRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountDeletionRepository)(nil).Save), arg0, arg1)
+}
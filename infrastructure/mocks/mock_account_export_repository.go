@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: AccountExportRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockAccountExportRepository is a mock of AccountExportRepository interface.
+type MockAccountExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountExportRepositoryMockRecorder
+}
+
+// MockAccountExportRepositoryMockRecorder is the mock recorder for MockAccountExportRepository.
+type MockAccountExportRepositoryMockRecorder struct {
+	mock *MockAccountExportRepository
+}
+
+// NewMockAccountExportRepository creates a new mock instance.
+func NewMockAccountExportRepository(ctrl *gomock.Controller) *MockAccountExportRepository {
+	mock := &MockAccountExportRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountExportRepository) EXPECT() *MockAccountExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAccountExportRepository) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccountExportRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountExportRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockAccountExportRepository) FindByID(arg0 context.Context, arg1 string) (*domain.AccountExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AccountExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAccountExportRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAccountExportRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByUserID mocks base method.
+func (m *MockAccountExportRepository) FindByUserID(arg0 context.Context, arg1 string) ([]*domain.AccountExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.AccountExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockAccountExportRepositoryMockRecorder) FindByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockAccountExportRepository)(nil).FindByUserID), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockAccountExportRepository) Save(arg0 context.Context, arg1 *domain.AccountExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAccountExportRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountExportRepository)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: BookmarkRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockBookmarkRepository is a mock of BookmarkRepository interface.
+type MockBookmarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkRepositoryMockRecorder
+}
+
+// MockBookmarkRepositoryMockRecorder is the mock recorder for MockBookmarkRepository.
+type MockBookmarkRepositoryMockRecorder struct {
+	mock *MockBookmarkRepository
+}
+
+// NewMockBookmarkRepository creates a new mock instance.
+func NewMockBookmarkRepository(ctrl *gomock.Controller) *MockBookmarkRepository {
+	mock := &MockBookmarkRepository{ctrl: ctrl}
+	mock.recorder = &MockBookmarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkRepository) EXPECT() *MockBookmarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBookmarkRepository) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookmarkRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookmarkRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockBookmarkRepository) DeleteByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockBookmarkRepositoryMockRecorder) DeleteByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockBookmarkRepository)(nil).DeleteByUserID), arg0, arg1)
+}
+
+// Find mocks base method.
+func (m *MockBookmarkRepository) Find(arg0 context.Context, arg1, arg2 string) (*domain.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockBookmarkRepositoryMockRecorder) Find(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBookmarkRepository)(nil).Find), arg0, arg1, arg2)
+}
+
+// FindByUserID mocks base method.
+func (m *MockBookmarkRepository) FindByUserID(arg0 context.Context, arg1 string, arg2 *domain.BookmarkCursor, arg3 int) ([]*domain.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockBookmarkRepositoryMockRecorder) FindByUserID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockBookmarkRepository)(nil).FindByUserID), arg0, arg1, arg2, arg3)
+}
+
+// Save mocks base method.
+func (m *MockBookmarkRepository) Save(arg0 context.Context, arg1 *domain.Bookmark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBookmarkRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBookmarkRepository)(nil).Save), arg0, arg1)
+}
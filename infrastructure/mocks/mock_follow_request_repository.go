@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: FollowRequestRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockFollowRequestRepository is a mock of FollowRequestRepository interface.
+type MockFollowRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRequestRepositoryMockRecorder
+}
+
+// MockFollowRequestRepositoryMockRecorder is the mock recorder for MockFollowRequestRepository.
+type MockFollowRequestRepositoryMockRecorder struct {
+	mock *MockFollowRequestRepository
+}
+
+// NewMockFollowRequestRepository creates a new mock instance.
+func NewMockFollowRequestRepository(ctrl *gomock.Controller) *MockFollowRequestRepository {
+	mock := &MockFollowRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowRequestRepository) EXPECT() *MockFollowRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockFollowRequestRepository) DeleteByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockFollowRequestRepositoryMockRecorder) DeleteByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockFollowRequestRepository)(nil).DeleteByUserID), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockFollowRequestRepository) FindByID(arg0 context.Context, arg1 string) (*domain.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockFollowRequestRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockFollowRequestRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindPending mocks base method.
+func (m *MockFollowRequestRepository) FindPending(arg0 context.Context, arg1, arg2 string) (*domain.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockFollowRequestRepositoryMockRecorder) FindPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockFollowRequestRepository)(nil).FindPending), arg0, arg1, arg2)
+}
+
+// FindPendingByFolloweeID mocks base method.
+func (m *MockFollowRequestRepository) FindPendingByFolloweeID(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByFolloweeID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByFolloweeID indicates an expected call of FindPendingByFolloweeID.
+func (mr *MockFollowRequestRepositoryMockRecorder) FindPendingByFolloweeID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByFolloweeID", reflect.TypeOf((*MockFollowRequestRepository)(nil).FindPendingByFolloweeID), arg0, arg1, arg2, arg3)
+}
+
+// Save mocks base method.
+func (m *MockFollowRequestRepository) Save(arg0 context.Context, arg1 *domain.FollowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockFollowRequestRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFollowRequestRepository)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: ImportJobRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockImportJobRepository is a mock of ImportJobRepository interface.
+type MockImportJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportJobRepositoryMockRecorder
+}
+
+// MockImportJobRepositoryMockRecorder is the mock recorder for MockImportJobRepository.
+type MockImportJobRepositoryMockRecorder struct {
+	mock *MockImportJobRepository
+}
+
+// NewMockImportJobRepository creates a new mock instance.
+func NewMockImportJobRepository(ctrl *gomock.Controller) *MockImportJobRepository {
+	mock := &MockImportJobRepository{ctrl: ctrl}
+	mock.recorder = &MockImportJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportJobRepository) EXPECT() *MockImportJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockImportJobRepository) FindByID(arg0 context.Context, arg1 string) (*domain.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockImportJobRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockImportJobRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindRows mocks base method.
+func (m *MockImportJobRepository) FindRows(arg0 context.Context, arg1 string, arg2, arg3 int) ([]domain.ImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRows", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]domain.ImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRows indicates an expected call of FindRows.
+func (mr *MockImportJobRepositoryMockRecorder) FindRows(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRows", reflect.TypeOf((*MockImportJobRepository)(nil).FindRows), arg0, arg1, arg2, arg3)
+}
+
+// Save mocks base method.
+func (m *MockImportJobRepository) Save(arg0 context.Context, arg1 *domain.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockImportJobRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockImportJobRepository)(nil).Save), arg0, arg1)
+}
+
+// SaveRows mocks base method.
+func (m *MockImportJobRepository) SaveRows(arg0 context.Context, arg1 []domain.ImportRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRows", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRows indicates an expected call of SaveRows.
+func (mr *MockImportJobRepositoryMockRecorder) SaveRows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRows", reflect.TypeOf((*MockImportJobRepository)(nil).SaveRows), arg0, arg1)
+}
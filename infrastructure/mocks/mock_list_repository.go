@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: ListRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockListRepository is a mock of ListRepository interface.
+type MockListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRepositoryMockRecorder
+}
+
+// MockListRepositoryMockRecorder is the mock recorder for MockListRepository.
+type MockListRepositoryMockRecorder struct {
+	mock *MockListRepository
+}
+
+// NewMockListRepository creates a new mock instance.
+func NewMockListRepository(ctrl *gomock.Controller) *MockListRepository {
+	mock := &MockListRepository{ctrl: ctrl}
+	mock.recorder = &MockListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRepository) EXPECT() *MockListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockListRepository) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockListRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockListRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockListRepository) FindByID(arg0 context.Context, arg1 string) (*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockListRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockListRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByOwnerID mocks base method.
+func (m *MockListRepository) FindByOwnerID(arg0 context.Context, arg1 string) ([]*domain.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwnerID", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwnerID indicates an expected call of FindByOwnerID.
+func (mr *MockListRepositoryMockRecorder) FindByOwnerID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwnerID", reflect.TypeOf((*MockListRepository)(nil).FindByOwnerID), arg0, arg1)
+}
+
+// RemoveMember mocks base method.
+func (m *MockListRepository) RemoveMember(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockListRepositoryMockRecorder) RemoveMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockListRepository)(nil).RemoveMember), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockListRepository) Save(arg0 context.Context, arg1 *domain.List) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockListRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockListRepository)(nil).Save), arg0, arg1)
+}
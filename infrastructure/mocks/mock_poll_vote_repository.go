@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: PollVoteRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockPollVoteRepository is a mock of PollVoteRepository interface.
+type MockPollVoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPollVoteRepositoryMockRecorder
+}
+
+// MockPollVoteRepositoryMockRecorder is the mock recorder for MockPollVoteRepository.
+type MockPollVoteRepositoryMockRecorder struct {
+	mock *MockPollVoteRepository
+}
+
+// NewMockPollVoteRepository creates a new mock instance.
+func NewMockPollVoteRepository(ctrl *gomock.Controller) *MockPollVoteRepository {
+	mock := &MockPollVoteRepository{ctrl: ctrl}
+	mock.recorder = &MockPollVoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPollVoteRepository) EXPECT() *MockPollVoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockPollVoteRepository) DeleteByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockPollVoteRepositoryMockRecorder) DeleteByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockPollVoteRepository)(nil).DeleteByUserID), arg0, arg1)
+}
+
+// FindByUserID mocks base method.
+func (m *MockPollVoteRepository) FindByUserID(arg0 context.Context, arg1 string, arg2 []string) (map[string]*domain.PollVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]*domain.PollVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockPollVoteRepositoryMockRecorder) FindByUserID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockPollVoteRepository)(nil).FindByUserID), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockPollVoteRepository) Save(arg0 context.Context, arg1 *domain.PollVote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPollVoteRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPollVoteRepository)(nil).Save), arg0, arg1)
+}
+
+// Tally mocks base method.
+func (m *MockPollVoteRepository) Tally(arg0 context.Context, arg1 []string) (map[string]map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tally", arg0, arg1)
+	ret0, _ := ret[0].(map[string]map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tally indicates an expected call of Tally.
+func (mr *MockPollVoteRepositoryMockRecorder) Tally(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tally", reflect.TypeOf((*MockPollVoteRepository)(nil).Tally), arg0, arg1)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,15 +35,15 @@ func (m *MockQueue) EXPECT() *MockQueueMockRecorder {
 }
 
 // WriteMessage mocks base method.
-func (m *MockQueue) WriteMessage(arg0 []byte) error {
+func (m *MockQueue) WriteMessage(arg0 context.Context, arg1 []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteMessage", arg0)
+	ret := m.ctrl.Call(m, "WriteMessage", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // WriteMessage indicates an expected call of WriteMessage.
-func (mr *MockQueueMockRecorder) WriteMessage(arg0 interface{}) *gomock.Call {
+func (mr *MockQueueMockRecorder) WriteMessage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessage", reflect.TypeOf((*MockQueue)(nil).WriteMessage), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessage", reflect.TypeOf((*MockQueue)(nil).WriteMessage), arg0, arg1)
 }
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure/repositories (interfaces: ScheduledTweetRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockScheduledTweetRepository is a mock of ScheduledTweetRepository interface.
+type MockScheduledTweetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTweetRepositoryMockRecorder
+}
+
+// MockScheduledTweetRepositoryMockRecorder is the mock recorder for MockScheduledTweetRepository.
+type MockScheduledTweetRepositoryMockRecorder struct {
+	mock *MockScheduledTweetRepository
+}
+
+// NewMockScheduledTweetRepository creates a new mock instance.
+func NewMockScheduledTweetRepository(ctrl *gomock.Controller) *MockScheduledTweetRepository {
+	mock := &MockScheduledTweetRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledTweetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTweetRepository) EXPECT() *MockScheduledTweetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockScheduledTweetRepository) ClaimDue(arg0 context.Context, arg1 string, arg2, arg3 time.Time, arg4 int) ([]*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockScheduledTweetRepositoryMockRecorder) ClaimDue(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockScheduledTweetRepository)(nil).ClaimDue), arg0, arg1, arg2, arg3, arg4)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockScheduledTweetRepository) DeleteByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockScheduledTweetRepositoryMockRecorder) DeleteByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockScheduledTweetRepository)(nil).DeleteByUserID), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockScheduledTweetRepository) FindByID(arg0 context.Context, arg1 string) (*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockScheduledTweetRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockScheduledTweetRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindPendingByUserID mocks base method.
+func (m *MockScheduledTweetRepository) FindPendingByUserID(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.ScheduledTweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.ScheduledTweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByUserID indicates an expected call of FindPendingByUserID.
+func (mr *MockScheduledTweetRepositoryMockRecorder) FindPendingByUserID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByUserID", reflect.TypeOf((*MockScheduledTweetRepository)(nil).FindPendingByUserID), arg0, arg1, arg2, arg3)
+}
+
+// Save mocks base method.
+func (m *MockScheduledTweetRepository) Save(arg0 context.Context, arg1 *domain.ScheduledTweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockScheduledTweetRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockScheduledTweetRepository)(nil).Save), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockScheduledTweetRepository) Update(arg0 context.Context, arg1 *domain.ScheduledTweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockScheduledTweetRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockScheduledTweetRepository)(nil).Update), arg0, arg1)
+}
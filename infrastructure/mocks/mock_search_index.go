@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/pedro00627/urblog/infrastructure (interfaces: SearchIndex)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/pedro00627/urblog/domain"
+)
+
+// MockSearchIndex is a mock of SearchIndex interface.
+type MockSearchIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchIndexMockRecorder
+}
+
+// MockSearchIndexMockRecorder is the mock recorder for MockSearchIndex.
+type MockSearchIndexMockRecorder struct {
+	mock *MockSearchIndex
+}
+
+// NewMockSearchIndex creates a new mock instance.
+func NewMockSearchIndex(ctrl *gomock.Controller) *MockSearchIndex {
+	mock := &MockSearchIndex{ctrl: ctrl}
+	mock.recorder = &MockSearchIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchIndex) EXPECT() *MockSearchIndexMockRecorder {
+	return m.recorder
+}
+
+// Index mocks base method.
+func (m *MockSearchIndex) Index(arg0 context.Context, arg1 *domain.Tweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Index indicates an expected call of Index.
+func (mr *MockSearchIndexMockRecorder) Index(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockSearchIndex)(nil).Index), arg0, arg1)
+}
+
+// RemoveByUserID mocks base method.
+func (m *MockSearchIndex) RemoveByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveByUserID indicates an expected call of RemoveByUserID.
+func (mr *MockSearchIndexMockRecorder) RemoveByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveByUserID", reflect.TypeOf((*MockSearchIndex)(nil).RemoveByUserID), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockSearchIndex) Search(arg0 context.Context, arg1 domain.SearchQuery) ([]domain.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]domain.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchIndexMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchIndex)(nil).Search), arg0, arg1)
+}
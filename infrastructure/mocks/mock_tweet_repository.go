@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,31 +35,60 @@ func (m *MockTweetRepository) EXPECT() *MockTweetRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteByUserID mocks base method.
+func (m *MockTweetRepository) DeleteByUserID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockTweetRepositoryMockRecorder) DeleteByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockTweetRepository)(nil).DeleteByUserID), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockTweetRepository) FindByID(arg0 context.Context, arg1 string) (*domain.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTweetRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTweetRepository)(nil).FindByID), arg0, arg1)
+}
+
 // FindByUserID mocks base method.
-func (m *MockTweetRepository) FindByUserID(arg0 string, arg1, arg2 int) ([]*domain.Tweet, error) {
+func (m *MockTweetRepository) FindByUserID(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*domain.Tweet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*domain.Tweet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserID indicates an expected call of FindByUserID.
-func (mr *MockTweetRepositoryMockRecorder) FindByUserID(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockTweetRepositoryMockRecorder) FindByUserID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockTweetRepository)(nil).FindByUserID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockTweetRepository)(nil).FindByUserID), arg0, arg1, arg2, arg3)
 }
 
 // Save mocks base method.
-func (m *MockTweetRepository) Save(arg0 *domain.Tweet) error {
+func (m *MockTweetRepository) Save(arg0 context.Context, arg1 *domain.Tweet) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0)
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockTweetRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+func (mr *MockTweetRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTweetRepository)(nil).Save), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTweetRepository)(nil).Save), arg0, arg1)
 }
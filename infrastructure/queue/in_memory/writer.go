@@ -1,5 +1,7 @@
 package in_memory
 
+import "context"
+
 type InMemoryQueue struct {
 	messages []string
 }
@@ -10,7 +12,7 @@ func NewInMemoryQueue() *InMemoryQueue {
 	}
 }
 
-func (w *InMemoryQueue) WriteMessage(message []byte) error {
+func (w *InMemoryQueue) WriteMessage(ctx context.Context, message []byte) error {
 	w.messages = append(w.messages, string(message))
 	return nil
 }
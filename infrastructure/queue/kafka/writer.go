@@ -2,35 +2,108 @@ package kafka
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"time"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
 )
 
+// propagator writes the trace context of a message into its headers, in the
+// W3C traceparent format.
+var propagator = propagation.TraceContext{}
+
+// writeTimeout bounds how long WriteMessage waits for the broker.
+const writeTimeout = 10 * time.Second
+
 type Writer struct {
-	writer *kafka.Writer
+	writer       *kafka.Writer
+	logger       *slog.Logger
+	writeTimeout time.Duration
 }
 
-func NewWriter(broker string) *Writer {
+func NewWriter(broker string, logger *slog.Logger) *Writer {
 	return &Writer{
 		writer: &kafka.Writer{
 			Addr:     kafka.TCP(broker),
 			Topic:    "tweets",
 			Balancer: &kafka.LeastBytes{},
 		},
+		logger:       logger,
+		writeTimeout: writeTimeout,
 	}
 }
 
-func (kw *Writer) WriteMessage(message []byte) error {
-	err := kw.writer.WriteMessages(context.Background(),
-		kafka.Message{
-			Key:   []byte("Key"),
-			Value: message,
-		},
-	)
+// WriteMessage writes message with the span in ctx in its headers, so that
+// its consumer can continue the trace with ExtractTraceContext. The write is
+// not cancelled with ctx, since what the message reports is already saved
+// by then, but gives up after writeTimeout.
+func (kw *Writer) WriteMessage(ctx context.Context, message []byte) error {
+	msg := kafka.Message{
+		Key:   []byte("Key"),
+		Value: message,
+	}
+	propagator.Inject(ctx, headerCarrier{message: &msg})
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), kw.writeTimeout)
+	defer cancel()
+	err := kw.writer.WriteMessages(writeCtx, msg)
 	if err != nil {
-		log.Println("Error writing message to Kafka:", err)
+		kw.logger.ErrorContext(ctx, "writing message to Kafka failed", "error", err)
 		return err
 	}
 	return nil
 }
+
+// Ping reports whether the broker accepts connections.
+func (kw *Writer) Ping(ctx context.Context) error {
+	conn, err := (&kafka.Dialer{}).DialContext(ctx, "tcp", kw.writer.Addr.String())
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Close writes the messages still pending and releases the connections of the
+// writer.
+func (kw *Writer) Close() error {
+	return kw.writer.Close()
+}
+
+// ExtractTraceContext returns ctx with the trace context in the headers of
+// message, so that spans started from it continue the trace of the request
+// that wrote the message.
+func ExtractTraceContext(ctx context.Context, message kafka.Message) context.Context {
+	return propagator.Extract(ctx, headerCarrier{message: &message})
+}
+
+// headerCarrier reads and writes trace context in the headers of a message.
+type headerCarrier struct {
+	message *kafka.Message
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range c.message.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range c.message.Headers {
+		if header.Key == key {
+			c.message.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.message.Headers = append(c.message.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, len(c.message.Headers))
+	for i, header := range c.message.Headers {
+		keys[i] = header.Key
+	}
+	return keys
+}
@@ -0,0 +1,66 @@
+package kafka
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContextTravelsInHeaders(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	msg := kafka.Message{Value: []byte("tweet"), Headers: []kafka.Header{{Key: "traceparent", Value: []byte("stale")}}}
+
+	propagator.Inject(ctx, headerCarrier{message: &msg})
+
+	assert.Equal(t, []kafka.Header{{Key: "traceparent", Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")}}, msg.Headers)
+	consumed := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), msg))
+	assert.Equal(t, traceID, consumed.TraceID())
+	assert.Equal(t, spanID, consumed.SpanID())
+	assert.True(t, consumed.IsRemote())
+}
+
+func TestExtractTraceContextWithoutHeaders(t *testing.T) {
+	ctx := ExtractTraceContext(context.Background(), kafka.Message{Value: []byte("tweet")})
+
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
+
+func TestWriteMessageOutlivesTheRequest(t *testing.T) {
+	// The broker accepts connections but never answers, so writes only end
+	// when their context does.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	writer := NewWriter(listener.Addr().String(), slog.New(slog.DiscardHandler))
+	writer.writeTimeout = 50 * time.Millisecond
+	defer writer.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = writer.WriteMessage(ctx, []byte("tweet"))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,39 @@
+package in_memory
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kljensen/snowball/english"
+	"github.com/kljensen/snowball/spanish"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// tokenize splits text into lowercase words.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '_'
+	})
+}
+
+// fold removes diacritics, so that "canción" and "cancion" compare equal.
+func fold(word string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), word)
+	if err != nil {
+		return word
+	}
+	return folded
+}
+
+// stems returns the accent-folded Spanish and English stems of a lowercase
+// word. Tweets carry no language, so every word is indexed under both.
+func stems(word string) []string {
+	es := fold(spanish.Stem(word, true))
+	en := fold(english.Stem(fold(word), true))
+	if es == en {
+		return []string{es}
+	}
+	return []string{es, en}
+}
@@ -0,0 +1,222 @@
+package in_memory
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/pedro00627/urblog/domain"
+)
+
+type document struct {
+	tweet *domain.Tweet
+	// words are the folded words of the content, in order, for phrase matching.
+	words    []string
+	terms    map[string]int
+	hashtags map[string]bool
+}
+
+// Index is an in-process inverted index over tweet content, with Spanish and
+// English stemming and accent folding.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[string]*document
+	postings map[string]map[string]struct{}
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+func (i *Index) Index(ctx context.Context, tweet *domain.Tweet) error {
+	doc := &document{
+		tweet:    tweet,
+		terms:    make(map[string]int),
+		hashtags: make(map[string]bool),
+	}
+	for _, word := range tokenize(tweet.Content) {
+		doc.words = append(doc.words, fold(word))
+		for _, stem := range stems(word) {
+			doc.terms[stem]++
+		}
+	}
+	for _, entity := range tweet.Entities {
+		if entity.Type == domain.EntityHashtag {
+			doc.hashtags[fold(strings.ToLower(entity.Name()))] = true
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.remove(tweet.ID)
+	i.docs[tweet.ID] = doc
+	for stem := range doc.terms {
+		if i.postings[stem] == nil {
+			i.postings[stem] = make(map[string]struct{})
+		}
+		i.postings[stem][tweet.ID] = struct{}{}
+	}
+	return nil
+}
+
+func (i *Index) Search(ctx context.Context, query domain.SearchQuery) ([]domain.SearchResult, error) {
+	var words []string
+	for _, term := range query.Terms {
+		words = append(words, tokenize(term)...)
+	}
+	var phrases [][]string
+	for _, phrase := range query.Phrases {
+		phraseWords := tokenize(phrase)
+		words = append(words, phraseWords...)
+		for j := range phraseWords {
+			phraseWords[j] = fold(phraseWords[j])
+		}
+		phrases = append(phrases, phraseWords)
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var results []domain.SearchResult
+	for id := range i.candidates(words) {
+		doc := i.docs[id]
+		if !i.matches(doc, query, phrases) {
+			continue
+		}
+		score := i.score(doc, words)
+		if query.After != nil && query.After.Covers(query.Sort, score, doc.tweet.Timestamp, id) {
+			continue
+		}
+		results = append(results, domain.SearchResult{Tweet: doc.tweet, Score: score})
+	}
+
+	domain.SortSearchResults(results, query.Sort)
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
+
+// candidates returns the documents containing every word, or all documents
+// when there are no words.
+func (i *Index) candidates(words []string) map[string]struct{} {
+	if len(words) == 0 {
+		all := make(map[string]struct{}, len(i.docs))
+		for id := range i.docs {
+			all[id] = struct{}{}
+		}
+		return all
+	}
+
+	var result map[string]struct{}
+	for _, word := range words {
+		matching := make(map[string]struct{})
+		for _, stem := range stems(word) {
+			for id := range i.postings[stem] {
+				if result == nil {
+					matching[id] = struct{}{}
+				} else if _, ok := result[id]; ok {
+					matching[id] = struct{}{}
+				}
+			}
+		}
+		result = matching
+		if len(result) == 0 {
+			break
+		}
+	}
+	return result
+}
+
+func (i *Index) matches(doc *document, query domain.SearchQuery, phrases [][]string) bool {
+	if query.FromUserID != "" && doc.tweet.UserID != query.FromUserID {
+		return false
+	}
+	if !query.Since.IsZero() && doc.tweet.Timestamp.Before(query.Since) {
+		return false
+	}
+	if !query.Until.IsZero() && !doc.tweet.Timestamp.Before(query.Until) {
+		return false
+	}
+	for _, hashtag := range query.Hashtags {
+		if !doc.hashtags[fold(strings.ToLower(hashtag))] {
+			return false
+		}
+	}
+	for _, phrase := range phrases {
+		if !containsSequence(doc.words, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+// score is a length-normalized TF-IDF over the query words.
+func (i *Index) score(doc *document, words []string) float64 {
+	if len(doc.words) == 0 {
+		return 0
+	}
+	total := float64(len(i.docs))
+	score := 0.0
+	for _, word := range words {
+		best := 0.0
+		for _, stem := range stems(word) {
+			tf := float64(doc.terms[stem])
+			if tf == 0 {
+				continue
+			}
+			idf := math.Log(1 + total/float64(len(i.postings[stem])))
+			best = math.Max(best, tf*idf)
+		}
+		score += best
+	}
+	return score / math.Sqrt(float64(len(doc.words)))
+}
+
+func (i *Index) RemoveByUserID(ctx context.Context, userID string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for id, doc := range i.docs {
+		if doc.tweet.UserID == userID {
+			i.remove(id)
+		}
+	}
+	return nil
+}
+
+func (i *Index) remove(id string) {
+	doc, ok := i.docs[id]
+	if !ok {
+		return
+	}
+	for stem := range doc.terms {
+		delete(i.postings[stem], id)
+		if len(i.postings[stem]) == 0 {
+			delete(i.postings, stem)
+		}
+	}
+	delete(i.docs, id)
+}
+
+func containsSequence(words, sequence []string) bool {
+	if len(sequence) == 0 {
+		return true
+	}
+	for start := 0; start+len(sequence) <= len(words); start++ {
+		match := true
+		for j := range sequence {
+			if words[start+j] != sequence[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package in_memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex_Search(t *testing.T) {
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	tweet := func(id, userID, content string, age time.Duration) *domain.Tweet {
+		return &domain.Tweet{
+			ID:        id,
+			UserID:    userID,
+			Content:   content,
+			Entities:  domain.ExtractEntities(content),
+			Timestamp: now.Add(-age),
+		}
+	}
+
+	index := NewIndex()
+	for _, tw := range []*domain.Tweet{
+		tweet("t1", "user1", "Publicamos una nueva canción #Música", 4*time.Hour),
+		tweet("t2", "user2", "Las canciones de hoy y las cancion de ayer", 3*time.Hour),
+		tweet("t3", "user1", "Running the hexagonal architecture workshop", 2*time.Hour),
+		tweet("t4", "user2", "I ran a workshop about architecture hexagonal", time.Hour),
+	} {
+		assert.NoError(t, index.Index(context.Background(), tw))
+	}
+
+	ids := func(results []domain.SearchResult) []string {
+		var ids []string
+		for _, result := range results {
+			ids = append(ids, result.Tweet.ID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name  string
+		query domain.SearchQuery
+		want  []string
+	}{
+		{
+			name:  "spanish stemming and accent folding",
+			query: domain.SearchQuery{Terms: []string{"cancion"}},
+			want:  []string{"t2", "t1"},
+		},
+		{
+			name:  "english stemming",
+			query: domain.SearchQuery{Terms: []string{"workshops", "run"}},
+			want:  []string{"t3"},
+		},
+		{
+			name:  "phrase",
+			query: domain.SearchQuery{Phrases: []string{"hexagonal architecture"}},
+			want:  []string{"t3"},
+		},
+		{
+			name:  "hashtag ignores case and accents",
+			query: domain.SearchQuery{Hashtags: []string{"musica"}},
+			want:  []string{"t1"},
+		},
+		{
+			name:  "from user",
+			query: domain.SearchQuery{Terms: []string{"architecture"}, FromUserID: "user2"},
+			want:  []string{"t4"},
+		},
+		{
+			name:  "date range",
+			query: domain.SearchQuery{FromUserID: "user1", Since: now.Add(-5 * time.Hour), Until: now.Add(-3 * time.Hour)},
+			want:  []string{"t1"},
+		},
+		{
+			name:  "relevance",
+			query: domain.SearchQuery{Terms: []string{"cancion"}, Sort: domain.SearchByRelevance},
+			want:  []string{"t2", "t1"},
+		},
+		{
+			name:  "no match",
+			query: domain.SearchQuery{Terms: []string{"kafka"}},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := index.Search(context.Background(), tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ids(results))
+		})
+	}
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		query := domain.SearchQuery{Terms: []string{"architecture"}, Limit: 1}
+		first, err := index.Search(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"t4"}, ids(first))
+
+		query.After = &domain.SearchCursor{Timestamp: first[0].Tweet.Timestamp, TweetID: first[0].Tweet.ID}
+		second, err := index.Search(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"t3"}, ids(second))
+
+		query.After = &domain.SearchCursor{Timestamp: second[0].Tweet.Timestamp, TweetID: second[0].Tweet.ID}
+		third, err := index.Search(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Empty(t, third)
+	})
+
+	t.Run("reindexing replaces the document", func(t *testing.T) {
+		assert.NoError(t, index.Index(context.Background(), tweet("t3", "user1", "Cancelled", 2*time.Hour)))
+		results, err := index.Search(context.Background(), domain.SearchQuery{Phrases: []string{"hexagonal architecture"}})
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestIndex_RemoveByUserID(t *testing.T) {
+	index := NewIndex()
+	for _, tw := range []*domain.Tweet{
+		{ID: "t1", UserID: "user1", Content: "hola mundo"},
+		{ID: "t2", UserID: "user2", Content: "hola a todos"},
+	} {
+		assert.NoError(t, index.Index(context.Background(), tw))
+	}
+
+	assert.NoError(t, index.RemoveByUserID(context.Background(), "user1"))
+
+	results, err := index.Search(context.Background(), domain.SearchQuery{Terms: []string{"hola"}, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "t2", results[0].Tweet.ID)
+}
@@ -0,0 +1,141 @@
+package mongo
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pedro00627/urblog/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Index searches the tweets collection through a MongoDB text index. Plain
+// terms follow MongoDB's text search semantics: any of them matches and
+// documents matching more rank higher. Phrases are required.
+type Index struct {
+	collection *mongo.Collection
+}
+
+func NewIndex(db *mongo.Database) *Index {
+	return &Index{
+		collection: db.Collection("tweets"),
+	}
+}
+
+// EnsureIndexes creates the text index on tweet content if it is missing.
+func (i *Index) EnsureIndexes(ctx context.Context) error {
+	_, err := i.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "content", Value: "text"}},
+		Options: options.Index().SetName("content_text").SetDefaultLanguage("spanish"),
+	})
+	return err
+}
+
+// Index is a no-op: tweets are saved by the tweet repository into the
+// collection that the text index covers.
+func (i *Index) Index(context.Context, *domain.Tweet) error {
+	return nil
+}
+
+// RemoveByUserID is a no-op: the tweet repository deletes the tweets from
+// the collection that the text index covers.
+func (i *Index) RemoveByUserID(context.Context, string) error {
+	return nil
+}
+
+func (i *Index) Search(ctx context.Context, query domain.SearchQuery) ([]domain.SearchResult, error) {
+	match := bson.M{}
+	search := textSearch(query)
+	if search != "" {
+		match["$text"] = bson.M{"$search": search}
+	}
+	if query.FromUserID != "" {
+		match["userid"] = query.FromUserID
+	}
+	timestamp := bson.M{}
+	if !query.Since.IsZero() {
+		timestamp["$gte"] = query.Since
+	}
+	if !query.Until.IsZero() {
+		timestamp["$lt"] = query.Until
+	}
+	if len(timestamp) > 0 {
+		match["timestamp"] = timestamp
+	}
+	if len(query.Hashtags) > 0 {
+		var hashtags []bson.M
+		for _, hashtag := range query.Hashtags {
+			hashtags = append(hashtags, bson.M{"$elemMatch": bson.M{
+				"type": domain.EntityHashtag,
+				"text": bson.M{"$regex": "^#" + regexp.QuoteMeta(hashtag) + "$", "$options": "i"},
+			}})
+		}
+		match["entities"] = bson.M{"$all": hashtags}
+	}
+
+	score := bson.M{"$literal": 0}
+	if search != "" {
+		score = bson.M{"$meta": "textScore"}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"score": score}}},
+	}
+	if query.After != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: afterCursor(query)}})
+	}
+	sort := bson.D{{Key: "timestamp", Value: -1}, {Key: "id", Value: -1}}
+	if query.Sort == domain.SearchByRelevance {
+		sort = append(bson.D{{Key: "score", Value: -1}}, sort...)
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: sort}})
+	if query.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: query.Limit}})
+	}
+
+	cursor, err := i.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []domain.SearchResult
+	for cursor.Next(ctx) {
+		var doc struct {
+			domain.Tweet `bson:",inline"`
+			Score        float64 `bson:"score"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		tweet := doc.Tweet
+		results = append(results, domain.SearchResult{Tweet: &tweet, Score: doc.Score})
+	}
+	return results, cursor.Err()
+}
+
+func textSearch(query domain.SearchQuery) string {
+	parts := append([]string{}, query.Terms...)
+	for _, phrase := range query.Phrases {
+		parts = append(parts, `"`+strings.ReplaceAll(phrase, `"`, "")+`"`)
+	}
+	return strings.Join(parts, " ")
+}
+
+// afterCursor matches the results that sort after query.After.
+func afterCursor(query domain.SearchQuery) bson.M {
+	after := query.After
+	older := []bson.M{
+		{"timestamp": bson.M{"$lt": after.Timestamp}},
+		{"timestamp": after.Timestamp, "id": bson.M{"$lt": after.TweetID}},
+	}
+	if query.Sort != domain.SearchByRelevance {
+		return bson.M{"$or": older}
+	}
+	return bson.M{"$or": []bson.M{
+		{"score": bson.M{"$lt": after.Score}},
+		{"score": after.Score, "$or": older},
+	}}
+}
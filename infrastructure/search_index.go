@@ -0,0 +1,16 @@
+package infrastructure
+
+import (
+	"context"
+	"github.com/pedro00627/urblog/domain"
+)
+
+//go:generate mockgen -destination=./mocks/mock_search_index.go -package=mocks github.com/pedro00627/urblog/infrastructure SearchIndex
+type SearchIndex interface {
+	Index(ctx context.Context, tweet *domain.Tweet) error
+	// Search returns up to query.Limit results after query.After, in the
+	// order given by query.Sort.
+	Search(ctx context.Context, query domain.SearchQuery) ([]domain.SearchResult, error)
+	// RemoveByUserID removes the tweets of userID from the index.
+	RemoveByUserID(ctx context.Context, userID string) error
+}
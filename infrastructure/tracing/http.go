@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pedro00627/urblog/infrastructure/httputil"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware runs each request next serves in a span, which continues the
+// trace of the traceparent header when the request has one. Spans are named
+// after the pattern of the route the request matched rather than its path, so
+// that IDs in paths do not make a name each.
+//
+// Middleware must wrap any middleware that reads the pattern of the request,
+// since it hands next a copy of the request that carries the span.
+func (t *Tracing) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := t.start(ctx, r.Method, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(r.Method),
+			semconv.URLPath(r.URL.Path),
+		))
+		defer span.End()
+
+		rw := httputil.NewStatusRecorder(w)
+		r = r.WithContext(ctx)
+		next.ServeHTTP(rw, r)
+
+		if r.Pattern != "" {
+			// Patterns such as "GET /users/{id}" may start with the method.
+			route := strings.TrimPrefix(r.Pattern, r.Method+" ")
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(semconv.HTTPRoute(route))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(rw.Status()))
+		if rw.Status() >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rw.Status()))
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MongoMonitor traces each command the MongoDB driver sends, such as a find
+// or an insert, as a child of the span in the context of the operation that
+// sent it.
+func (t *Tracing) MongoMonitor() *event.CommandMonitor {
+	// spans holds the spans of the commands that did not finish yet, by
+	// request ID.
+	var spans sync.Map
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			name := evt.CommandName
+			attrs := []attribute.KeyValue{
+				semconv.DBSystemMongoDB,
+				semconv.DBNamespace(evt.DatabaseName),
+				semconv.DBOperationName(evt.CommandName),
+			}
+			if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+				name += " " + collection
+				attrs = append(attrs, semconv.DBCollectionName(collection))
+			}
+			_, span := t.start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			spans.Store(evt.RequestID, span)
+		},
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				finish(span.(trace.Span), nil)
+			}
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				finish(span.(trace.Span), errors.New(evt.Failure))
+			}
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/pedro00627/urblog/infrastructure"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type queue struct {
+	next    infrastructure.Queue
+	tracing *Tracing
+}
+
+// Queue traces the messages written to next. The Kafka writer carries the
+// span into the headers of each message, so that consumers can continue the
+// trace.
+func (t *Tracing) Queue(next infrastructure.Queue) infrastructure.Queue {
+	return &queue{next: next, tracing: t}
+}
+
+func (q *queue) WriteMessage(ctx context.Context, message []byte) error {
+	ctx, span := q.tracing.start(ctx, "Queue.WriteMessage", trace.WithSpanKind(trace.SpanKindProducer))
+	err := q.next.WriteMessage(ctx, message)
+	finish(span, err)
+	return err
+}
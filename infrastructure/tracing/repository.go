@@ -0,0 +1,601 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/db"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tweetRepository struct {
+	next    db.TweetRepository
+	adapter string
+	tracing *Tracing
+}
+
+// TweetRepository traces the operations of next, tagged with adapter, such as
+// "mongo" or "in_memory".
+func (t *Tracing) TweetRepository(next db.TweetRepository, adapter string) db.TweetRepository {
+	return &tweetRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *tweetRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "TweetRepository."+operation, r.adapter)
+}
+
+func (r *tweetRepository) FindByID(ctx context.Context, id string) (*domain.Tweet, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	tweet, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return tweet, err
+}
+
+func (r *tweetRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	tweets, err := r.next.FindByUserID(ctx, userID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+func (r *tweetRepository) Save(ctx context.Context, tweet *domain.Tweet) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, tweet)
+	finish(span, err)
+	return err
+}
+
+func (r *tweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type userRepository struct {
+	next    db.UserRepository
+	adapter string
+	tracing *Tracing
+}
+
+// UserRepository traces the operations of next, tagged with adapter, such as
+// "mongo" or "in_memory".
+func (t *Tracing) UserRepository(next db.UserRepository, adapter string) db.UserRepository {
+	return &userRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *userRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "UserRepository."+operation, r.adapter)
+}
+
+func (r *userRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	user, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return user, err
+}
+
+func (r *userRepository) FindByName(ctx context.Context, username string) (*domain.User, error) {
+	ctx, span := r.start(ctx, "FindByName")
+	user, err := r.next.FindByName(ctx, username)
+	finish(span, err)
+	return user, err
+}
+
+func (r *userRepository) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	ctx, span := r.start(ctx, "FindAll")
+	users, err := r.next.FindAll(ctx, limit, offset)
+	finish(span, err)
+	return users, err
+}
+
+func (r *userRepository) Save(ctx context.Context, user *domain.User) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, user)
+	finish(span, err)
+	return err
+}
+
+func (r *userRepository) SaveAll(ctx context.Context, users []*domain.User) error {
+	ctx, span := r.start(ctx, "SaveAll")
+	err := r.next.SaveAll(ctx, users)
+	finish(span, err)
+	return err
+}
+
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, id)
+	finish(span, err)
+	return err
+}
+
+func (r *userRepository) RemoveRelationships(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "RemoveRelationships")
+	err := r.next.RemoveRelationships(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type notificationRepository struct {
+	next    db.NotificationRepository
+	adapter string
+	tracing *Tracing
+}
+
+// NotificationRepository traces the operations of next, tagged with adapter,
+// such as "mongo" or "in_memory".
+func (t *Tracing) NotificationRepository(next db.NotificationRepository, adapter string) db.NotificationRepository {
+	return &notificationRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *notificationRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "NotificationRepository."+operation, r.adapter)
+}
+
+func (r *notificationRepository) FindUnread(ctx context.Context, userID string, notificationType domain.NotificationType, tweetID string) (*domain.Notification, error) {
+	ctx, span := r.start(ctx, "FindUnread")
+	notification, err := r.next.FindUnread(ctx, userID, notificationType, tweetID)
+	finish(span, err)
+	return notification, err
+}
+
+func (r *notificationRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	notifications, err := r.next.FindByUserID(ctx, userID, limit, offset)
+	finish(span, err)
+	return notifications, err
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	ctx, span := r.start(ctx, "CountUnread")
+	count, err := r.next.CountUnread(ctx, userID)
+	finish(span, err)
+	return count, err
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID string, ids []string) error {
+	ctx, span := r.start(ctx, "MarkRead")
+	err := r.next.MarkRead(ctx, userID, ids)
+	finish(span, err)
+	return err
+}
+
+func (r *notificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, notification)
+	finish(span, err)
+	return err
+}
+
+func (r *notificationRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type followRequestRepository struct {
+	next    db.FollowRequestRepository
+	adapter string
+	tracing *Tracing
+}
+
+// FollowRequestRepository traces the operations of next, tagged with adapter,
+// such as "mongo" or "in_memory".
+func (t *Tracing) FollowRequestRepository(next db.FollowRequestRepository, adapter string) db.FollowRequestRepository {
+	return &followRequestRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *followRequestRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "FollowRequestRepository."+operation, r.adapter)
+}
+
+func (r *followRequestRepository) FindByID(ctx context.Context, id string) (*domain.FollowRequest, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	request, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return request, err
+}
+
+func (r *followRequestRepository) FindPending(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	ctx, span := r.start(ctx, "FindPending")
+	request, err := r.next.FindPending(ctx, followerID, followeeID)
+	finish(span, err)
+	return request, err
+}
+
+func (r *followRequestRepository) FindPendingByFolloweeID(ctx context.Context, followeeID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	ctx, span := r.start(ctx, "FindPendingByFolloweeID")
+	requests, err := r.next.FindPendingByFolloweeID(ctx, followeeID, limit, offset)
+	finish(span, err)
+	return requests, err
+}
+
+func (r *followRequestRepository) Save(ctx context.Context, request *domain.FollowRequest) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, request)
+	finish(span, err)
+	return err
+}
+
+func (r *followRequestRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type listRepository struct {
+	next    db.ListRepository
+	adapter string
+	tracing *Tracing
+}
+
+// ListRepository traces the operations of next, tagged with adapter, such as
+// "mongo" or "in_memory".
+func (t *Tracing) ListRepository(next db.ListRepository, adapter string) db.ListRepository {
+	return &listRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *listRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "ListRepository."+operation, r.adapter)
+}
+
+func (r *listRepository) FindByID(ctx context.Context, id string) (*domain.List, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	list, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return list, err
+}
+
+func (r *listRepository) FindByOwnerID(ctx context.Context, ownerID string) ([]*domain.List, error) {
+	ctx, span := r.start(ctx, "FindByOwnerID")
+	lists, err := r.next.FindByOwnerID(ctx, ownerID)
+	finish(span, err)
+	return lists, err
+}
+
+func (r *listRepository) Save(ctx context.Context, list *domain.List) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, list)
+	finish(span, err)
+	return err
+}
+
+func (r *listRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, id)
+	finish(span, err)
+	return err
+}
+
+func (r *listRepository) RemoveMember(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "RemoveMember")
+	err := r.next.RemoveMember(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type bookmarkRepository struct {
+	next    db.BookmarkRepository
+	adapter string
+	tracing *Tracing
+}
+
+// BookmarkRepository traces the operations of next, tagged with adapter, such
+// as "mongo" or "in_memory".
+func (t *Tracing) BookmarkRepository(next db.BookmarkRepository, adapter string) db.BookmarkRepository {
+	return &bookmarkRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *bookmarkRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "BookmarkRepository."+operation, r.adapter)
+}
+
+func (r *bookmarkRepository) Find(ctx context.Context, userID, tweetID string) (*domain.Bookmark, error) {
+	ctx, span := r.start(ctx, "Find")
+	bookmark, err := r.next.Find(ctx, userID, tweetID)
+	finish(span, err)
+	return bookmark, err
+}
+
+func (r *bookmarkRepository) FindByUserID(ctx context.Context, userID string, after *domain.BookmarkCursor, limit int) ([]*domain.Bookmark, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	bookmarks, err := r.next.FindByUserID(ctx, userID, after, limit)
+	finish(span, err)
+	return bookmarks, err
+}
+
+func (r *bookmarkRepository) Save(ctx context.Context, bookmark *domain.Bookmark) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, bookmark)
+	finish(span, err)
+	return err
+}
+
+func (r *bookmarkRepository) Delete(ctx context.Context, userID, tweetID string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, userID, tweetID)
+	finish(span, err)
+	return err
+}
+
+func (r *bookmarkRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type scheduledTweetRepository struct {
+	next    db.ScheduledTweetRepository
+	adapter string
+	tracing *Tracing
+}
+
+// ScheduledTweetRepository traces the operations of next, tagged with adapter,
+// such as "mongo" or "in_memory".
+func (t *Tracing) ScheduledTweetRepository(next db.ScheduledTweetRepository, adapter string) db.ScheduledTweetRepository {
+	return &scheduledTweetRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *scheduledTweetRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "ScheduledTweetRepository."+operation, r.adapter)
+}
+
+func (r *scheduledTweetRepository) FindByID(ctx context.Context, id string) (*domain.ScheduledTweet, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	tweet, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return tweet, err
+}
+
+func (r *scheduledTweetRepository) FindPendingByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	ctx, span := r.start(ctx, "FindPendingByUserID")
+	tweets, err := r.next.FindPendingByUserID(ctx, userID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+func (r *scheduledTweetRepository) Save(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, tweet)
+	finish(span, err)
+	return err
+}
+
+func (r *scheduledTweetRepository) Update(ctx context.Context, tweet *domain.ScheduledTweet) error {
+	ctx, span := r.start(ctx, "Update")
+	err := r.next.Update(ctx, tweet)
+	finish(span, err)
+	return err
+}
+
+func (r *scheduledTweetRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.ScheduledTweet, error) {
+	ctx, span := r.start(ctx, "ClaimDue")
+	tweets, err := r.next.ClaimDue(ctx, owner, now, leaseUntil, limit)
+	finish(span, err)
+	return tweets, err
+}
+
+func (r *scheduledTweetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type mediaRepository struct {
+	next    db.MediaRepository
+	adapter string
+	tracing *Tracing
+}
+
+// MediaRepository traces the operations of next, tagged with adapter, such as
+// "mongo" or "in_memory".
+func (t *Tracing) MediaRepository(next db.MediaRepository, adapter string) db.MediaRepository {
+	return &mediaRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *mediaRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "MediaRepository."+operation, r.adapter)
+}
+
+func (r *mediaRepository) FindByID(ctx context.Context, id string) (*domain.Media, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	media, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return media, err
+}
+
+func (r *mediaRepository) FindByUserID(ctx context.Context, userID string, limit int) ([]*domain.Media, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	media, err := r.next.FindByUserID(ctx, userID, limit)
+	finish(span, err)
+	return media, err
+}
+
+func (r *mediaRepository) Save(ctx context.Context, media *domain.Media) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, media)
+	finish(span, err)
+	return err
+}
+
+func (r *mediaRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, id)
+	finish(span, err)
+	return err
+}
+
+type pollVoteRepository struct {
+	next    db.PollVoteRepository
+	adapter string
+	tracing *Tracing
+}
+
+// PollVoteRepository traces the operations of next, tagged with adapter, such
+// as "mongo" or "in_memory".
+func (t *Tracing) PollVoteRepository(next db.PollVoteRepository, adapter string) db.PollVoteRepository {
+	return &pollVoteRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *pollVoteRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "PollVoteRepository."+operation, r.adapter)
+}
+
+func (r *pollVoteRepository) Save(ctx context.Context, pollVote *domain.PollVote) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, pollVote)
+	finish(span, err)
+	return err
+}
+
+func (r *pollVoteRepository) FindByUserID(ctx context.Context, userID string, tweetIDs []string) (map[string]*domain.PollVote, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	votes, err := r.next.FindByUserID(ctx, userID, tweetIDs)
+	finish(span, err)
+	return votes, err
+}
+
+func (r *pollVoteRepository) Tally(ctx context.Context, tweetIDs []string) (map[string]map[int]int, error) {
+	ctx, span := r.start(ctx, "Tally")
+	tally, err := r.next.Tally(ctx, tweetIDs)
+	finish(span, err)
+	return tally, err
+}
+
+func (r *pollVoteRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "DeleteByUserID")
+	err := r.next.DeleteByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type importJobRepository struct {
+	next    db.ImportJobRepository
+	adapter string
+	tracing *Tracing
+}
+
+// ImportJobRepository traces the operations of next, tagged with adapter, such
+// as "mongo" or "in_memory".
+func (t *Tracing) ImportJobRepository(next db.ImportJobRepository, adapter string) db.ImportJobRepository {
+	return &importJobRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *importJobRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "ImportJobRepository."+operation, r.adapter)
+}
+
+func (r *importJobRepository) FindByID(ctx context.Context, id string) (*domain.ImportJob, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	job, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return job, err
+}
+
+func (r *importJobRepository) Save(ctx context.Context, job *domain.ImportJob) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, job)
+	finish(span, err)
+	return err
+}
+
+func (r *importJobRepository) SaveRows(ctx context.Context, rows []domain.ImportRow) error {
+	ctx, span := r.start(ctx, "SaveRows")
+	err := r.next.SaveRows(ctx, rows)
+	finish(span, err)
+	return err
+}
+
+func (r *importJobRepository) FindRows(ctx context.Context, jobID string, limit, offset int) ([]domain.ImportRow, error) {
+	ctx, span := r.start(ctx, "FindRows")
+	rows, err := r.next.FindRows(ctx, jobID, limit, offset)
+	finish(span, err)
+	return rows, err
+}
+
+type accountExportRepository struct {
+	next    db.AccountExportRepository
+	adapter string
+	tracing *Tracing
+}
+
+// AccountExportRepository traces the operations of next, tagged with adapter,
+// such as "mongo" or "in_memory".
+func (t *Tracing) AccountExportRepository(next db.AccountExportRepository, adapter string) db.AccountExportRepository {
+	return &accountExportRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *accountExportRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "AccountExportRepository."+operation, r.adapter)
+}
+
+func (r *accountExportRepository) FindByID(ctx context.Context, id string) (*domain.AccountExport, error) {
+	ctx, span := r.start(ctx, "FindByID")
+	export, err := r.next.FindByID(ctx, id)
+	finish(span, err)
+	return export, err
+}
+
+func (r *accountExportRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.AccountExport, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	exports, err := r.next.FindByUserID(ctx, userID)
+	finish(span, err)
+	return exports, err
+}
+
+func (r *accountExportRepository) Save(ctx context.Context, export *domain.AccountExport) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, export)
+	finish(span, err)
+	return err
+}
+
+func (r *accountExportRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, id)
+	finish(span, err)
+	return err
+}
+
+type accountDeletionRepository struct {
+	next    db.AccountDeletionRepository
+	adapter string
+	tracing *Tracing
+}
+
+// AccountDeletionRepository traces the operations of next, tagged with
+// adapter, such as "mongo" or "in_memory".
+func (t *Tracing) AccountDeletionRepository(next db.AccountDeletionRepository, adapter string) db.AccountDeletionRepository {
+	return &accountDeletionRepository{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *accountDeletionRepository) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "AccountDeletionRepository."+operation, r.adapter)
+}
+
+func (r *accountDeletionRepository) FindByUserID(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	ctx, span := r.start(ctx, "FindByUserID")
+	deletion, err := r.next.FindByUserID(ctx, userID)
+	finish(span, err)
+	return deletion, err
+}
+
+func (r *accountDeletionRepository) Save(ctx context.Context, deletion *domain.AccountDeletion) error {
+	ctx, span := r.start(ctx, "Save")
+	err := r.next.Save(ctx, deletion)
+	finish(span, err)
+	return err
+}
+
+func (r *accountDeletionRepository) ClaimDue(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	ctx, span := r.start(ctx, "ClaimDue")
+	deletions, err := r.next.ClaimDue(ctx, owner, now, leaseUntil, limit)
+	finish(span, err)
+	return deletions, err
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type searchIndex struct {
+	next    infrastructure.SearchIndex
+	adapter string
+	tracing *Tracing
+}
+
+// SearchIndex traces the operations of next, tagged with adapter, such as
+// "mongo" or "in_memory".
+func (t *Tracing) SearchIndex(next infrastructure.SearchIndex, adapter string) infrastructure.SearchIndex {
+	return &searchIndex{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *searchIndex) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "SearchIndex."+operation, r.adapter)
+}
+
+func (r *searchIndex) Index(ctx context.Context, tweet *domain.Tweet) error {
+	ctx, span := r.start(ctx, "Index")
+	err := r.next.Index(ctx, tweet)
+	finish(span, err)
+	return err
+}
+
+func (r *searchIndex) Search(ctx context.Context, query domain.SearchQuery) ([]domain.SearchResult, error) {
+	ctx, span := r.start(ctx, "Search")
+	results, err := r.next.Search(ctx, query)
+	finish(span, err)
+	return results, err
+}
+
+func (r *searchIndex) RemoveByUserID(ctx context.Context, userID string) error {
+	ctx, span := r.start(ctx, "RemoveByUserID")
+	err := r.next.RemoveByUserID(ctx, userID)
+	finish(span, err)
+	return err
+}
+
+type blobStore struct {
+	next    infrastructure.BlobStore
+	adapter string
+	tracing *Tracing
+}
+
+// BlobStore traces the operations of next, tagged with adapter, such as "s3"
+// or "local".
+func (t *Tracing) BlobStore(next infrastructure.BlobStore, adapter string) infrastructure.BlobStore {
+	return &blobStore{next: next, adapter: adapter, tracing: t}
+}
+
+func (r *blobStore) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return r.tracing.startOperation(ctx, "BlobStore."+operation, r.adapter)
+}
+
+func (r *blobStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	ctx, span := r.start(ctx, "Put")
+	err := r.next.Put(ctx, key, contentType, data)
+	finish(span, err)
+	return err
+}
+
+func (r *blobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := r.start(ctx, "Get")
+	data, err := r.next.Get(ctx, key)
+	finish(span, err)
+	return data, err
+}
+
+func (r *blobStore) Delete(ctx context.Context, key string) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.next.Delete(ctx, key)
+	finish(span, err)
+	return err
+}
@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/pedro00627/urblog"
+
+// propagator reads and writes trace context in the W3C traceparent format,
+// both in HTTP headers and in queue messages.
+var propagator = propagation.TraceContext{}
+
+// Tracing creates the OpenTelemetry spans of urblog. Like metrics, requests,
+// use cases, repositories and the queue are traced by decorators that wrap
+// them, so that neither handlers nor adapters know about OpenTelemetry. Each
+// span is a child of the one in the context it is given, so a request is
+// traced from its handler down to every repository and queue call.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// New exports spans to exporter in batches. The service is named "urblog"
+// unless OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES say otherwise, and
+// OTEL_TRACES_SAMPLER chooses which traces are sampled.
+func New(exporter sdktrace.SpanExporter) *Tracing {
+	// Neither resource has a schema, so merging them cannot fail.
+	res, _ := resource.Merge(resource.NewSchemaless(semconv.ServiceName("urblog")), resource.Environment())
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	return &Tracing{provider: provider, tracer: provider.Tracer(instrumentationName)}
+}
+
+// Disabled records no spans, but still passes on the trace context of
+// incoming requests.
+func Disabled() *Tracing {
+	return &Tracing{tracer: noop.NewTracerProvider().Tracer(instrumentationName)}
+}
+
+// Flush exports the spans that ended but were not exported yet.
+func (t *Tracing) Flush(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+	return t.provider.ForceFlush(ctx)
+}
+
+// Shutdown flushes the spans left and stops exporting them.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+// start starts a span named name, such as the interface of the use case a
+// decorator wraps.
+func (t *Tracing) start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, name, opts...)
+}
+
+// startOperation starts the span of an operation of a repository or store
+// that runs on adapter.
+func (t *Tracing) startOperation(ctx context.Context, name, adapter string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attribute.String("urblog.adapter", adapter)}
+	if adapter == "mongo" {
+		attrs = append(attrs, semconv.DBSystemMongoDB)
+	}
+	return t.start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// finish ends span, recording err if the operation failed with it.
+func finish(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
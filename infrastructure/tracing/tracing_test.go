@@ -0,0 +1,161 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	appmocks "github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spans flushes tr and returns the spans exporter got, by name.
+func spans(t *testing.T, tr *Tracing, exporter *tracetest.InMemoryExporter) map[string]tracetest.SpanStub {
+	require.NoError(t, tr.Flush(context.Background()))
+	byName := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		byName[span.Name] = span
+	}
+	return byName
+}
+
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func TestTraceFromHandlerToRepositoryAndQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tr := New(exporter)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(nil, domain.ErrUserNotFound).Times(1)
+	queue := mocks.NewMockQueue(ctrl)
+	queue.EXPECT().WriteMessage(gomock.Any(), []byte("user1")).Return(nil).Times(1)
+	users := tr.UserRepository(userRepo, "mongo")
+	tracedQueue := tr.Queue(queue)
+	followUser := appmocks.NewMockFollowUser(ctrl)
+	followUser.EXPECT().Execute(gomock.Any(), "user1", "user2").DoAndReturn(func(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+		if err := tracedQueue.WriteMessage(ctx, []byte(followerID)); err != nil {
+			return nil, err
+		}
+		_, err := users.FindByID(ctx, followeeID)
+		return nil, err
+	}).Times(1)
+	tracedFollowUser := tr.FollowUser(followUser)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /users/{id}/follow", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := tracedFollowUser.Execute(r.Context(), r.PathValue("id"), "user2"); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	req := httptest.NewRequest(http.MethodPost, "/users/user1/follow", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	tr.Middleware(mux).ServeHTTP(httptest.NewRecorder(), req)
+
+	byName := spans(t, tr, exporter)
+	require.Len(t, byName, 4)
+	server := byName["POST /users/{id}/follow"]
+	useCase := byName["FollowUser"]
+	write := byName["Queue.WriteMessage"]
+	find := byName["UserRepository.FindByID"]
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext.TraceID().String(), "the incoming trace is continued")
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+	assert.Equal(t, server.SpanContext.SpanID(), useCase.Parent.SpanID())
+	assert.Equal(t, useCase.SpanContext.SpanID(), write.Parent.SpanID())
+	assert.Equal(t, useCase.SpanContext.SpanID(), find.Parent.SpanID())
+
+	assert.Equal(t, "/users/{id}/follow", attributes(server)["http.route"].AsString())
+	assert.Equal(t, int64(500), attributes(server)["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Error, server.Status.Code)
+	assert.Equal(t, codes.Error, useCase.Status.Code)
+	assert.Equal(t, codes.Error, find.Status.Code)
+	assert.Equal(t, domain.ErrUserNotFound.Error(), find.Status.Description)
+	assert.Equal(t, "mongodb", attributes(find)["db.system"].AsString())
+	assert.Equal(t, codes.Unset, write.Status.Code)
+}
+
+func TestMiddleware(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tr := New(exporter)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/docs", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("GET /stream", func(w http.ResponseWriter, r *http.Request) {
+		_, ok := w.(http.Flusher)
+		assert.True(t, ok, "streams can still flush")
+	})
+	handler := tr.Middleware(mux)
+
+	for _, path := range []string{"/docs", "/stream", "/nowhere"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	byName := spans(t, tr, exporter)
+	assert.Contains(t, byName, "GET /docs")
+	assert.Contains(t, byName, "GET /stream")
+	assert.Equal(t, int64(404), attributes(byName["GET"])["http.response.status_code"].AsInt64(), "unmatched requests are named after their method")
+	assert.Equal(t, codes.Unset, byName["GET"].Status.Code)
+}
+
+func TestMongoMonitor(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tr := New(exporter)
+	monitor := tr.MongoMonitor()
+	ctx, parent := tr.start(context.Background(), "TweetRepository.FindByID")
+	find, err := bson.Marshal(bson.D{{Key: "find", Value: "tweets"}})
+	require.NoError(t, err)
+	insert, err := bson.Marshal(bson.D{{Key: "insert", Value: "tweets"}})
+	require.NoError(t, err)
+
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: find, DatabaseName: "urblog", CommandName: "find", RequestID: 1})
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: insert, DatabaseName: "urblog", CommandName: "insert", RequestID: 2})
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 1}})
+	monitor.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "insert", RequestID: 2}, Failure: "duplicate key"})
+	parent.End()
+
+	byName := spans(t, tr, exporter)
+	require.Len(t, byName, 3)
+	assert.Equal(t, parent.SpanContext().SpanID(), byName["find tweets"].Parent.SpanID())
+	assert.Equal(t, "tweets", attributes(byName["find tweets"])["db.collection.name"].AsString())
+	assert.Equal(t, "urblog", attributes(byName["find tweets"])["db.namespace"].AsString())
+	assert.Equal(t, codes.Unset, byName["find tweets"].Status.Code)
+	assert.Equal(t, codes.Error, byName["insert tweets"].Status.Code)
+	assert.Equal(t, "duplicate key", byName["insert tweets"].Status.Description)
+}
+
+func TestDisabled(t *testing.T) {
+	tr := Disabled()
+	var traceID string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tr.start(r.Context(), "GetTimeline")
+		traceID = span.SpanContext().TraceID().String()
+		finish(span, errors.New("ignored"))
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	tr.Middleware(mux).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID, "the incoming trace is still passed on")
+	assert.NoError(t, tr.Flush(context.Background()))
+	assert.NoError(t, tr.Shutdown(context.Background()))
+}
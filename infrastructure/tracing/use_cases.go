@@ -0,0 +1,730 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+type addListMember struct {
+	next    application.AddListMember
+	tracing *Tracing
+}
+
+func (t *Tracing) AddListMember(next application.AddListMember) application.AddListMember {
+	return &addListMember{next: next, tracing: t}
+}
+
+func (uc *addListMember) Execute(ctx context.Context, userID, listID, memberID string) error {
+	ctx, span := uc.tracing.start(ctx, "AddListMember")
+	err := uc.next.Execute(ctx, userID, listID, memberID)
+	finish(span, err)
+	return err
+}
+
+type blockUser struct {
+	next    application.BlockUser
+	tracing *Tracing
+}
+
+func (t *Tracing) BlockUser(next application.BlockUser) application.BlockUser {
+	return &blockUser{next: next, tracing: t}
+}
+
+func (uc *blockUser) Execute(ctx context.Context, userID, targetID string) error {
+	ctx, span := uc.tracing.start(ctx, "BlockUser")
+	err := uc.next.Execute(ctx, userID, targetID)
+	finish(span, err)
+	return err
+}
+
+type bookmarkTweet struct {
+	next    application.BookmarkTweet
+	tracing *Tracing
+}
+
+func (t *Tracing) BookmarkTweet(next application.BookmarkTweet) application.BookmarkTweet {
+	return &bookmarkTweet{next: next, tracing: t}
+}
+
+func (uc *bookmarkTweet) Execute(ctx context.Context, userID, tweetID string) error {
+	ctx, span := uc.tracing.start(ctx, "BookmarkTweet")
+	err := uc.next.Execute(ctx, userID, tweetID)
+	finish(span, err)
+	return err
+}
+
+type buildNotifications struct {
+	next    application.BuildNotifications
+	tracing *Tracing
+}
+
+func (t *Tracing) BuildNotifications(next application.BuildNotifications) application.BuildNotifications {
+	return &buildNotifications{next: next, tracing: t}
+}
+
+func (uc *buildNotifications) Execute(ctx context.Context, event domain.Event) error {
+	ctx, span := uc.tracing.start(ctx, "BuildNotifications")
+	err := uc.next.Execute(ctx, event)
+	finish(span, err)
+	return err
+}
+
+type cancelScheduledTweet struct {
+	next    application.CancelScheduledTweet
+	tracing *Tracing
+}
+
+func (t *Tracing) CancelScheduledTweet(next application.CancelScheduledTweet) application.CancelScheduledTweet {
+	return &cancelScheduledTweet{next: next, tracing: t}
+}
+
+func (uc *cancelScheduledTweet) Execute(ctx context.Context, userID, id string) error {
+	ctx, span := uc.tracing.start(ctx, "CancelScheduledTweet")
+	err := uc.next.Execute(ctx, userID, id)
+	finish(span, err)
+	return err
+}
+
+type createList struct {
+	next    application.CreateList
+	tracing *Tracing
+}
+
+func (t *Tracing) CreateList(next application.CreateList) application.CreateList {
+	return &createList{next: next, tracing: t}
+}
+
+func (uc *createList) Execute(ctx context.Context, ownerID, name string, private bool) (*domain.List, error) {
+	ctx, span := uc.tracing.start(ctx, "CreateList")
+	list, err := uc.next.Execute(ctx, ownerID, name, private)
+	finish(span, err)
+	return list, err
+}
+
+type createTweet struct {
+	next    application.CreateTweet
+	tracing *Tracing
+}
+
+func (t *Tracing) CreateTweet(next application.CreateTweet) application.CreateTweet {
+	return &createTweet{next: next, tracing: t}
+}
+
+func (uc *createTweet) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments) (*domain.Tweet, error) {
+	ctx, span := uc.tracing.start(ctx, "CreateTweet")
+	tweet, err := uc.next.Execute(ctx, userID, content, attachments)
+	finish(span, err)
+	return tweet, err
+}
+
+type deleteList struct {
+	next    application.DeleteList
+	tracing *Tracing
+}
+
+func (t *Tracing) DeleteList(next application.DeleteList) application.DeleteList {
+	return &deleteList{next: next, tracing: t}
+}
+
+func (uc *deleteList) Execute(ctx context.Context, userID, listID string) error {
+	ctx, span := uc.tracing.start(ctx, "DeleteList")
+	err := uc.next.Execute(ctx, userID, listID)
+	finish(span, err)
+	return err
+}
+
+type deleteUser struct {
+	next    application.DeleteUser
+	tracing *Tracing
+}
+
+func (t *Tracing) DeleteUser(next application.DeleteUser) application.DeleteUser {
+	return &deleteUser{next: next, tracing: t}
+}
+
+func (uc *deleteUser) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	ctx, span := uc.tracing.start(ctx, "DeleteUser")
+	deletion, err := uc.next.Execute(ctx, userID)
+	finish(span, err)
+	return deletion, err
+}
+
+type exportAccount struct {
+	next    application.ExportAccount
+	tracing *Tracing
+}
+
+func (t *Tracing) ExportAccount(next application.ExportAccount) application.ExportAccount {
+	return &exportAccount{next: next, tracing: t}
+}
+
+func (uc *exportAccount) Execute(ctx context.Context, userID string) (*domain.AccountExport, []byte, error) {
+	ctx, span := uc.tracing.start(ctx, "ExportAccount")
+	export, data, err := uc.next.Execute(ctx, userID)
+	finish(span, err)
+	return export, data, err
+}
+
+type exportUsers struct {
+	next    application.ExportUsers
+	tracing *Tracing
+}
+
+func (t *Tracing) ExportUsers(next application.ExportUsers) application.ExportUsers {
+	return &exportUsers{next: next, tracing: t}
+}
+
+func (uc *exportUsers) Execute(ctx context.Context, w io.Writer, format domain.ImportFormat) (int, error) {
+	ctx, span := uc.tracing.start(ctx, "ExportUsers")
+	count, err := uc.next.Execute(ctx, w, format)
+	finish(span, err)
+	return count, err
+}
+
+type followUser struct {
+	next    application.FollowUser
+	tracing *Tracing
+}
+
+func (t *Tracing) FollowUser(next application.FollowUser) application.FollowUser {
+	return &followUser{next: next, tracing: t}
+}
+
+func (uc *followUser) Execute(ctx context.Context, followerID, followeeID string) (*domain.FollowRequest, error) {
+	ctx, span := uc.tracing.start(ctx, "FollowUser")
+	request, err := uc.next.Execute(ctx, followerID, followeeID)
+	finish(span, err)
+	return request, err
+}
+
+type getAccountDeletion struct {
+	next    application.GetAccountDeletion
+	tracing *Tracing
+}
+
+func (t *Tracing) GetAccountDeletion(next application.GetAccountDeletion) application.GetAccountDeletion {
+	return &getAccountDeletion{next: next, tracing: t}
+}
+
+func (uc *getAccountDeletion) Execute(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	ctx, span := uc.tracing.start(ctx, "GetAccountDeletion")
+	deletion, err := uc.next.Execute(ctx, userID)
+	finish(span, err)
+	return deletion, err
+}
+
+type getAccountExport struct {
+	next    application.GetAccountExport
+	tracing *Tracing
+}
+
+func (t *Tracing) GetAccountExport(next application.GetAccountExport) application.GetAccountExport {
+	return &getAccountExport{next: next, tracing: t}
+}
+
+func (uc *getAccountExport) Execute(ctx context.Context, id string, archive bool) (*domain.AccountExport, []byte, error) {
+	ctx, span := uc.tracing.start(ctx, "GetAccountExport")
+	export, data, err := uc.next.Execute(ctx, id, archive)
+	finish(span, err)
+	return export, data, err
+}
+
+type getBookmarks struct {
+	next    application.GetBookmarks
+	tracing *Tracing
+}
+
+func (t *Tracing) GetBookmarks(next application.GetBookmarks) application.GetBookmarks {
+	return &getBookmarks{next: next, tracing: t}
+}
+
+func (uc *getBookmarks) Execute(ctx context.Context, userID string, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	ctx, span := uc.tracing.start(ctx, "GetBookmarks")
+	tweets, cursor, err := uc.next.Execute(ctx, userID, limit, cursor)
+	finish(span, err)
+	return tweets, cursor, err
+}
+
+type getFollowRequests struct {
+	next    application.GetFollowRequests
+	tracing *Tracing
+}
+
+func (t *Tracing) GetFollowRequests(next application.GetFollowRequests) application.GetFollowRequests {
+	return &getFollowRequests{next: next, tracing: t}
+}
+
+func (uc *getFollowRequests) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.FollowRequest, error) {
+	ctx, span := uc.tracing.start(ctx, "GetFollowRequests")
+	requests, err := uc.next.Execute(ctx, userID, limit, offset)
+	finish(span, err)
+	return requests, err
+}
+
+type getImportJob struct {
+	next    application.GetImportJob
+	tracing *Tracing
+}
+
+func (t *Tracing) GetImportJob(next application.GetImportJob) application.GetImportJob {
+	return &getImportJob{next: next, tracing: t}
+}
+
+func (uc *getImportJob) Execute(ctx context.Context, id string, limit, offset int) (*domain.ImportJob, []domain.ImportRow, error) {
+	ctx, span := uc.tracing.start(ctx, "GetImportJob")
+	job, rows, err := uc.next.Execute(ctx, id, limit, offset)
+	finish(span, err)
+	return job, rows, err
+}
+
+type getList struct {
+	next    application.GetList
+	tracing *Tracing
+}
+
+func (t *Tracing) GetList(next application.GetList) application.GetList {
+	return &getList{next: next, tracing: t}
+}
+
+func (uc *getList) Execute(ctx context.Context, viewerID, listID string) (*domain.List, error) {
+	ctx, span := uc.tracing.start(ctx, "GetList")
+	list, err := uc.next.Execute(ctx, viewerID, listID)
+	finish(span, err)
+	return list, err
+}
+
+type getListTimeline struct {
+	next    application.GetListTimeline
+	tracing *Tracing
+}
+
+func (t *Tracing) GetListTimeline(next application.GetListTimeline) application.GetListTimeline {
+	return &getListTimeline{next: next, tracing: t}
+}
+
+func (uc *getListTimeline) Execute(ctx context.Context, viewerID, listID string, limit, offset int) ([]*domain.Tweet, error) {
+	ctx, span := uc.tracing.start(ctx, "GetListTimeline")
+	tweets, err := uc.next.Execute(ctx, viewerID, listID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+type getMedia struct {
+	next    application.GetMedia
+	tracing *Tracing
+}
+
+func (t *Tracing) GetMedia(next application.GetMedia) application.GetMedia {
+	return &getMedia{next: next, tracing: t}
+}
+
+func (uc *getMedia) Execute(ctx context.Context, id string, thumbnail bool) (*domain.Media, []byte, error) {
+	ctx, span := uc.tracing.start(ctx, "GetMedia")
+	media, data, err := uc.next.Execute(ctx, id, thumbnail)
+	finish(span, err)
+	return media, data, err
+}
+
+type getNotifications struct {
+	next    application.GetNotifications
+	tracing *Tracing
+}
+
+func (t *Tracing) GetNotifications(next application.GetNotifications) application.GetNotifications {
+	return &getNotifications{next: next, tracing: t}
+}
+
+func (uc *getNotifications) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Notification, int, error) {
+	ctx, span := uc.tracing.start(ctx, "GetNotifications")
+	notifications, unread, err := uc.next.Execute(ctx, userID, limit, offset)
+	finish(span, err)
+	return notifications, unread, err
+}
+
+type getScheduledTweets struct {
+	next    application.GetScheduledTweets
+	tracing *Tracing
+}
+
+func (t *Tracing) GetScheduledTweets(next application.GetScheduledTweets) application.GetScheduledTweets {
+	return &getScheduledTweets{next: next, tracing: t}
+}
+
+func (uc *getScheduledTweets) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.ScheduledTweet, error) {
+	ctx, span := uc.tracing.start(ctx, "GetScheduledTweets")
+	tweets, err := uc.next.Execute(ctx, userID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+type getTimeline struct {
+	next    application.GetTimeline
+	tracing *Tracing
+}
+
+func (t *Tracing) GetTimeline(next application.GetTimeline) application.GetTimeline {
+	return &getTimeline{next: next, tracing: t}
+}
+
+func (uc *getTimeline) Execute(ctx context.Context, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	ctx, span := uc.tracing.start(ctx, "GetTimeline")
+	tweets, err := uc.next.Execute(ctx, userID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+type getTrends struct {
+	next    application.GetTrends
+	tracing *Tracing
+}
+
+func (t *Tracing) GetTrends(next application.GetTrends) application.GetTrends {
+	return &getTrends{next: next, tracing: t}
+}
+
+func (uc *getTrends) Execute(ctx context.Context, window string, limit int) ([]domain.Trend, error) {
+	ctx, span := uc.tracing.start(ctx, "GetTrends")
+	trends, err := uc.next.Execute(ctx, window, limit)
+	finish(span, err)
+	return trends, err
+}
+
+type getUserLists struct {
+	next    application.GetUserLists
+	tracing *Tracing
+}
+
+func (t *Tracing) GetUserLists(next application.GetUserLists) application.GetUserLists {
+	return &getUserLists{next: next, tracing: t}
+}
+
+func (uc *getUserLists) Execute(ctx context.Context, viewerID, ownerID string) ([]*domain.List, error) {
+	ctx, span := uc.tracing.start(ctx, "GetUserLists")
+	lists, err := uc.next.Execute(ctx, viewerID, ownerID)
+	finish(span, err)
+	return lists, err
+}
+
+type getUserTweets struct {
+	next    application.GetUserTweets
+	tracing *Tracing
+}
+
+func (t *Tracing) GetUserTweets(next application.GetUserTweets) application.GetUserTweets {
+	return &getUserTweets{next: next, tracing: t}
+}
+
+func (uc *getUserTweets) Execute(ctx context.Context, viewerID, userID string, limit, offset int) ([]*domain.Tweet, error) {
+	ctx, span := uc.tracing.start(ctx, "GetUserTweets")
+	tweets, err := uc.next.Execute(ctx, viewerID, userID, limit, offset)
+	finish(span, err)
+	return tweets, err
+}
+
+type importUsers struct {
+	next    application.ImportUsers
+	tracing *Tracing
+}
+
+func (t *Tracing) ImportUsers(next application.ImportUsers) application.ImportUsers {
+	return &importUsers{next: next, tracing: t}
+}
+
+func (uc *importUsers) Execute(ctx context.Context, r io.Reader, options domain.ImportOptions) (*domain.ImportJob, error) {
+	ctx, span := uc.tracing.start(ctx, "ImportUsers")
+	job, err := uc.next.Execute(ctx, r, options)
+	finish(span, err)
+	return job, err
+}
+
+type indexTweets struct {
+	next    application.IndexTweets
+	tracing *Tracing
+}
+
+func (t *Tracing) IndexTweets(next application.IndexTweets) application.IndexTweets {
+	return &indexTweets{next: next, tracing: t}
+}
+
+func (uc *indexTweets) Execute(ctx context.Context, event domain.Event) error {
+	ctx, span := uc.tracing.start(ctx, "IndexTweets")
+	err := uc.next.Execute(ctx, event)
+	finish(span, err)
+	return err
+}
+
+type listBlockedUsers struct {
+	next    application.ListBlockedUsers
+	tracing *Tracing
+}
+
+func (t *Tracing) ListBlockedUsers(next application.ListBlockedUsers) application.ListBlockedUsers {
+	return &listBlockedUsers{next: next, tracing: t}
+}
+
+func (uc *listBlockedUsers) Execute(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := uc.tracing.start(ctx, "ListBlockedUsers")
+	ids, err := uc.next.Execute(ctx, userID)
+	finish(span, err)
+	return ids, err
+}
+
+type listMutedUsers struct {
+	next    application.ListMutedUsers
+	tracing *Tracing
+}
+
+func (t *Tracing) ListMutedUsers(next application.ListMutedUsers) application.ListMutedUsers {
+	return &listMutedUsers{next: next, tracing: t}
+}
+
+func (uc *listMutedUsers) Execute(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := uc.tracing.start(ctx, "ListMutedUsers")
+	ids, err := uc.next.Execute(ctx, userID)
+	finish(span, err)
+	return ids, err
+}
+
+type markNotificationsRead struct {
+	next    application.MarkNotificationsRead
+	tracing *Tracing
+}
+
+func (t *Tracing) MarkNotificationsRead(next application.MarkNotificationsRead) application.MarkNotificationsRead {
+	return &markNotificationsRead{next: next, tracing: t}
+}
+
+func (uc *markNotificationsRead) Execute(ctx context.Context, userID string, ids []string) error {
+	ctx, span := uc.tracing.start(ctx, "MarkNotificationsRead")
+	err := uc.next.Execute(ctx, userID, ids)
+	finish(span, err)
+	return err
+}
+
+type muteUser struct {
+	next    application.MuteUser
+	tracing *Tracing
+}
+
+func (t *Tracing) MuteUser(next application.MuteUser) application.MuteUser {
+	return &muteUser{next: next, tracing: t}
+}
+
+func (uc *muteUser) Execute(ctx context.Context, userID, targetID string) error {
+	ctx, span := uc.tracing.start(ctx, "MuteUser")
+	err := uc.next.Execute(ctx, userID, targetID)
+	finish(span, err)
+	return err
+}
+
+type removeBookmark struct {
+	next    application.RemoveBookmark
+	tracing *Tracing
+}
+
+func (t *Tracing) RemoveBookmark(next application.RemoveBookmark) application.RemoveBookmark {
+	return &removeBookmark{next: next, tracing: t}
+}
+
+func (uc *removeBookmark) Execute(ctx context.Context, userID, tweetID string) error {
+	ctx, span := uc.tracing.start(ctx, "RemoveBookmark")
+	err := uc.next.Execute(ctx, userID, tweetID)
+	finish(span, err)
+	return err
+}
+
+type removeListMember struct {
+	next    application.RemoveListMember
+	tracing *Tracing
+}
+
+func (t *Tracing) RemoveListMember(next application.RemoveListMember) application.RemoveListMember {
+	return &removeListMember{next: next, tracing: t}
+}
+
+func (uc *removeListMember) Execute(ctx context.Context, userID, listID, memberID string) error {
+	ctx, span := uc.tracing.start(ctx, "RemoveListMember")
+	err := uc.next.Execute(ctx, userID, listID, memberID)
+	finish(span, err)
+	return err
+}
+
+type resolveFollowRequest struct {
+	next    application.ResolveFollowRequest
+	tracing *Tracing
+}
+
+func (t *Tracing) ResolveFollowRequest(next application.ResolveFollowRequest) application.ResolveFollowRequest {
+	return &resolveFollowRequest{next: next, tracing: t}
+}
+
+func (uc *resolveFollowRequest) Execute(ctx context.Context, userID, requestID string, status domain.FollowRequestStatus) (*domain.FollowRequest, error) {
+	ctx, span := uc.tracing.start(ctx, "ResolveFollowRequest")
+	request, err := uc.next.Execute(ctx, userID, requestID, status)
+	finish(span, err)
+	return request, err
+}
+
+type scheduleTweet struct {
+	next    application.ScheduleTweet
+	tracing *Tracing
+}
+
+func (t *Tracing) ScheduleTweet(next application.ScheduleTweet) application.ScheduleTweet {
+	return &scheduleTweet{next: next, tracing: t}
+}
+
+func (uc *scheduleTweet) Execute(ctx context.Context, userID, content string, attachments domain.TweetAttachments, publishAt time.Time) (*domain.ScheduledTweet, error) {
+	ctx, span := uc.tracing.start(ctx, "ScheduleTweet")
+	tweet, err := uc.next.Execute(ctx, userID, content, attachments, publishAt)
+	finish(span, err)
+	return tweet, err
+}
+
+type searchTweets struct {
+	next    application.SearchTweets
+	tracing *Tracing
+}
+
+func (t *Tracing) SearchTweets(next application.SearchTweets) application.SearchTweets {
+	return &searchTweets{next: next, tracing: t}
+}
+
+func (uc *searchTweets) Execute(ctx context.Context, q string, sort domain.SearchSort, limit int, cursor string) ([]*domain.Tweet, string, error) {
+	ctx, span := uc.tracing.start(ctx, "SearchTweets")
+	tweets, cursor, err := uc.next.Execute(ctx, q, sort, limit, cursor)
+	finish(span, err)
+	return tweets, cursor, err
+}
+
+type setAccountPrivacy struct {
+	next    application.SetAccountPrivacy
+	tracing *Tracing
+}
+
+func (t *Tracing) SetAccountPrivacy(next application.SetAccountPrivacy) application.SetAccountPrivacy {
+	return &setAccountPrivacy{next: next, tracing: t}
+}
+
+func (uc *setAccountPrivacy) Execute(ctx context.Context, userID string, protected bool) error {
+	ctx, span := uc.tracing.start(ctx, "SetAccountPrivacy")
+	err := uc.next.Execute(ctx, userID, protected)
+	finish(span, err)
+	return err
+}
+
+type suggestUsers struct {
+	next    application.SuggestUsers
+	tracing *Tracing
+}
+
+func (t *Tracing) SuggestUsers(next application.SuggestUsers) application.SuggestUsers {
+	return &suggestUsers{next: next, tracing: t}
+}
+
+func (uc *suggestUsers) Execute(ctx context.Context, userID string, limit int) ([]domain.Suggestion, error) {
+	ctx, span := uc.tracing.start(ctx, "SuggestUsers")
+	suggestions, err := uc.next.Execute(ctx, userID, limit)
+	finish(span, err)
+	return suggestions, err
+}
+
+type unblockUser struct {
+	next    application.UnblockUser
+	tracing *Tracing
+}
+
+func (t *Tracing) UnblockUser(next application.UnblockUser) application.UnblockUser {
+	return &unblockUser{next: next, tracing: t}
+}
+
+func (uc *unblockUser) Execute(ctx context.Context, userID, targetID string) error {
+	ctx, span := uc.tracing.start(ctx, "UnblockUser")
+	err := uc.next.Execute(ctx, userID, targetID)
+	finish(span, err)
+	return err
+}
+
+type unmuteUser struct {
+	next    application.UnmuteUser
+	tracing *Tracing
+}
+
+func (t *Tracing) UnmuteUser(next application.UnmuteUser) application.UnmuteUser {
+	return &unmuteUser{next: next, tracing: t}
+}
+
+func (uc *unmuteUser) Execute(ctx context.Context, userID, targetID string) error {
+	ctx, span := uc.tracing.start(ctx, "UnmuteUser")
+	err := uc.next.Execute(ctx, userID, targetID)
+	finish(span, err)
+	return err
+}
+
+type updateList struct {
+	next    application.UpdateList
+	tracing *Tracing
+}
+
+func (t *Tracing) UpdateList(next application.UpdateList) application.UpdateList {
+	return &updateList{next: next, tracing: t}
+}
+
+func (uc *updateList) Execute(ctx context.Context, userID, listID string, name *string, private *bool) (*domain.List, error) {
+	ctx, span := uc.tracing.start(ctx, "UpdateList")
+	list, err := uc.next.Execute(ctx, userID, listID, name, private)
+	finish(span, err)
+	return list, err
+}
+
+type updateScheduledTweet struct {
+	next    application.UpdateScheduledTweet
+	tracing *Tracing
+}
+
+func (t *Tracing) UpdateScheduledTweet(next application.UpdateScheduledTweet) application.UpdateScheduledTweet {
+	return &updateScheduledTweet{next: next, tracing: t}
+}
+
+func (uc *updateScheduledTweet) Execute(ctx context.Context, userID, id string, content *string, publishAt *time.Time) (*domain.ScheduledTweet, error) {
+	ctx, span := uc.tracing.start(ctx, "UpdateScheduledTweet")
+	tweet, err := uc.next.Execute(ctx, userID, id, content, publishAt)
+	finish(span, err)
+	return tweet, err
+}
+
+type uploadMedia struct {
+	next    application.UploadMedia
+	tracing *Tracing
+}
+
+func (t *Tracing) UploadMedia(next application.UploadMedia) application.UploadMedia {
+	return &uploadMedia{next: next, tracing: t}
+}
+
+func (uc *uploadMedia) Execute(ctx context.Context, userID string, data []byte) (*domain.Media, error) {
+	ctx, span := uc.tracing.start(ctx, "UploadMedia")
+	media, err := uc.next.Execute(ctx, userID, data)
+	finish(span, err)
+	return media, err
+}
+
+type votePoll struct {
+	next    application.VotePoll
+	tracing *Tracing
+}
+
+func (t *Tracing) VotePoll(next application.VotePoll) application.VotePoll {
+	return &votePoll{next: next, tracing: t}
+}
+
+func (uc *votePoll) Execute(ctx context.Context, userID, tweetID string, option int) (*domain.Tweet, error) {
+	ctx, span := uc.tracing.start(ctx, "VotePoll")
+	tweet, err := uc.next.Execute(ctx, userID, tweetID, option)
+	finish(span, err)
+	return tweet, err
+}
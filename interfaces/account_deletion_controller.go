@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+type AccountDeletionController struct {
+	deleteUser         application.DeleteUser
+	getAccountDeletion application.GetAccountDeletion
+}
+
+func NewAccountDeletionController(deleteUser application.DeleteUser, getAccountDeletion application.GetAccountDeletion) *AccountDeletionController {
+	return &AccountDeletionController{
+		deleteUser:         deleteUser,
+		getAccountDeletion: getAccountDeletion,
+	}
+}
+
+type accountDeletionResponse struct {
+	UserID      string     `json:"user_id"`
+	Status      string     `json:"status"`
+	RequestedAt time.Time  `json:"requested_at"`
+	PurgeAt     time.Time  `json:"purge_at"`
+	Done        []string   `json:"done"`
+	Remaining   []string   `json:"remaining"`
+	Error       string     `json:"error,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
+
+func newAccountDeletionResponse(deletion *domain.AccountDeletion) accountDeletionResponse {
+	resp := accountDeletionResponse{
+		UserID:      deletion.UserID,
+		Status:      string(deletion.Status),
+		RequestedAt: deletion.RequestedAt,
+		PurgeAt:     deletion.PurgeAt,
+		Done:        []string{},
+		Remaining:   []string{},
+		Error:       deletion.Error,
+		UpdatedAt:   deletion.UpdatedAt,
+	}
+	for _, step := range domain.PurgeSteps {
+		if slices.Contains(deletion.Done, step) {
+			resp.Done = append(resp.Done, string(step))
+		} else {
+			resp.Remaining = append(resp.Remaining, string(step))
+		}
+	}
+	if deletion.Status == domain.AccountDeletionCompleted {
+		resp.FinishedAt = &deletion.FinishedAt
+	}
+	return resp
+}
+
+// DeleteUser deactivates a user right away and answers 202 Accepted with the
+// deletion, whose purge can be followed at GET /users/{id}/deletion.
+func (c *AccountDeletionController) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	deletion, err := c.deleteUser.Execute(r.Context(), r.PathValue("id"))
+	if err != nil {
+		accountDeletionError(w, err)
+		return
+	}
+	resp := newAccountDeletionResponse(deletion)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetAccountDeletion reports the progress of a deletion.
+func (c *AccountDeletionController) GetAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	deletion, err := c.getAccountDeletion.Execute(r.Context(), r.PathValue("id"))
+	if err != nil {
+		accountDeletionError(w, err)
+		return
+	}
+	resp := newAccountDeletionResponse(deletion)
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func accountDeletionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrAccountDeletionNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
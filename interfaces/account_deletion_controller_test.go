@@ -0,0 +1,118 @@
+package interfaces
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountDeletionController_DeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeleteUser := mocks.NewMockDeleteUser(ctrl)
+	accountDeletionController := NewAccountDeletionController(mockDeleteUser, mocks.NewMockGetAccountDeletion(ctrl))
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "deleted",
+			userID: "user1",
+			setup: func() {
+				deletion := domain.NewAccountDeletion("user1", now, 24*time.Hour)
+				mockDeleteUser.EXPECT().Execute(gomock.Any(), "user1").Return(deletion, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantBody: `{"user_id":"user1","status":"pending",
+				"requested_at":"2025-03-04T12:00:00Z","purge_at":"2025-03-05T12:00:00Z",
+				"done":[],"remaining":["event","tweets","scheduled_tweets","media","bookmarks","poll_votes","follows","notifications","lists","exports","user"],
+				"updated_at":"2025-03-04T12:00:00Z"}`,
+		},
+		{
+			name:   "user not found",
+			userID: "ghost",
+			setup: func() {
+				mockDeleteUser.EXPECT().Execute(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "repository error",
+			userID: "user1",
+			setup: func() {
+				mockDeleteUser.EXPECT().Execute(gomock.Any(), "user1").Return(nil, errors.New("db down")).Times(1)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodDelete, "/users/"+tt.userID, nil)
+			req.SetPathValue("id", tt.userID)
+			w := httptest.NewRecorder()
+
+			accountDeletionController.DeleteUser(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAccountDeletionController_GetAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetAccountDeletion := mocks.NewMockGetAccountDeletion(ctrl)
+	accountDeletionController := NewAccountDeletionController(mocks.NewMockDeleteUser(ctrl), mockGetAccountDeletion)
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("completed", func(t *testing.T) {
+		deletion := domain.NewAccountDeletion("user1", now, time.Hour)
+		for _, step := range domain.PurgeSteps {
+			deletion.StepDone(step, now.Add(time.Hour))
+		}
+		deletion.Complete(now.Add(time.Hour))
+		mockGetAccountDeletion.EXPECT().Execute(gomock.Any(), "user1").Return(deletion, nil).Times(1)
+		req := httptest.NewRequest(http.MethodGet, "/users/user1/deletion", nil)
+		req.SetPathValue("id", "user1")
+		w := httptest.NewRecorder()
+
+		accountDeletionController.GetAccountDeletion(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"user_id":"user1","status":"completed",
+			"requested_at":"2025-03-04T12:00:00Z","purge_at":"2025-03-04T13:00:00Z",
+			"done":["event","tweets","scheduled_tweets","media","bookmarks","poll_votes","follows","notifications","lists","exports","user"],"remaining":[],
+			"updated_at":"2025-03-04T13:00:00Z","finished_at":"2025-03-04T13:00:00Z"}`, w.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockGetAccountDeletion.EXPECT().Execute(gomock.Any(), "ghost").Return(nil, domain.ErrAccountDeletionNotFound).Times(1)
+		req := httptest.NewRequest(http.MethodGet, "/users/ghost/deletion", nil)
+		req.SetPathValue("id", "ghost")
+		w := httptest.NewRecorder()
+
+		accountDeletionController.GetAccountDeletion(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,75 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+const defaultBookmarksLimit = 20
+
+type BookmarkController struct {
+	bookmarkTweet  application.BookmarkTweet
+	removeBookmark application.RemoveBookmark
+	getBookmarks   application.GetBookmarks
+}
+
+func NewBookmarkController(bookmarkTweet application.BookmarkTweet, removeBookmark application.RemoveBookmark, getBookmarks application.GetBookmarks) *BookmarkController {
+	return &BookmarkController{
+		bookmarkTweet:  bookmarkTweet,
+		removeBookmark: removeBookmark,
+		getBookmarks:   getBookmarks,
+	}
+}
+
+func (c *BookmarkController) Bookmark(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	writeNoContent(w, c.bookmarkTweet.Execute(r.Context(), req.UserID, r.PathValue("id")))
+}
+
+func (c *BookmarkController) RemoveBookmark(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.removeBookmark.Execute(r.Context(), r.URL.Query().Get("user_id"), r.PathValue("id")))
+}
+
+// GetBookmarks returns a page of the user's bookmarked tweets, most recently
+// bookmarked first. Pass next_cursor back as cursor for the next page.
+func (c *BookmarkController) GetBookmarks(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	if userID == "" {
+		http.Error(w, "user_id parameter is required", http.StatusBadRequest)
+		return
+	}
+	limit, _, err := parsePagination(query, defaultBookmarksLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tweets, next, err := c.getBookmarks.Execute(r.Context(), userID, limit, query.Get("cursor"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Tweets     []tweetResponse `json:"tweets"`
+		NextCursor string          `json:"next_cursor,omitempty"`
+	}{
+		Tweets:     make([]tweetResponse, len(tweets)),
+		NextCursor: next,
+	}
+	for i, tweet := range tweets {
+		resp.Tweets[i] = newTweetResponse(tweet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
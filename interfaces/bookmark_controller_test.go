@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookmarkTweet := mocks.NewMockBookmarkTweet(ctrl)
+	mockRemoveBookmark := mocks.NewMockRemoveBookmark(ctrl)
+	mockGetBookmarks := mocks.NewMockGetBookmarks(ctrl)
+
+	bookmarkController := NewBookmarkController(mockBookmarkTweet, mockRemoveBookmark, mockGetBookmarks)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "bookmark",
+			method:  http.MethodPost,
+			target:  "/tweets/tweet1/bookmark",
+			body:    `{"user_id":"user1"}`,
+			handler: bookmarkController.Bookmark,
+			setup: func() {
+				mockBookmarkTweet.EXPECT().Execute(gomock.Any(), "user1", "tweet1").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:    "bookmark twice",
+			method:  http.MethodPost,
+			target:  "/tweets/tweet1/bookmark",
+			body:    `{"user_id":"user1"}`,
+			handler: bookmarkController.Bookmark,
+			setup: func() {
+				mockBookmarkTweet.EXPECT().Execute(gomock.Any(), "user1", "tweet1").Return(domain.ErrAlreadyBookmarked).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "remove bookmark",
+			method:  http.MethodDelete,
+			target:  "/tweets/tweet1/bookmark?user_id=user1",
+			handler: bookmarkController.RemoveBookmark,
+			setup: func() {
+				mockRemoveBookmark.EXPECT().Execute(gomock.Any(), "user1", "tweet1").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:    "list bookmarks",
+			method:  http.MethodGet,
+			target:  "/bookmarks?user_id=user1&limit=1&cursor=abc",
+			handler: bookmarkController.GetBookmarks,
+			setup: func() {
+				tweet := &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "hello", Timestamp: time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)}
+				mockGetBookmarks.EXPECT().Execute(gomock.Any(), "user1", 1, "abc").Return([]*domain.Tweet{tweet}, "next", nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"tweets":[{"id":"tweet1","user_id":"user2","content":"hello","timestamp":"2025-03-04 12:00:00 +0000 UTC"}],"next_cursor":"next"}`,
+		},
+		{
+			name:       "list bookmarks without user",
+			method:     http.MethodGet,
+			target:     "/bookmarks",
+			handler:    bookmarkController.GetBookmarks,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.SetPathValue("id", "tweet1")
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+type ExportController struct {
+	exportAccount    application.ExportAccount
+	getAccountExport application.GetAccountExport
+}
+
+func NewExportController(exportAccount application.ExportAccount, getAccountExport application.GetAccountExport) *ExportController {
+	return &ExportController{
+		exportAccount:    exportAccount,
+		getAccountExport: getAccountExport,
+	}
+}
+
+type accountExportResponse struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	Status     string     `json:"status"`
+	Size       int        `json:"size,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	ArchiveURL string     `json:"archive_url,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+func newAccountExportResponse(export *domain.AccountExport) accountExportResponse {
+	resp := accountExportResponse{
+		ID:        export.ID,
+		UserID:    export.UserID,
+		Status:    string(export.Status),
+		Size:      export.Size,
+		Error:     export.Error,
+		CreatedAt: export.CreatedAt,
+		UpdatedAt: export.UpdatedAt,
+	}
+	if export.Status == domain.AccountExportCompleted {
+		resp.ArchiveURL = "/exports/" + export.ID + "/archive"
+	}
+	if export.Finished() {
+		resp.FinishedAt = &export.FinishedAt
+	}
+	return resp
+}
+
+// ExportAccount downloads the archive of an account as a zip. Large accounts
+// are archived in the background instead: the answer is then 202 Accepted
+// with the export to follow at GET /exports/{id}.
+func (c *ExportController) ExportAccount(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("id")
+	export, data, err := c.exportAccount.Execute(r.Context(), userID)
+	if err != nil {
+		exportError(w, err)
+		return
+	}
+	if export == nil {
+		writeArchive(w, userID, data)
+		return
+	}
+
+	resp := newAccountExportResponse(export)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetAccountExport reports the progress of an export.
+func (c *ExportController) GetAccountExport(w http.ResponseWriter, r *http.Request) {
+	export, _, err := c.getAccountExport.Execute(r.Context(), r.PathValue("id"), false)
+	if err != nil {
+		exportError(w, err)
+		return
+	}
+	resp := newAccountExportResponse(export)
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// DownloadAccountExport downloads the archive of a completed export.
+func (c *ExportController) DownloadAccountExport(w http.ResponseWriter, r *http.Request) {
+	export, data, err := c.getAccountExport.Execute(r.Context(), r.PathValue("id"), true)
+	if err != nil {
+		exportError(w, err)
+		return
+	}
+	writeArchive(w, export.UserID, data)
+}
+
+func writeArchive(w http.ResponseWriter, userID string, data []byte) {
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", domain.ArchiveFilename(userID)))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	_, _ = w.Write(data)
+}
+
+func exportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrAccountExportNotFound),
+		errors.Is(err, domain.ErrAccountExportNotReady):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,148 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportController_ExportAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExportAccount := mocks.NewMockExportAccount(ctrl)
+	exportController := NewExportController(mockExportAccount, mocks.NewMockGetAccountExport(ctrl))
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		userID          string
+		setup           func()
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:   "archive right away",
+			userID: "user1",
+			setup: func() {
+				mockExportAccount.EXPECT().Execute(gomock.Any(), "user1").Return(nil, []byte("zip"), nil).Times(1)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/zip",
+			wantBody:        "zip",
+		},
+		{
+			name:   "archive in the background",
+			userID: "user1",
+			setup: func() {
+				export := domain.NewAccountExport("export1", "user1", now)
+				mockExportAccount.EXPECT().Execute(gomock.Any(), "user1").Return(export, nil, nil).Times(1)
+			},
+			wantStatus:      http.StatusAccepted,
+			wantContentType: "application/json",
+			wantBody: `{"id":"export1","user_id":"user1","status":"pending",
+				"created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`,
+		},
+		{
+			name:   "user not found",
+			userID: "ghost",
+			setup: func() {
+				mockExportAccount.EXPECT().Execute(gomock.Any(), "ghost").Return(nil, nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID+"/export", nil)
+			req.SetPathValue("id", tt.userID)
+			w := httptest.NewRecorder()
+
+			exportController.ExportAccount(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusBadRequest {
+				return
+			}
+			assert.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+			if tt.wantContentType == "application/json" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+				return
+			}
+			assert.Equal(t, `attachment; filename="urblog-user1.zip"`, w.Header().Get("Content-Disposition"))
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestExportController_GetAccountExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetAccountExport := mocks.NewMockGetAccountExport(ctrl)
+	exportController := NewExportController(mocks.NewMockExportAccount(ctrl), mockGetAccountExport)
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	export := domain.NewAccountExport("export1", "user1", now)
+	export.Complete(3, now.Add(time.Second))
+
+	t.Run("completed", func(t *testing.T) {
+		mockGetAccountExport.EXPECT().Execute(gomock.Any(), "export1", false).Return(export, nil, nil).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/exports/export1", nil)
+		req.SetPathValue("id", "export1")
+		w := httptest.NewRecorder()
+
+		exportController.GetAccountExport(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":"export1","user_id":"user1","status":"completed","size":3,"archive_url":"/exports/export1/archive",
+			"created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:01Z","finished_at":"2025-03-04T12:00:01Z"}`, w.Body.String())
+	})
+
+	t.Run("download", func(t *testing.T) {
+		mockGetAccountExport.EXPECT().Execute(gomock.Any(), "export1", true).Return(export, []byte("zip"), nil).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/exports/export1/archive", nil)
+		req.SetPathValue("id", "export1")
+		w := httptest.NewRecorder()
+
+		exportController.DownloadAccountExport(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Equal(t, "zip", w.Body.String())
+	})
+
+	t.Run("download before it is ready", func(t *testing.T) {
+		mockGetAccountExport.EXPECT().Execute(gomock.Any(), "export2", true).Return(nil, nil, domain.ErrAccountExportNotReady).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/exports/export2/archive", nil)
+		req.SetPathValue("id", "export2")
+		w := httptest.NewRecorder()
+
+		exportController.DownloadAccountExport(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockGetAccountExport.EXPECT().Execute(gomock.Any(), "export1", false).Return(nil, nil, assert.AnError).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/exports/export1", nil)
+		req.SetPathValue("id", "export1")
+		w := httptest.NewRecorder()
+
+		exportController.GetAccountExport(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+const defaultFollowRequestsLimit = 20
+
+// followRequestActions maps the action in the URL to the status it moves a
+// request to.
+var followRequestActions = map[string]domain.FollowRequestStatus{
+	"approve": domain.FollowRequestApproved,
+	"reject":  domain.FollowRequestRejected,
+	"cancel":  domain.FollowRequestCancelled,
+}
+
+type FollowRequestController struct {
+	getFollowRequests    application.GetFollowRequests
+	resolveFollowRequest application.ResolveFollowRequest
+}
+
+func NewFollowRequestController(getFollowRequests application.GetFollowRequests, resolveFollowRequest application.ResolveFollowRequest) *FollowRequestController {
+	return &FollowRequestController{
+		getFollowRequests:    getFollowRequests,
+		resolveFollowRequest: resolveFollowRequest,
+	}
+}
+
+type followRequestResponse struct {
+	ID         string    `json:"id"`
+	FollowerID string    `json:"follower_id"`
+	FolloweeID string    `json:"followee_id"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func newFollowRequestResponse(request *domain.FollowRequest) followRequestResponse {
+	return followRequestResponse{
+		ID:         request.ID,
+		FollowerID: request.FollowerID,
+		FolloweeID: request.FolloweeID,
+		Status:     string(request.Status),
+		CreatedAt:  request.CreatedAt,
+		UpdatedAt:  request.UpdatedAt,
+	}
+}
+
+// GetFollowRequests lists the pending follow requests a user has received.
+func (c *FollowRequestController) GetFollowRequests(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parsePagination(r.URL.Query(), defaultFollowRequestsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	requests, err := c.getFollowRequests.Execute(r.Context(), r.PathValue("id"), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		FollowRequests []followRequestResponse `json:"follow_requests"`
+	}{
+		FollowRequests: make([]followRequestResponse, len(requests)),
+	}
+	for i, request := range requests {
+		resp.FollowRequests[i] = newFollowRequestResponse(request)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ResolveFollowRequest approves, rejects or cancels a follow request on behalf
+// of the user_id in the request body.
+func (c *FollowRequestController) ResolveFollowRequest(w http.ResponseWriter, r *http.Request) {
+	status, ok := followRequestActions[r.PathValue("action")]
+	if !ok {
+		http.Error(w, domain.ErrInvalidFollowRequestAction.Error(), http.StatusBadRequest)
+		return
+	}
+	var req struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	request, err := c.resolveFollowRequest.Execute(r.Context(), req.UserID, r.PathValue("id"), status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(newFollowRequestResponse(request))
+}
@@ -0,0 +1,126 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowRequestController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetFollowRequests := mocks.NewMockGetFollowRequests(ctrl)
+	mockResolveFollowRequest := mocks.NewMockResolveFollowRequest(ctrl)
+
+	followRequestController := NewFollowRequestController(mockGetFollowRequests, mockResolveFollowRequest)
+
+	at := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	request := func(status domain.FollowRequestStatus) *domain.FollowRequest {
+		return &domain.FollowRequest{ID: "request1", FollowerID: "user1", FolloweeID: "user2", Status: status, CreatedAt: at, UpdatedAt: at}
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		query      string
+		id         string
+		action     string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "list follow requests",
+			method:  http.MethodGet,
+			query:   "?limit=5",
+			id:      "user2",
+			handler: followRequestController.GetFollowRequests,
+			setup: func() {
+				mockGetFollowRequests.EXPECT().Execute(gomock.Any(), "user2", 5, 0).Return([]*domain.FollowRequest{request(domain.FollowRequestRequested)}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"follow_requests":[{"id":"request1","follower_id":"user1","followee_id":"user2","status":"requested","created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}]}`,
+		},
+		{
+			name:       "list follow requests invalid limit",
+			method:     http.MethodGet,
+			query:      "?limit=abc",
+			id:         "user2",
+			handler:    followRequestController.GetFollowRequests,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "approve",
+			method:  http.MethodPost,
+			body:    `{"user_id":"user2"}`,
+			id:      "request1",
+			action:  "approve",
+			handler: followRequestController.ResolveFollowRequest,
+			setup: func() {
+				mockResolveFollowRequest.EXPECT().Execute(gomock.Any(), "user2", "request1", domain.FollowRequestApproved).Return(request(domain.FollowRequestApproved), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"request1","follower_id":"user1","followee_id":"user2","status":"approved","created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`,
+		},
+		{
+			name:       "unknown action",
+			method:     http.MethodPost,
+			body:       `{"user_id":"user2"}`,
+			id:         "request1",
+			action:     "ignore",
+			handler:    followRequestController.ResolveFollowRequest,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPost,
+			body:       `{`,
+			id:         "request1",
+			action:     "reject",
+			handler:    followRequestController.ResolveFollowRequest,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "resolve error",
+			method:  http.MethodPost,
+			body:    `{"user_id":"user3"}`,
+			id:      "request1",
+			action:  "reject",
+			handler: followRequestController.ResolveFollowRequest,
+			setup: func() {
+				mockResolveFollowRequest.EXPECT().Execute(gomock.Any(), "user3", "request1", domain.FollowRequestRejected).Return(nil, domain.ErrFollowRequestNotFound).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, "/"+tt.query, strings.NewReader(tt.body))
+			req.SetPathValue("id", tt.id)
+			req.SetPathValue("action", tt.action)
+			rr := httptest.NewRecorder()
+
+			tt.handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+// maxImportRequestSize leaves room for the multipart framing around a CSV of
+// application.MaxUserImportSize.
+const maxImportRequestSize = application.MaxUserImportSize + 64<<10
+
+const defaultImportRowsLimit = 100
+
+type ImportController struct {
+	importUsers  application.ImportUsers
+	getImportJob application.GetImportJob
+}
+
+func NewImportController(importUsers application.ImportUsers, getImportJob application.GetImportJob) *ImportController {
+	return &ImportController{
+		importUsers:  importUsers,
+		getImportJob: getImportJob,
+	}
+}
+
+type importRowResponse struct {
+	Line   int    `json:"line"`
+	Status string `json:"status"`
+	UserID string `json:"user_id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type importJobResponse struct {
+	ID         string              `json:"id"`
+	Status     string              `json:"status"`
+	Format     string              `json:"format"`
+	Merge      string              `json:"merge"`
+	Dangling   string              `json:"dangling"`
+	DryRun     bool                `json:"dry_run"`
+	TotalRows  int                 `json:"total_rows"`
+	Processed  int                 `json:"processed"`
+	Accepted   int                 `json:"accepted"`
+	Skipped    int                 `json:"skipped"`
+	Failed     int                 `json:"failed"`
+	Created    int                 `json:"created"`
+	Error      string              `json:"error,omitempty"`
+	Rows       []importRowResponse `json:"rows,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+	FinishedAt *time.Time          `json:"finished_at,omitempty"`
+}
+
+func newImportJobResponse(job *domain.ImportJob) importJobResponse {
+	resp := importJobResponse{
+		ID:        job.ID,
+		Status:    string(job.Status),
+		Format:    string(job.Options.Format),
+		Merge:     string(job.Options.Merge),
+		Dangling:  string(job.Options.Dangling),
+		DryRun:    job.Options.DryRun,
+		TotalRows: job.TotalRows,
+		Processed: job.Processed,
+		Accepted:  job.Accepted,
+		Skipped:   job.Skipped,
+		Failed:    job.Failed,
+		Created:   job.Created,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Finished() {
+		resp.FinishedAt = &job.FinishedAt
+	}
+	return resp
+}
+
+// ImportUsers starts importing users from a file sent either as the file
+// field of a multipart form or as the raw request body, and answers right
+// away with the job to follow at GET /imports/{id}. The format is taken from
+// the format parameter, or else detected from the file name and content type.
+// The merge parameter decides what happens to users that already exist, and
+// dangling to followed users that do not. With dry_run=true the file is only
+// checked.
+func (c *ImportController) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	var options domain.ImportOptions
+	query := r.URL.Query()
+	if v := query.Get("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "invalid dry_run parameter", http.StatusBadRequest)
+			return
+		}
+		options.DryRun = b
+	}
+	var err error
+	if v := query.Get("merge"); v != "" {
+		if options.Merge, err = domain.ParseImportMergeStrategy(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if v := query.Get("dangling"); v != "" {
+		if options.Dangling, err = domain.ParseImportDanglingPolicy(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportRequestSize)
+	var body io.Reader = r.Body
+	filename, contentType := "", r.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "multipart/form-data" {
+		file, err := formFile(r, "file")
+		if err != nil {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+		body, filename, contentType = file, file.FileName(), file.Header.Get("Content-Type")
+	}
+	if v := query.Get("format"); v != "" {
+		options.Format, err = domain.ParseImportFormat(v)
+	} else {
+		options.Format, err = domain.DetectImportFormat(filename, contentType)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := c.importUsers.Execute(r.Context(), body, options)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) || errors.Is(err, domain.ErrImportTooLarge) {
+		http.Error(w, domain.ErrImportTooLarge.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidImport) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := newImportJobResponse(job)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetImportJob reports the progress of an import with one page of its
+// per-line report, paginated with limit and offset.
+func (c *ImportController) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parsePagination(r.URL.Query(), defaultImportRowsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	job, rows, err := c.getImportJob.Execute(r.Context(), r.PathValue("id"), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := newImportJobResponse(job)
+	for _, row := range rows {
+		resp.Rows = append(resp.Rows, importRowResponse{
+			Line:   row.Line,
+			Status: string(row.Status),
+			UserID: row.UserID,
+			Reason: row.Reason,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// formFile returns the part of a multipart request holding the named file,
+// reading the form as a stream rather than buffering it like
+// http.Request.FormFile does.
+func formFile(r *http.Request, name string) (*multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == name && part.FileName() != "" {
+			return part, nil
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportController_ImportUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImportUsers := mocks.NewMockImportUsers(ctrl)
+	importController := NewImportController(mockImportUsers, mocks.NewMockGetImportJob(ctrl))
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	pending := domain.NewImportJob("job1", domain.ImportOptions{Format: domain.ImportFormatCSV}, 2, now)
+	pendingBody := `{"id":"job1","status":"pending","format":"csv","merge":"merge-follows","dangling":"reject","dry_run":false,"total_rows":2,"processed":0,"accepted":0,"skipped":0,"failed":0,"created":0,
+		"created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`
+
+	readCSV := func(csv *string) func(context.Context, io.Reader, domain.ImportOptions) {
+		return func(_ context.Context, r io.Reader, _ domain.ImportOptions) {
+			data, _ := io.ReadAll(r)
+			*csv = string(data)
+		}
+	}
+	multipartBody := func(field string) (string, string) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		writer.WriteField("note", "ignored")
+		part, _ := writer.CreateFormFile(field, "users.ndjson")
+		part.Write([]byte("user1,user2\n"))
+		writer.Close()
+		return body.String(), writer.FormDataContentType()
+	}
+
+	tests := []struct {
+		name        string
+		target      string
+		body        string
+		contentType string
+		// formField, when set, sends the CSV as that field of a multipart
+		// form instead.
+		formField  string
+		setup      func(csv *string)
+		wantStatus int
+		wantBody   string
+		wantCSV    string
+	}{
+		{
+			name:        "raw body",
+			target:      "/imports/users",
+			body:        "user1,user2\n",
+			contentType: "text/csv",
+			setup: func(csv *string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatCSV}).Do(readCSV(csv)).Return(pending, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantBody:   pendingBody,
+			wantCSV:    "user1,user2\n",
+		},
+		{
+			name:      "multipart ndjson upload as a dry run",
+			target:    "/imports/users?dry_run=true",
+			formField: "file",
+			setup: func(csv *string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatNDJSON, DryRun: true}).Do(readCSV(csv)).Return(pending, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantCSV:    "user1,user2\n",
+		},
+		{
+			name:        "format from the content type",
+			target:      "/imports/users",
+			body:        `[{"username":"user1"}]`,
+			contentType: "application/json",
+			setup: func(csv *string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatJSON}).Do(readCSV(csv)).Return(pending, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantCSV:    `[{"username":"user1"}]`,
+		},
+		{
+			name:        "format parameter",
+			target:      "/imports/users?format=edges",
+			body:        "user1,user2\n",
+			contentType: "text/csv",
+			setup: func(csv *string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatEdges}).Do(readCSV(csv)).Return(pending, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantCSV:    "user1,user2\n",
+		},
+		{
+			name:        "merge strategy and dangling policy",
+			target:      "/imports/users?merge=skip-existing&dangling=create",
+			body:        "user1,user2\n",
+			contentType: "text/csv",
+			setup: func(csv *string) {
+				options := domain.ImportOptions{Format: domain.ImportFormatCSV, Merge: domain.ImportSkipExisting, Dangling: domain.ImportDanglingCreate}
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), options).Do(readCSV(csv)).Return(pending, nil).Times(1)
+			},
+			wantStatus: http.StatusAccepted,
+			wantCSV:    "user1,user2\n",
+		},
+		{
+			name:       "unknown merge strategy",
+			target:     "/imports/users?merge=overwrite",
+			body:       "user1,user2\n",
+			setup:      func(*string) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown dangling policy",
+			target:     "/imports/users?dangling=ignore",
+			body:       "user1,user2\n",
+			setup:      func(*string) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "unsupported content type",
+			target:      "/imports/users",
+			body:        "<users/>",
+			contentType: "application/xml",
+			setup:       func(*string) {},
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:       "unsupported format parameter",
+			target:     "/imports/users?format=xml",
+			body:       "<users/>",
+			setup:      func(*string) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "file that cannot be read",
+			target:      "/imports/users",
+			body:        `{"username":"user1"}`,
+			contentType: "application/json",
+			setup: func(*string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatJSON}).Return(nil, domain.ErrInvalidImport).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "multipart without file",
+			target:     "/imports/users",
+			formField:  "other",
+			setup:      func(*string) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid dry run",
+			target:     "/imports/users?dry_run=maybe",
+			body:       "user1,user2\n",
+			setup:      func(*string) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "import too large",
+			target: "/imports/users",
+			body:   "user1,user2\n",
+			setup: func(*string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatCSV}).Return(nil, domain.ErrImportTooLarge).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "error",
+			target: "/imports/users",
+			body:   "user1,user2\n",
+			setup: func(*string) {
+				mockImportUsers.EXPECT().Execute(gomock.Any(), gomock.Any(), domain.ImportOptions{Format: domain.ImportFormatCSV}).Return(nil, assert.AnError).Times(1)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, contentType := tt.body, tt.contentType
+			if tt.formField != "" {
+				body, contentType = multipartBody(tt.formField)
+			}
+			var csv string
+			tt.setup(&csv)
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(body))
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+
+			importController.ImportUsers(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantCSV, csv)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestImportController_GetImportJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetImportJob := mocks.NewMockGetImportJob(ctrl)
+	importController := NewImportController(mocks.NewMockImportUsers(ctrl), mockGetImportJob)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	job := domain.NewImportJob("job1", domain.ImportOptions{Format: domain.ImportFormatCSV}, 2, now)
+	job.Record(domain.ImportRow{Status: domain.ImportRowAccepted})
+	job.Record(domain.ImportRow{Status: domain.ImportRowFailed})
+	job.Complete(now.Add(time.Second))
+	rows := []domain.ImportRow{
+		{JobID: "job1", Line: 1, Status: domain.ImportRowAccepted, UserID: "user1"},
+		{JobID: "job1", Line: 2, Status: domain.ImportRowFailed, Reason: "username is empty"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockGetImportJob.EXPECT().Execute(gomock.Any(), "job1", 2, 0).Return(job, rows, nil).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/imports/job1?limit=2", nil)
+		req.SetPathValue("id", "job1")
+		w := httptest.NewRecorder()
+
+		importController.GetImportJob(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":"job1","status":"completed","format":"csv","merge":"merge-follows","dangling":"reject","dry_run":false,"total_rows":2,"processed":2,"accepted":1,"skipped":0,"failed":1,"created":0,
+			"rows":[{"line":1,"status":"accepted","user_id":"user1"},{"line":2,"status":"failed","reason":"username is empty"}],
+			"created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:01Z","finished_at":"2025-03-04T12:00:01Z"}`, w.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockGetImportJob.EXPECT().Execute(gomock.Any(), "ghost", defaultImportRowsLimit, 0).Return(nil, nil, domain.ErrImportJobNotFound).Times(1)
+
+		req := httptest.NewRequest(http.MethodGet, "/imports/ghost", nil)
+		req.SetPathValue("id", "ghost")
+		w := httptest.NewRecorder()
+
+		importController.GetImportJob(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,182 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+const defaultListTimelineLimit = 20
+
+// ListController manages user-curated lists and their timelines.
+type ListController struct {
+	createList       application.CreateList
+	updateList       application.UpdateList
+	deleteList       application.DeleteList
+	addListMember    application.AddListMember
+	removeListMember application.RemoveListMember
+	getList          application.GetList
+	getUserLists     application.GetUserLists
+	getListTimeline  application.GetListTimeline
+}
+
+func NewListController(
+	createList application.CreateList,
+	updateList application.UpdateList,
+	deleteList application.DeleteList,
+	addListMember application.AddListMember,
+	removeListMember application.RemoveListMember,
+	getList application.GetList,
+	getUserLists application.GetUserLists,
+	getListTimeline application.GetListTimeline,
+) *ListController {
+	return &ListController{
+		createList:       createList,
+		updateList:       updateList,
+		deleteList:       deleteList,
+		addListMember:    addListMember,
+		removeListMember: removeListMember,
+		getList:          getList,
+		getUserLists:     getUserLists,
+		getListTimeline:  getListTimeline,
+	}
+}
+
+type listResponse struct {
+	ID        string    `json:"id"`
+	OwnerID   string    `json:"owner_id"`
+	Name      string    `json:"name"`
+	Private   bool      `json:"private"`
+	Members   []string  `json:"members"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newListResponse(list *domain.List) listResponse {
+	members := make([]string, 0, len(list.Members))
+	for member := range list.Members {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return listResponse{
+		ID:        list.ID,
+		OwnerID:   list.OwnerID,
+		Name:      list.Name,
+		Private:   list.Private,
+		Members:   members,
+		CreatedAt: list.CreatedAt,
+		UpdatedAt: list.UpdatedAt,
+	}
+}
+
+func (c *ListController) CreateList(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		OwnerID string `json:"owner_id"`
+		Name    string `json:"name"`
+		Private bool   `json:"private"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	list, err := c.createList.Execute(r.Context(), req.OwnerID, req.Name, req.Private)
+	writeList(w, list, err)
+}
+
+// GetUserLists lists the lists a user owns, as seen by the optional viewer_id.
+func (c *ListController) GetUserLists(w http.ResponseWriter, r *http.Request) {
+	lists, err := c.getUserLists.Execute(r.Context(), r.URL.Query().Get("viewer_id"), r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := struct {
+		Lists []listResponse `json:"lists"`
+	}{
+		Lists: make([]listResponse, len(lists)),
+	}
+	for i, list := range lists {
+		resp.Lists[i] = newListResponse(list)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (c *ListController) GetList(w http.ResponseWriter, r *http.Request) {
+	list, err := c.getList.Execute(r.Context(), r.URL.Query().Get("viewer_id"), r.PathValue("id"))
+	writeList(w, list, err)
+}
+
+// UpdateList renames a list or changes its visibility; fields left out of the
+// body are unchanged.
+func (c *ListController) UpdateList(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID  string  `json:"user_id"`
+		Name    *string `json:"name"`
+		Private *bool   `json:"private"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	list, err := c.updateList.Execute(r.Context(), req.UserID, r.PathValue("id"), req.Name, req.Private)
+	writeList(w, list, err)
+}
+
+func (c *ListController) DeleteList(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.deleteList.Execute(r.Context(), r.URL.Query().Get("user_id"), r.PathValue("id")))
+}
+
+func (c *ListController) AddMember(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID   string `json:"user_id"`
+		MemberID string `json:"member_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	writeNoContent(w, c.addListMember.Execute(r.Context(), req.UserID, r.PathValue("id"), req.MemberID))
+}
+
+func (c *ListController) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.removeListMember.Execute(r.Context(), r.URL.Query().Get("user_id"), r.PathValue("id"), r.PathValue("member")))
+}
+
+// GetListTimeline returns the tweets of a list's members, newest first.
+func (c *ListController) GetListTimeline(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, offset, err := parsePagination(query, defaultListTimelineLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tweets, err := c.getListTimeline.Execute(r.Context(), query.Get("viewer_id"), r.PathValue("id"), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := make([]tweetResponse, len(tweets))
+	for i, tweet := range tweets {
+		resp[i] = newTweetResponse(tweet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeList(w http.ResponseWriter, list *domain.List, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(newListResponse(list))
+}
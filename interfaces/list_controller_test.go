@@ -0,0 +1,180 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCreateList := mocks.NewMockCreateList(ctrl)
+	mockUpdateList := mocks.NewMockUpdateList(ctrl)
+	mockDeleteList := mocks.NewMockDeleteList(ctrl)
+	mockAddListMember := mocks.NewMockAddListMember(ctrl)
+	mockRemoveListMember := mocks.NewMockRemoveListMember(ctrl)
+	mockGetList := mocks.NewMockGetList(ctrl)
+	mockGetUserLists := mocks.NewMockGetUserLists(ctrl)
+	mockGetListTimeline := mocks.NewMockGetListTimeline(ctrl)
+
+	listController := NewListController(mockCreateList, mockUpdateList, mockDeleteList, mockAddListMember, mockRemoveListMember, mockGetList, mockGetUserLists, mockGetListTimeline)
+
+	at := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	list := &domain.List{ID: "list1", OwnerID: "user1", Name: "friends", Members: map[string]bool{"user3": true, "user2": true}, CreatedAt: at, UpdatedAt: at}
+	listJSON := `{"id":"list1","owner_id":"user1","name":"friends","private":false,"members":["user2","user3"],"created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		id         string
+		body       string
+		member     string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "create list",
+			method:  http.MethodPost,
+			target:  "/lists",
+			body:    `{"owner_id":"user1","name":"friends"}`,
+			handler: listController.CreateList,
+			setup: func() {
+				mockCreateList.EXPECT().Execute(gomock.Any(), "user1", "friends", false).Return(list, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   listJSON,
+		},
+		{
+			name:       "create list invalid body",
+			method:     http.MethodPost,
+			target:     "/lists",
+			body:       `{`,
+			handler:    listController.CreateList,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "user lists",
+			method:  http.MethodGet,
+			target:  "/users/user1/lists?viewer_id=user2",
+			id:      "user1",
+			handler: listController.GetUserLists,
+			setup: func() {
+				mockGetUserLists.EXPECT().Execute(gomock.Any(), "user2", "user1").Return([]*domain.List{list}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"lists":[` + listJSON + `]}`,
+		},
+		{
+			name:    "get private list of another user",
+			method:  http.MethodGet,
+			target:  "/lists/list1?viewer_id=user2",
+			handler: listController.GetList,
+			setup: func() {
+				mockGetList.EXPECT().Execute(gomock.Any(), "user2", "list1").Return(nil, domain.ErrListNotFound).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "rename list",
+			method:  http.MethodPatch,
+			target:  "/lists/list1",
+			body:    `{"user_id":"user1","name":"friends"}`,
+			handler: listController.UpdateList,
+			setup: func() {
+				mockUpdateList.EXPECT().Execute(gomock.Any(), "user1", "list1", gomock.Any(), nil).DoAndReturn(func(_ context.Context, _, _ string, name *string, _ *bool) (*domain.List, error) {
+					assert.Equal(t, "friends", *name)
+					return list, nil
+				}).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   listJSON,
+		},
+		{
+			name:    "delete list",
+			method:  http.MethodDelete,
+			target:  "/lists/list1?user_id=user1",
+			handler: listController.DeleteList,
+			setup: func() {
+				mockDeleteList.EXPECT().Execute(gomock.Any(), "user1", "list1").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:    "add member",
+			method:  http.MethodPost,
+			target:  "/lists/list1/members",
+			body:    `{"user_id":"user1","member_id":"user2"}`,
+			handler: listController.AddMember,
+			setup: func() {
+				mockAddListMember.EXPECT().Execute(gomock.Any(), "user1", "list1", "user2").Return(domain.ErrAlreadyListMember).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "remove member",
+			method:  http.MethodDelete,
+			target:  "/lists/list1/members/user2?user_id=user1",
+			member:  "user2",
+			handler: listController.RemoveMember,
+			setup: func() {
+				mockRemoveListMember.EXPECT().Execute(gomock.Any(), "user1", "list1", "user2").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:    "list timeline",
+			method:  http.MethodGet,
+			target:  "/lists/list1/timeline?viewer_id=user1&limit=5",
+			handler: listController.GetListTimeline,
+			setup: func() {
+				tweet := &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "hello", Timestamp: at}
+				mockGetListTimeline.EXPECT().Execute(gomock.Any(), "user1", "list1", 5, 0).Return([]*domain.Tweet{tweet}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"id":"tweet1","user_id":"user2","content":"hello","timestamp":"2025-03-04 12:00:00 +0000 UTC"}]`,
+		},
+		{
+			name:       "list timeline invalid offset",
+			method:     http.MethodGet,
+			target:     "/lists/list1/timeline?offset=-1",
+			handler:    listController.GetListTimeline,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			id := tt.id
+			if id == "" {
+				id = "list1"
+			}
+			req.SetPathValue("id", id)
+			req.SetPathValue("member", tt.member)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
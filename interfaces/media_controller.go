@@ -0,0 +1,111 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+// maxUploadRequestSize leaves room for the multipart framing and the other
+// form fields around a file of domain.MaxMediaSize.
+const maxUploadRequestSize = domain.MaxMediaSize + 64<<10
+
+type mediaResponse struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func newMediaResponse(id, contentType string, width, height int) mediaResponse {
+	return mediaResponse{
+		ID:           id,
+		Type:         contentType,
+		Width:        width,
+		Height:       height,
+		URL:          "/media/" + id,
+		ThumbnailURL: "/media/" + id + "/thumbnail",
+	}
+}
+
+type MediaController struct {
+	uploadMedia application.UploadMedia
+	getMedia    application.GetMedia
+}
+
+func NewMediaController(uploadMedia application.UploadMedia, getMedia application.GetMedia) *MediaController {
+	return &MediaController{
+		uploadMedia: uploadMedia,
+		getMedia:    getMedia,
+	}
+}
+
+// UploadMedia takes a multipart form with the uploader's user_id and the
+// image as file. The returned ID can be attached to tweets as media_ids.
+func (c *MediaController) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadRequestSize)
+	if err := r.ParseMultipartForm(maxUploadRequestSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, domain.ErrMediaTooLarge.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, domain.MaxMediaSize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	media, err := c.uploadMedia.Execute(r.Context(), r.FormValue("user_id"), data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := newMediaResponse(media.ID, media.ContentType, media.Width, media.Height)
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (c *MediaController) GetMedia(w http.ResponseWriter, r *http.Request) {
+	c.serveMedia(w, r, false)
+}
+
+func (c *MediaController) GetThumbnail(w http.ResponseWriter, r *http.Request) {
+	c.serveMedia(w, r, true)
+}
+
+// serveMedia writes an image with its sniffed type. Media never change once
+// uploaded, so they may be cached for good.
+func (c *MediaController) serveMedia(w http.ResponseWriter, r *http.Request, thumbnail bool) {
+	media, data, err := c.getMedia.Execute(r.Context(), r.PathValue("id"), thumbnail)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	contentType := media.ContentType
+	if thumbnail {
+		contentType = media.ThumbnailContentType
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,170 @@
+package interfaces
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newUploadRequest(t *testing.T, userID string, file []byte) *http.Request {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if userID != "" {
+		assert.NoError(t, form.WriteField("user_id", userID))
+	}
+	if file != nil {
+		part, err := form.CreateFormFile("file", "photo.png")
+		assert.NoError(t, err)
+		_, _ = part.Write(file)
+	}
+	assert.NoError(t, form.Close())
+	req := httptest.NewRequest(http.MethodPost, "/media", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return req
+}
+
+func TestMediaController_UploadMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUploadMedia := mocks.NewMockUploadMedia(ctrl)
+	mediaController := NewMediaController(mockUploadMedia, mocks.NewMockGetMedia(ctrl))
+
+	tests := []struct {
+		name       string
+		req        *http.Request
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "upload",
+			req:  newUploadRequest(t, "user1", []byte("image")),
+			setup: func() {
+				mockUploadMedia.EXPECT().Execute(gomock.Any(), "user1", []byte("image")).Return(&domain.Media{
+					ID: "media1", UserID: "user1", ContentType: "image/png", Width: 640, Height: 480,
+				}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"media1","type":"image/png","width":640,"height":480,"url":"/media/media1","thumbnail_url":"/media/media1/thumbnail"}`,
+		},
+		{
+			name: "unsupported type",
+			req:  newUploadRequest(t, "user1", []byte("hello")),
+			setup: func() {
+				mockUploadMedia.EXPECT().Execute(gomock.Any(), "user1", []byte("hello")).Return(nil, domain.ErrUnsupportedMediaType).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domain.ErrUnsupportedMediaType.Error() + "\n",
+		},
+		{
+			name:       "missing file",
+			req:        newUploadRequest(t, "user1", nil),
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "file is required\n",
+		},
+		{
+			name:       "file too large",
+			req:        newUploadRequest(t, "user1", make([]byte, domain.MaxMediaSize+128<<10)),
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domain.ErrMediaTooLarge.Error() + "\n",
+		},
+		{
+			name:       "not a multipart form",
+			req:        httptest.NewRequest(http.MethodPost, "/media", bytes.NewReader([]byte(`{}`))),
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "Invalid multipart form\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			w := httptest.NewRecorder()
+
+			mediaController.UploadMedia(w, tt.req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			} else {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestMediaController_GetMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetMedia := mocks.NewMockGetMedia(ctrl)
+	mediaController := NewMediaController(mocks.NewMockUploadMedia(ctrl), mockGetMedia)
+	media := &domain.Media{ID: "media1", ContentType: "image/gif", ThumbnailContentType: "image/png"}
+
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		setup           func()
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:    "image",
+			handler: mediaController.GetMedia,
+			setup: func() {
+				mockGetMedia.EXPECT().Execute(gomock.Any(), "media1", false).Return(media, []byte("gif"), nil).Times(1)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "image/gif",
+			wantBody:        "gif",
+		},
+		{
+			name:    "thumbnail",
+			handler: mediaController.GetThumbnail,
+			setup: func() {
+				mockGetMedia.EXPECT().Execute(gomock.Any(), "media1", true).Return(media, []byte("png"), nil).Times(1)
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "image/png",
+			wantBody:        "png",
+		},
+		{
+			name:    "not found",
+			handler: mediaController.GetMedia,
+			setup: func() {
+				mockGetMedia.EXPECT().Execute(gomock.Any(), "media1", false).Return(nil, nil, domain.ErrMediaNotFound).Times(1)
+			},
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        domain.ErrMediaNotFound.Error() + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodGet, "/media/media1", nil)
+			req.SetPathValue("id", "media1")
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, w.Body.String())
+			assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		})
+	}
+}
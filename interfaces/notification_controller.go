@@ -0,0 +1,99 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+const defaultNotificationsLimit = 20
+
+type notificationResponse struct {
+	ID         string   `json:"id"`
+	Type       string   `json:"type"`
+	TweetID    string   `json:"tweet_id,omitempty"`
+	ActorIDs   []string `json:"actor_ids"`
+	ActorCount int      `json:"actor_count"`
+	Summary    string   `json:"summary"`
+	Read       bool     `json:"read"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+func newNotificationResponse(notification *domain.Notification) notificationResponse {
+	return notificationResponse{
+		ID:         notification.ID,
+		Type:       string(notification.Type),
+		TweetID:    notification.TweetID,
+		ActorIDs:   notification.ActorIDs,
+		ActorCount: notification.ActorCount,
+		Summary:    notification.Summary(),
+		Read:       notification.Read,
+		CreatedAt:  notification.CreatedAt.String(),
+		UpdatedAt:  notification.UpdatedAt.String(),
+	}
+}
+
+type NotificationController struct {
+	getNotifications      application.GetNotifications
+	markNotificationsRead application.MarkNotificationsRead
+}
+
+func NewNotificationController(getNotifications application.GetNotifications, markNotificationsRead application.MarkNotificationsRead) *NotificationController {
+	return &NotificationController{
+		getNotifications:      getNotifications,
+		markNotificationsRead: markNotificationsRead,
+	}
+}
+
+func (c *NotificationController) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	if userID == "" {
+		http.Error(w, "user_id parameter is required", http.StatusBadRequest)
+		return
+	}
+	limit, offset, err := parsePagination(query, defaultNotificationsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	notifications, unread, err := c.getNotifications.Execute(r.Context(), userID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Notifications []notificationResponse `json:"notifications"`
+		UnreadCount   int                    `json:"unread_count"`
+	}{
+		Notifications: make([]notificationResponse, len(notifications)),
+		UnreadCount:   unread,
+	}
+	for i, notification := range notifications {
+		resp.Notifications[i] = newNotificationResponse(notification)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (c *NotificationController) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID string   `json:"user_id"`
+		IDs    []string `json:"ids,omitempty"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := c.markNotificationsRead.Execute(r.Context(), req.UserID, req.IDs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetNotifications := mocks.NewMockGetNotifications(ctrl)
+	mockMarkNotificationsRead := mocks.NewMockMarkNotificationsRead(ctrl)
+
+	notificationController := NewNotificationController(mockGetNotifications, mockMarkNotificationsRead)
+
+	notification := domain.NewNotification("n1", "user1", domain.NotificationMentioned, "tweet1", "user2")
+	notification.AddActor("user3")
+
+	t.Run("success", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/notifications?user_id=user1&limit=5&offset=10", nil)
+		w := httptest.NewRecorder()
+
+		mockGetNotifications.EXPECT().Execute(gomock.Any(), "user1", 5, 10).Return([]*domain.Notification{notification}, 1, nil).Times(1)
+
+		notificationController.GetNotifications(w, req)
+
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var body struct {
+			Notifications []struct {
+				ID         string   `json:"id"`
+				Type       string   `json:"type"`
+				TweetID    string   `json:"tweet_id"`
+				ActorIDs   []string `json:"actor_ids"`
+				ActorCount int      `json:"actor_count"`
+				Summary    string   `json:"summary"`
+				Read       bool     `json:"read"`
+			} `json:"notifications"`
+			UnreadCount int `json:"unread_count"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, body.UnreadCount)
+		assert.Len(t, body.Notifications, 1)
+		assert.Equal(t, "n1", body.Notifications[0].ID)
+		assert.Equal(t, "mentioned", body.Notifications[0].Type)
+		assert.Equal(t, "tweet1", body.Notifications[0].TweetID)
+		assert.Equal(t, []string{"user3", "user2"}, body.Notifications[0].ActorIDs)
+		assert.Equal(t, "user3 and 1 other mentioned you", body.Notifications[0].Summary)
+		assert.False(t, body.Notifications[0].Read)
+	})
+
+	t.Run("default pagination", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/notifications?user_id=user1", nil)
+		w := httptest.NewRecorder()
+
+		mockGetNotifications.EXPECT().Execute(gomock.Any(), "user1", defaultNotificationsLimit, 0).Return(nil, 0, nil).Times(1)
+
+		notificationController.GetNotifications(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"notifications":[],"unread_count":0}`, w.Body.String())
+	})
+
+	t.Run("missing user_id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/notifications", nil)
+		w := httptest.NewRecorder()
+
+		notificationController.GetNotifications(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/notifications?user_id=user1&limit=abc", nil)
+		w := httptest.NewRecorder()
+
+		notificationController.GetNotifications(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/notifications?user_id=user1", nil)
+		w := httptest.NewRecorder()
+
+		mockGetNotifications.EXPECT().Execute(gomock.Any(), "user1", defaultNotificationsLimit, 0).Return(nil, 0, assert.AnError).Times(1)
+
+		notificationController.GetNotifications(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetNotifications := mocks.NewMockGetNotifications(ctrl)
+	mockMarkNotificationsRead := mocks.NewMockMarkNotificationsRead(ctrl)
+
+	notificationController := NewNotificationController(mockGetNotifications, mockMarkNotificationsRead)
+
+	t.Run("success", func(t *testing.T) {
+		reqBody := bytes.NewBufferString(`{"user_id": "user1", "ids": ["n1"]}`)
+		req := httptest.NewRequest(http.MethodPost, "/notifications/read", reqBody)
+		w := httptest.NewRecorder()
+
+		mockMarkNotificationsRead.EXPECT().Execute(gomock.Any(), "user1", []string{"n1"}).Return(nil).Times(1)
+
+		notificationController.MarkNotificationsRead(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("invalid request body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/notifications/read", bytes.NewBufferString(`invalid`))
+		w := httptest.NewRecorder()
+
+		notificationController.MarkNotificationsRead(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		reqBody := bytes.NewBufferString(`{"user_id": "user1"}`)
+		req := httptest.NewRequest(http.MethodPost, "/notifications/read", reqBody)
+		w := httptest.NewRecorder()
+
+		mockMarkNotificationsRead.EXPECT().Execute(gomock.Any(), "user1", nil).Return(domain.ErrUserNotFound).Times(1)
+
+		notificationController.MarkNotificationsRead(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// parsePagination reads the optional limit and offset query parameters.
+func parsePagination(query url.Values, defaultLimit int) (int, int, error) {
+	limit, offset := defaultLimit, 0
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return 0, 0, fmt.Errorf("invalid limit parameter: %q", v)
+		}
+		limit = n
+	}
+	if v := query.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("invalid offset parameter: %q", v)
+		}
+		offset = n
+	}
+	return limit, offset, nil
+}
@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+type pollRequest struct {
+	Options         []string `json:"options"`
+	DurationMinutes int      `json:"duration_minutes"`
+}
+
+// newPollRequest echoes a draft back in the shape it was requested in, or
+// returns nil when there is none.
+func newPollRequest(draft *domain.PollDraft) *pollRequest {
+	if draft == nil {
+		return nil
+	}
+	return &pollRequest{
+		Options:         draft.Options,
+		DurationMinutes: int(draft.Duration / time.Minute),
+	}
+}
+
+func (r *pollRequest) draft() *domain.PollDraft {
+	return &domain.PollDraft{
+		Options:  r.Options,
+		Duration: time.Duration(r.DurationMinutes) * time.Minute,
+	}
+}
+
+type pollOptionResponse struct {
+	Text string `json:"text"`
+	// Votes is left out while the results are hidden from the reader.
+	Votes *int `json:"votes,omitempty"`
+}
+
+type pollResponse struct {
+	Options    []pollOptionResponse `json:"options"`
+	EndsAt     time.Time            `json:"ends_at"`
+	TotalVotes int                  `json:"total_votes"`
+	Closed     bool                 `json:"closed"`
+	ViewerVote *int                 `json:"viewer_vote,omitempty"`
+}
+
+func newPollResponse(poll *domain.Poll) pollResponse {
+	resp := pollResponse{
+		Options: make([]pollOptionResponse, len(poll.Options)),
+		EndsAt:  poll.EndsAt,
+	}
+	for i, option := range poll.Options {
+		resp.Options[i].Text = option
+	}
+	if results := poll.Results; results != nil {
+		resp.TotalVotes = results.TotalVotes
+		resp.Closed = results.Closed
+		if results.ViewerVote >= 0 {
+			resp.ViewerVote = &results.ViewerVote
+		}
+		for i := range results.Votes {
+			resp.Options[i].Votes = &results.Votes[i]
+		}
+	}
+	return resp
+}
+
+type PollController struct {
+	votePoll application.VotePoll
+}
+
+func NewPollController(votePoll application.VotePoll) *PollController {
+	return &PollController{
+		votePoll: votePoll,
+	}
+}
+
+// Vote records the user's vote for an option, counted from 0, and returns
+// the tweet with the results of its poll.
+func (c *PollController) Vote(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID string `json:"user_id"`
+		Option *int   `json:"option"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Option == nil {
+		http.Error(w, "option is required", http.StatusBadRequest)
+		return
+	}
+	tweet, err := c.votePoll.Execute(r.Context(), req.UserID, r.PathValue("id"), *req.Option)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := newTweetResponse(tweet)
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollController_Vote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVotePoll := mocks.NewMockVotePoll(ctrl)
+	pollController := NewPollController(mockVotePoll)
+
+	now := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	poll := &domain.Poll{Options: []string{"Yes", "No"}, EndsAt: now.Add(time.Hour)}
+	tweet := &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "Coffee?", Poll: poll, Timestamp: now}
+
+	tests := []struct {
+		name       string
+		body       string
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "vote",
+			body: `{"user_id":"user1","option":0}`,
+			setup: func() {
+				results := &domain.PollResults{Votes: []int{3, 1}, TotalVotes: 4, ViewerVote: 0}
+				mockVotePoll.EXPECT().Execute(gomock.Any(), "user1", "tweet1", 0).Return(tweet.WithPollResults(results), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: `{"id":"tweet1","user_id":"user2","content":"Coffee?","timestamp":"2025-03-04 12:00:00 +0000 UTC",
+				"poll":{"options":[{"text":"Yes","votes":3},{"text":"No","votes":1}],"ends_at":"2025-03-04T13:00:00Z","total_votes":4,"closed":false,"viewer_vote":0}}`,
+		},
+		{
+			name: "already voted",
+			body: `{"user_id":"user1","option":1}`,
+			setup: func() {
+				mockVotePoll.EXPECT().Execute(gomock.Any(), "user1", "tweet1", 1).Return(nil, domain.ErrAlreadyVoted).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing option",
+			body:       `{"user_id":"user1"}`,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid body",
+			body:       `{`,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodPost, "/tweets/tweet1/poll/votes", strings.NewReader(tt.body))
+			req.SetPathValue("id", "tweet1")
+			w := httptest.NewRecorder()
+
+			pollController.Vote(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNewPollResponse_HiddenResults(t *testing.T) {
+	poll := &domain.Poll{
+		Options: []string{"Yes", "No"},
+		EndsAt:  time.Date(2025, 3, 4, 13, 0, 0, 0, time.UTC),
+		Results: &domain.PollResults{TotalVotes: 4, ViewerVote: -1},
+	}
+
+	resp := newPollResponse(poll)
+
+	assert.Equal(t, 4, resp.TotalVotes)
+	assert.Nil(t, resp.ViewerVote)
+	for _, option := range resp.Options {
+		assert.Nil(t, option.Votes)
+	}
+}
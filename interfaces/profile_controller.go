@@ -0,0 +1,61 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+const defaultUserTweetsLimit = 20
+
+type ProfileController struct {
+	getUserTweets     application.GetUserTweets
+	setAccountPrivacy application.SetAccountPrivacy
+}
+
+func NewProfileController(getUserTweets application.GetUserTweets, setAccountPrivacy application.SetAccountPrivacy) *ProfileController {
+	return &ProfileController{
+		getUserTweets:     getUserTweets,
+		setAccountPrivacy: setAccountPrivacy,
+	}
+}
+
+// GetUserTweets lists a user's tweets as seen by the optional viewer_id.
+func (c *ProfileController) GetUserTweets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, offset, err := parsePagination(query, defaultUserTweetsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tweets, err := c.getUserTweets.Execute(r.Context(), query.Get("viewer_id"), r.PathValue("id"), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := make([]tweetResponse, len(tweets))
+	for i, tweet := range tweets {
+		resp[i] = newTweetResponse(tweet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// SetPrivacy protects or unprotects a user's account.
+func (c *ProfileController) SetPrivacy(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Protected bool `json:"protected"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := c.setAccountPrivacy.Execute(r.Context(), r.PathValue("id"), req.Protected); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,91 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetUserTweets := mocks.NewMockGetUserTweets(ctrl)
+	mockSetAccountPrivacy := mocks.NewMockSetAccountPrivacy(ctrl)
+
+	profileController := NewProfileController(mockGetUserTweets, mockSetAccountPrivacy)
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		query      string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "user tweets",
+			method:  http.MethodGet,
+			query:   "?viewer_id=user2",
+			handler: profileController.GetUserTweets,
+			setup: func() {
+				tweet := &domain.Tweet{ID: "tweet1", UserID: "user1", Content: "hello", Timestamp: time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)}
+				mockGetUserTweets.EXPECT().Execute(gomock.Any(), "user2", "user1", 20, 0).Return([]*domain.Tweet{tweet}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "protected account",
+			method:  http.MethodGet,
+			handler: profileController.GetUserTweets,
+			setup: func() {
+				mockGetUserTweets.EXPECT().Execute(gomock.Any(), "", "user1", 20, 0).Return(nil, domain.ErrProtectedAccount).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domain.ErrProtectedAccount.Error() + "\n",
+		},
+		{
+			name:    "protect account",
+			method:  http.MethodPut,
+			body:    `{"protected":true}`,
+			handler: profileController.SetPrivacy,
+			setup: func() {
+				mockSetAccountPrivacy.EXPECT().Execute(gomock.Any(), "user1", true).Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPut,
+			body:       `{`,
+			handler:    profileController.SetPrivacy,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, "/"+tt.query, strings.NewReader(tt.body))
+			req.SetPathValue("id", "user1")
+			rr := httptest.NewRecorder()
+
+			tt.handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+// RelationshipController manages the users a user has blocked or muted.
+type RelationshipController struct {
+	blockUser        application.BlockUser
+	unblockUser      application.UnblockUser
+	listBlockedUsers application.ListBlockedUsers
+	muteUser         application.MuteUser
+	unmuteUser       application.UnmuteUser
+	listMutedUsers   application.ListMutedUsers
+}
+
+func NewRelationshipController(
+	blockUser application.BlockUser,
+	unblockUser application.UnblockUser,
+	listBlockedUsers application.ListBlockedUsers,
+	muteUser application.MuteUser,
+	unmuteUser application.UnmuteUser,
+	listMutedUsers application.ListMutedUsers,
+) *RelationshipController {
+	return &RelationshipController{
+		blockUser:        blockUser,
+		unblockUser:      unblockUser,
+		listBlockedUsers: listBlockedUsers,
+		muteUser:         muteUser,
+		unmuteUser:       unmuteUser,
+		listMutedUsers:   listMutedUsers,
+	}
+}
+
+func (c *RelationshipController) GetBlocks(w http.ResponseWriter, r *http.Request) {
+	userIDs, err := c.listBlockedUsers.Execute(r.Context(), r.PathValue("id"))
+	writeUserIDs(w, "blocked", userIDs, err)
+}
+
+func (c *RelationshipController) Block(w http.ResponseWriter, r *http.Request) {
+	c.applyToTarget(w, r, c.blockUser.Execute)
+}
+
+func (c *RelationshipController) Unblock(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.unblockUser.Execute(r.Context(), r.PathValue("id"), r.PathValue("target")))
+}
+
+func (c *RelationshipController) GetMutes(w http.ResponseWriter, r *http.Request) {
+	userIDs, err := c.listMutedUsers.Execute(r.Context(), r.PathValue("id"))
+	writeUserIDs(w, "muted", userIDs, err)
+}
+
+func (c *RelationshipController) Mute(w http.ResponseWriter, r *http.Request) {
+	c.applyToTarget(w, r, c.muteUser.Execute)
+}
+
+func (c *RelationshipController) Unmute(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.unmuteUser.Execute(r.Context(), r.PathValue("id"), r.PathValue("target")))
+}
+
+// applyToTarget runs action for the user in the path and the user_id in the
+// request body.
+func (c *RelationshipController) applyToTarget(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, userID, targetID string) error) {
+	var req struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	writeNoContent(w, action(r.Context(), r.PathValue("id"), req.UserID))
+}
+
+func writeNoContent(w http.ResponseWriter, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeUserIDs(w http.ResponseWriter, key string, userIDs []string, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := map[string][]string{key: userIDs}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
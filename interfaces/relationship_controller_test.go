@@ -0,0 +1,124 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelationshipController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockUser := mocks.NewMockBlockUser(ctrl)
+	mockUnblockUser := mocks.NewMockUnblockUser(ctrl)
+	mockListBlockedUsers := mocks.NewMockListBlockedUsers(ctrl)
+	mockMuteUser := mocks.NewMockMuteUser(ctrl)
+	mockUnmuteUser := mocks.NewMockUnmuteUser(ctrl)
+	mockListMutedUsers := mocks.NewMockListMutedUsers(ctrl)
+
+	relationshipController := NewRelationshipController(mockBlockUser, mockUnblockUser, mockListBlockedUsers, mockMuteUser, mockUnmuteUser, mockListMutedUsers)
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		target     string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "list blocked users",
+			method:  http.MethodGet,
+			handler: relationshipController.GetBlocks,
+			setup: func() {
+				mockListBlockedUsers.EXPECT().Execute(gomock.Any(), "user1").Return([]string{"user2"}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"blocked":["user2"]}`,
+		},
+		{
+			name:    "block",
+			method:  http.MethodPost,
+			body:    `{"user_id":"user2"}`,
+			handler: relationshipController.Block,
+			setup: func() {
+				mockBlockUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "block invalid body",
+			method:     http.MethodPost,
+			body:       `{`,
+			handler:    relationshipController.Block,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "unblock not blocked",
+			method:  http.MethodDelete,
+			target:  "user2",
+			handler: relationshipController.Unblock,
+			setup: func() {
+				mockUnblockUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(domain.ErrNotBlocked).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "list muted users",
+			method:  http.MethodGet,
+			handler: relationshipController.GetMutes,
+			setup: func() {
+				mockListMutedUsers.EXPECT().Execute(gomock.Any(), "user1").Return([]string{}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"muted":[]}`,
+		},
+		{
+			name:    "mute",
+			method:  http.MethodPost,
+			body:    `{"user_id":"user2"}`,
+			handler: relationshipController.Mute,
+			setup: func() {
+				mockMuteUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:    "unmute",
+			method:  http.MethodDelete,
+			target:  "user2",
+			handler: relationshipController.Unmute,
+			setup: func() {
+				mockUnmuteUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, "/users/user1/blocks", strings.NewReader(tt.body))
+			req.SetPathValue("id", "user1")
+			req.SetPathValue("target", tt.target)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
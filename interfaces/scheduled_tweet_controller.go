@@ -0,0 +1,112 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+const defaultScheduledTweetsLimit = 20
+
+// ScheduledTweetController lists, edits and cancels scheduled tweets. Tweets
+// are scheduled through TweetController.CreateTweet.
+type ScheduledTweetController struct {
+	getScheduledTweets   application.GetScheduledTweets
+	updateScheduledTweet application.UpdateScheduledTweet
+	cancelScheduledTweet application.CancelScheduledTweet
+}
+
+func NewScheduledTweetController(getScheduledTweets application.GetScheduledTweets, updateScheduledTweet application.UpdateScheduledTweet, cancelScheduledTweet application.CancelScheduledTweet) *ScheduledTweetController {
+	return &ScheduledTweetController{
+		getScheduledTweets:   getScheduledTweets,
+		updateScheduledTweet: updateScheduledTweet,
+		cancelScheduledTweet: cancelScheduledTweet,
+	}
+}
+
+type scheduledTweetResponse struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	Content   string       `json:"content"`
+	MediaIDs  []string     `json:"media_ids,omitempty"`
+	Poll      *pollRequest `json:"poll,omitempty"`
+	PublishAt time.Time    `json:"publish_at"`
+	Status    string       `json:"status"`
+	TweetID   string       `json:"tweet_id,omitempty"`
+	Error     string       `json:"error,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+func newScheduledTweetResponse(tweet *domain.ScheduledTweet) scheduledTweetResponse {
+	return scheduledTweetResponse{
+		ID:        tweet.ID,
+		UserID:    tweet.UserID,
+		Content:   tweet.Content,
+		MediaIDs:  tweet.Attachments.MediaIDs,
+		Poll:      newPollRequest(tweet.Attachments.Poll),
+		PublishAt: tweet.PublishAt,
+		Status:    string(tweet.Status),
+		TweetID:   tweet.TweetID,
+		Error:     tweet.Error,
+		CreatedAt: tweet.CreatedAt,
+		UpdatedAt: tweet.UpdatedAt,
+	}
+}
+
+// GetScheduledTweets lists a user's pending scheduled tweets, soonest first.
+func (c *ScheduledTweetController) GetScheduledTweets(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parsePagination(r.URL.Query(), defaultScheduledTweetsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tweets, err := c.getScheduledTweets.Execute(r.Context(), r.PathValue("id"), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		ScheduledTweets []scheduledTweetResponse `json:"scheduled_tweets"`
+	}{
+		ScheduledTweets: make([]scheduledTweetResponse, len(tweets)),
+	}
+	for i, tweet := range tweets {
+		resp.ScheduledTweets[i] = newScheduledTweetResponse(tweet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UpdateScheduledTweet changes the content or publish time of a pending
+// scheduled tweet; fields left out of the body are unchanged.
+func (c *ScheduledTweetController) UpdateScheduledTweet(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID    string     `json:"user_id"`
+		Content   *string    `json:"content"`
+		PublishAt *time.Time `json:"publish_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	tweet, err := c.updateScheduledTweet.Execute(r.Context(), req.UserID, r.PathValue("id"), req.Content, req.PublishAt)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(newScheduledTweetResponse(tweet))
+}
+
+func (c *ScheduledTweetController) CancelScheduledTweet(w http.ResponseWriter, r *http.Request) {
+	writeNoContent(w, c.cancelScheduledTweet.Execute(r.Context(), r.URL.Query().Get("user_id"), r.PathValue("id")))
+}
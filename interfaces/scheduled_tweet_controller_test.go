@@ -0,0 +1,117 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledTweetController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetScheduledTweets := mocks.NewMockGetScheduledTweets(ctrl)
+	mockUpdateScheduledTweet := mocks.NewMockUpdateScheduledTweet(ctrl)
+	mockCancelScheduledTweet := mocks.NewMockCancelScheduledTweet(ctrl)
+
+	scheduledTweetController := NewScheduledTweetController(mockGetScheduledTweets, mockUpdateScheduledTweet, mockCancelScheduledTweet)
+
+	publishAt := time.Date(2025, 3, 5, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	scheduled := &domain.ScheduledTweet{ID: "scheduled1", UserID: "user1", Content: "hello", PublishAt: publishAt, Status: domain.ScheduledTweetPending, CreatedAt: createdAt, UpdatedAt: createdAt}
+	scheduledJSON := `{"id":"scheduled1","user_id":"user1","content":"hello","publish_at":"2025-03-05T09:00:00Z","status":"pending","created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		id         string
+		body       string
+		handler    http.HandlerFunc
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:    "list",
+			method:  http.MethodGet,
+			target:  "/users/user1/scheduled-tweets?limit=5&offset=5",
+			id:      "user1",
+			handler: scheduledTweetController.GetScheduledTweets,
+			setup: func() {
+				mockGetScheduledTweets.EXPECT().Execute(gomock.Any(), "user1", 5, 5).Return([]*domain.ScheduledTweet{scheduled}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"scheduled_tweets":[` + scheduledJSON + `]}`,
+		},
+		{
+			name:    "edit",
+			method:  http.MethodPatch,
+			target:  "/scheduled-tweets/scheduled1",
+			id:      "scheduled1",
+			body:    `{"user_id":"user1","publish_at":"2025-03-05T09:00:00Z"}`,
+			handler: scheduledTweetController.UpdateScheduledTweet,
+			setup: func() {
+				mockUpdateScheduledTweet.EXPECT().Execute(gomock.Any(), "user1", "scheduled1", nil, &publishAt).Return(scheduled, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   scheduledJSON,
+		},
+		{
+			name:    "edit after publishing",
+			method:  http.MethodPatch,
+			target:  "/scheduled-tweets/scheduled1",
+			id:      "scheduled1",
+			body:    `{"user_id":"user1","content":"bye"}`,
+			handler: scheduledTweetController.UpdateScheduledTweet,
+			setup: func() {
+				mockUpdateScheduledTweet.EXPECT().Execute(gomock.Any(), "user1", "scheduled1", gomock.Any(), nil).Return(nil, domain.ErrScheduledTweetNotPending).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "edit invalid body",
+			method:     http.MethodPatch,
+			target:     "/scheduled-tweets/scheduled1",
+			id:         "scheduled1",
+			body:       `{"publish_at":"tomorrow"}`,
+			handler:    scheduledTweetController.UpdateScheduledTweet,
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "cancel",
+			method:  http.MethodDelete,
+			target:  "/scheduled-tweets/scheduled1?user_id=user1",
+			id:      "scheduled1",
+			handler: scheduledTweetController.CancelScheduledTweet,
+			setup: func() {
+				mockCancelScheduledTweet.EXPECT().Execute(gomock.Any(), "user1", "scheduled1").Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.SetPathValue("id", tt.id)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+)
+
+const defaultSearchLimit = 20
+
+type SearchController struct {
+	searchTweets application.SearchTweets
+}
+
+func NewSearchController(searchTweets application.SearchTweets) *SearchController {
+	return &SearchController{
+		searchTweets: searchTweets,
+	}
+}
+
+func (c *SearchController) SearchTweets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	q := query.Get("q")
+	if q == "" {
+		http.Error(w, "q parameter is required", http.StatusBadRequest)
+		return
+	}
+	limit, _, err := parsePagination(query, defaultSearchLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tweets, next, err := c.searchTweets.Execute(r.Context(), q, domain.SearchSort(query.Get("sort")), limit, query.Get("cursor"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Tweets     []tweetResponse `json:"tweets"`
+		NextCursor string          `json:"next_cursor,omitempty"`
+	}{
+		Tweets:     make([]tweetResponse, len(tweets)),
+		NextCursor: next,
+	}
+	for i, tweet := range tweets {
+		resp.Tweets[i] = newTweetResponse(tweet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
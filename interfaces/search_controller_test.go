@@ -0,0 +1,85 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTweets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSearchTweets := mocks.NewMockSearchTweets(ctrl)
+
+	searchController := NewSearchController(mockSearchTweets)
+
+	tweet := &domain.Tweet{
+		ID:        "tweet1",
+		UserID:    "user1",
+		Content:   "Hola mundo",
+		Timestamp: time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		url        string
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "success",
+			url:  "/search/tweets?q=hola&sort=relevance&limit=1&cursor=abc",
+			setup: func() {
+				mockSearchTweets.EXPECT().Execute(gomock.Any(), "hola", domain.SearchByRelevance, 1, "abc").Return([]*domain.Tweet{tweet}, "def", nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"tweets":[{"id":"tweet1","user_id":"user1","content":"Hola mundo","timestamp":"2025-03-04 12:00:00 +0000 UTC"}],"next_cursor":"def"}`,
+		},
+		{
+			name: "no results",
+			url:  "/search/tweets?q=hola",
+			setup: func() {
+				mockSearchTweets.EXPECT().Execute(gomock.Any(), "hola", domain.SearchSort(""), defaultSearchLimit, "").Return(nil, "", nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"tweets":[]}`,
+		},
+		{
+			name:       "missing q",
+			url:        "/search/tweets",
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid query",
+			url:  "/search/tweets?q=since:ayer",
+			setup: func() {
+				mockSearchTweets.EXPECT().Execute(gomock.Any(), "since:ayer", domain.SearchSort(""), defaultSearchLimit, "").Return(nil, "", domain.ErrInvalidSearchQuery).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+
+			searchController.SearchTweets(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
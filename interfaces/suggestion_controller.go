@@ -0,0 +1,58 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+const defaultSuggestionsLimit = 10
+
+type SuggestionController struct {
+	suggestUsers application.SuggestUsers
+}
+
+func NewSuggestionController(suggestUsers application.SuggestUsers) *SuggestionController {
+	return &SuggestionController{
+		suggestUsers: suggestUsers,
+	}
+}
+
+type suggestionResponse struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	MutualCount int      `json:"mutual_count"`
+	Mutuals     []string `json:"mutuals"`
+}
+
+func (c *SuggestionController) GetSuggestions(w http.ResponseWriter, r *http.Request) {
+	limit, _, err := parsePagination(r.URL.Query(), defaultSuggestionsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	suggestions, err := c.suggestUsers.Execute(r.Context(), r.PathValue("id"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Suggestions []suggestionResponse `json:"suggestions"`
+	}{
+		Suggestions: make([]suggestionResponse, len(suggestions)),
+	}
+	for i, suggestion := range suggestions {
+		resp.Suggestions[i] = suggestionResponse{
+			UserID:      suggestion.UserID,
+			Username:    suggestion.Username,
+			MutualCount: suggestion.MutualCount,
+			Mutuals:     suggestion.Mutuals,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSuggestions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSuggestUsers := mocks.NewMockSuggestUsers(ctrl)
+
+	suggestionController := NewSuggestionController(mockSuggestUsers)
+
+	tests := []struct {
+		name       string
+		url        string
+		userID     string
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "success",
+			url:    "/users/user1/suggestions?limit=5",
+			userID: "user1",
+			setup: func() {
+				mockSuggestUsers.EXPECT().Execute(gomock.Any(), "user1", 5).Return([]domain.Suggestion{
+					{UserID: "user4", Username: "user4", MutualCount: 2, Mutuals: []string{"user2", "user3"}},
+				}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"suggestions":[{"user_id":"user4","username":"user4","mutual_count":2,"mutuals":["user2","user3"]}]}`,
+		},
+		{
+			name:   "default limit",
+			url:    "/users/user1/suggestions",
+			userID: "user1",
+			setup: func() {
+				mockSuggestUsers.EXPECT().Execute(gomock.Any(), "user1", defaultSuggestionsLimit).Return(nil, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"suggestions":[]}`,
+		},
+		{
+			name:   "user not found",
+			url:    "/users/ghost/suggestions",
+			userID: "ghost",
+			setup: func() {
+				mockSuggestUsers.EXPECT().Execute(gomock.Any(), "ghost", defaultSuggestionsLimit).Return(nil, domain.ErrUserNotFound).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.SetPathValue("id", tt.userID)
+			w := httptest.NewRecorder()
+
+			suggestionController.GetSuggestions(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
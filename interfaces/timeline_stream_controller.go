@@ -0,0 +1,94 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+type TimelineStreamController struct {
+	streamTimeline application.StreamTimeline
+	heartbeat      time.Duration
+	closing        chan struct{}
+	closeOnce      sync.Once
+}
+
+func NewTimelineStreamController(streamTimeline application.StreamTimeline, heartbeat time.Duration) *TimelineStreamController {
+	return &TimelineStreamController{
+		streamTimeline: streamTimeline,
+		heartbeat:      heartbeat,
+		closing:        make(chan struct{}),
+	}
+}
+
+// Shutdown ends the streams being served and refuses new ones, so that the
+// server does not wait for their clients to disconnect before it stops. The
+// clients reconnect with their Last-Event-ID, to another server.
+func (c *TimelineStreamController) Shutdown() {
+	c.closeOnce.Do(func() { close(c.closing) })
+}
+
+// StreamTimeline pushes new tweets from followed accounts as Server-Sent
+// Events until the client disconnects. Clients reconnecting with
+// Last-Event-ID receive the tweets they missed, as far as the hub retains them.
+func (c *TimelineStreamController) StreamTimeline(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	select {
+	case <-c.closing:
+		http.Error(w, "shutting down", http.StatusServiceUnavailable)
+		return
+	default:
+	}
+
+	sub, err := c.streamTimeline.Subscribe(r.Context(), r.PathValue("id"), r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(c.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.closing:
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-sub.Events:
+			if !ok {
+				// The hub dropped us for falling behind; the client will
+				// reconnect and resume from its Last-Event-ID.
+				return
+			}
+			data, err := json.Marshal(newTweetResponse(event.Tweet))
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: tweet\ndata: %s\n\n", event.ID, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
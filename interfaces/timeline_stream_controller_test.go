@@ -0,0 +1,83 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamTimeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	user := domain.NewUser("user1", "user1")
+	user.Following["user2"] = true
+
+	t.Run("streams tweets until the client disconnects", func(t *testing.T) {
+		hub := application.NewTimelineHub(userRepo, 10, 10)
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "missed"}})
+		hub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet2", UserID: "user2", Content: "resumed"}})
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		controller := NewTimelineStreamController(hub, time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodGet, "/users/user1/timeline/stream", nil).WithContext(ctx)
+		req.SetPathValue("id", "user1")
+		req.Header.Set("Last-Event-ID", "1")
+		w := httptest.NewRecorder()
+
+		time.AfterFunc(20*time.Millisecond, cancel)
+		controller.StreamTimeline(w, req)
+
+		body := w.Body.String()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Contains(t, body, "id: 2\nevent: tweet\ndata: {\"id\":\"tweet2\"")
+		assert.NotContains(t, body, "tweet1")
+		assert.Contains(t, body, ": heartbeat\n\n")
+	})
+
+	t.Run("shutdown ends streams and refuses new ones", func(t *testing.T) {
+		hub := application.NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user, nil).Times(1)
+		controller := NewTimelineStreamController(hub, time.Second)
+
+		req := httptest.NewRequest(http.MethodGet, "/users/user1/timeline/stream", nil)
+		req.SetPathValue("id", "user1")
+		w := httptest.NewRecorder()
+
+		time.AfterFunc(20*time.Millisecond, controller.Shutdown)
+		controller.StreamTimeline(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		controller.StreamTimeline(w, req)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		hub := application.NewTimelineHub(userRepo, 10, 10)
+		userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).Times(1)
+		controller := NewTimelineStreamController(hub, time.Second)
+
+		req := httptest.NewRequest(http.MethodGet, "/users/ghost/timeline/stream", nil)
+		req.SetPathValue("id", "ghost")
+		w := httptest.NewRecorder()
+
+		controller.StreamTimeline(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.True(t, strings.HasPrefix(w.Body.String(), domain.ErrUserNotFound.Error()))
+	})
+}
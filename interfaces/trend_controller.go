@@ -0,0 +1,64 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+
+	"github.com/pedro00627/urblog/application"
+)
+
+const defaultTrendsLimit = 10
+
+type TrendController struct {
+	getTrends application.GetTrends
+}
+
+func NewTrendController(getTrends application.GetTrends) *TrendController {
+	return &TrendController{
+		getTrends: getTrends,
+	}
+}
+
+type trendResponse struct {
+	Hashtag  string  `json:"hashtag"`
+	Count    float64 `json:"count"`
+	Expected float64 `json:"expected"`
+	Score    float64 `json:"score"`
+}
+
+func (c *TrendController) GetTrends(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, _, err := parsePagination(query, defaultTrendsLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	trends, err := c.getTrends.Execute(r.Context(), query.Get("window"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Trends []trendResponse `json:"trends"`
+	}{
+		Trends: make([]trendResponse, len(trends)),
+	}
+	for i, trend := range trends {
+		resp.Trends[i] = trendResponse{
+			Hashtag:  trend.Hashtag,
+			Count:    round2(trend.Count),
+			Expected: round2(trend.Expected),
+			Score:    round2(trend.Score),
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+_:
+	json.NewEncoder(w).Encode(resp)
+}
+
+func round2(x float64) float64 {
+	return math.Round(x*100) / 100
+}
@@ -0,0 +1,79 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pedro00627/urblog/application/mocks"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTrends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetTrends := mocks.NewMockGetTrends(ctrl)
+
+	trendController := NewTrendController(mockGetTrends)
+
+	tests := []struct {
+		name       string
+		url        string
+		setup      func()
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "success",
+			url:  "/trends?window=24h&limit=5",
+			setup: func() {
+				mockGetTrends.EXPECT().Execute(gomock.Any(), "24h", 5).Return([]domain.Trend{
+					{Hashtag: "#launch", Count: 5.8312, Expected: 0.0417, Score: 5.5266},
+				}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"trends":[{"hashtag":"#launch","count":5.83,"expected":0.04,"score":5.53}]}`,
+		},
+		{
+			name: "default window",
+			url:  "/trends",
+			setup: func() {
+				mockGetTrends.EXPECT().Execute(gomock.Any(), "", defaultTrendsLimit).Return(nil, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"trends":[]}`,
+		},
+		{
+			name: "unknown window",
+			url:  "/trends?window=7d",
+			setup: func() {
+				mockGetTrends.EXPECT().Execute(gomock.Any(), "7d", defaultTrendsLimit).Return(nil, domain.ErrInvalidTrendWindow).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit",
+			url:        "/trends?limit=abc",
+			setup:      func() {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+
+			trendController.GetTrends(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
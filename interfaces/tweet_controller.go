@@ -3,54 +3,112 @@ package interfaces
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
 )
 
+type entityResponse struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Start  int    `json:"start"`
+	End    int    `json:"end"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+type tweetResponse struct {
+	ID        string           `json:"id"`
+	UserID    string           `json:"user_id"`
+	Content   string           `json:"content"`
+	Entities  []entityResponse `json:"entities,omitempty"`
+	Media     []mediaResponse  `json:"media,omitempty"`
+	Poll      *pollResponse    `json:"poll,omitempty"`
+	Timestamp string           `json:"timestamp"`
+}
+
+func newTweetResponse(tweet *domain.Tweet) tweetResponse {
+	resp := tweetResponse{
+		ID:        tweet.ID,
+		UserID:    tweet.UserID,
+		Content:   tweet.Content,
+		Timestamp: tweet.Timestamp.String(),
+	}
+	for _, entity := range tweet.Entities {
+		resp.Entities = append(resp.Entities, entityResponse{
+			Type:   string(entity.Type),
+			Text:   entity.Text,
+			Start:  entity.Start,
+			End:    entity.End,
+			UserID: entity.UserID,
+		})
+	}
+	for _, media := range tweet.Media {
+		resp.Media = append(resp.Media, newMediaResponse(media.ID, media.ContentType, media.Width, media.Height))
+	}
+	if tweet.Poll != nil {
+		poll := newPollResponse(tweet.Poll)
+		resp.Poll = &poll
+	}
+	return resp
+}
+
 type TweetController struct {
-	createTweet application.CreateTweet
+	createTweet   application.CreateTweet
+	scheduleTweet application.ScheduleTweet
 }
 
-func NewTweetController(createTweet application.CreateTweet) *TweetController {
+func NewTweetController(createTweet application.CreateTweet, scheduleTweet application.ScheduleTweet) *TweetController {
 	return &TweetController{
-		createTweet: createTweet,
+		createTweet:   createTweet,
+		scheduleTweet: scheduleTweet,
 	}
 }
 
 // @Summary Create a new tweet
-// @Description Create a new tweet with the given content
+// @Description Create a new tweet with the given content and uploaded media or a poll, or schedule it when publish_at is set
 // @Tags tweets
 // @Accept  json
 // @Produce  json
 // @Param   tweet  body  Tweet  true  "Tweet content"
 // @Success 200 {object} Tweet
+// @Success 202 {object} ScheduledTweet
 // @Failure 400 {object} ErrorResponse
 // @Router /tweets [post]
 func (c *TweetController) CreateTweet(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		UserID  string `json:"user_id"`
-		Content string `json:"content"`
+		UserID    string       `json:"user_id"`
+		Content   string       `json:"content"`
+		MediaIDs  []string     `json:"media_ids"`
+		Poll      *pollRequest `json:"poll"`
+		PublishAt *time.Time   `json:"publish_at"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	tweet, err := c.createTweet.Execute(req.UserID, req.Content)
+	attachments := domain.TweetAttachments{MediaIDs: req.MediaIDs}
+	if req.Poll != nil {
+		attachments.Poll = req.Poll.draft()
+	}
+	if req.PublishAt != nil {
+		scheduled, err := c.scheduleTweet.Execute(r.Context(), req.UserID, req.Content, attachments, *req.PublishAt)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+	_:
+		json.NewEncoder(w).Encode(newScheduledTweetResponse(scheduled))
+		return
+	}
+	tweet, err := c.createTweet.Execute(r.Context(), req.UserID, req.Content, attachments)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	resp := struct {
-		ID        string `json:"id"`
-		UserID    string `json:"user_id"`
-		Content   string `json:"content"`
-		Timestamp string `json:"timestamp"`
-	}{
-		ID:        tweet.ID,
-		UserID:    tweet.UserID,
-		Content:   tweet.Content,
-		Timestamp: tweet.Timestamp.String(),
-	}
+	resp := newTweetResponse(tweet)
 	w.Header().Set("Content-Type", "application/json")
 _:
 	json.NewEncoder(w).Encode(resp)
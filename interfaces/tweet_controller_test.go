@@ -16,7 +16,8 @@ import (
 
 func TestNewTweetController(t *testing.T) {
 	type args struct {
-		createTweet application.CreateTweet
+		createTweet   application.CreateTweet
+		scheduleTweet application.ScheduleTweet
 	}
 	tests := []struct {
 		name string
@@ -26,10 +27,12 @@ func TestNewTweetController(t *testing.T) {
 		{
 			name: "valid createTweet",
 			args: args{
-				createTweet: &application.CreateTweetUseCase{},
+				createTweet:   &application.CreateTweetUseCase{},
+				scheduleTweet: &application.ScheduleTweetUseCase{},
 			},
 			want: &TweetController{
-				createTweet: &application.CreateTweetUseCase{},
+				createTweet:   &application.CreateTweetUseCase{},
+				scheduleTweet: &application.ScheduleTweetUseCase{},
 			},
 		},
 		{
@@ -44,7 +47,7 @@ func TestNewTweetController(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equalf(t, tt.want, NewTweetController(tt.args.createTweet), "NewTweetController(%v)", tt.args.createTweet)
+			assert.Equalf(t, tt.want, NewTweetController(tt.args.createTweet, tt.args.scheduleTweet), "NewTweetController(%v, %v)", tt.args.createTweet, tt.args.scheduleTweet)
 		})
 	}
 }
@@ -54,7 +57,8 @@ func TestTweetController_CreateTweet(t *testing.T) {
 	defer ctrl.Finish()
 
 	type fields struct {
-		createTweet *mocks.MockCreateTweet
+		createTweet   *mocks.MockCreateTweet
+		scheduleTweet *mocks.MockScheduleTweet
 	}
 	type args struct {
 		w http.ResponseWriter
@@ -78,7 +82,7 @@ func TestTweetController_CreateTweet(t *testing.T) {
 				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Hello, world!"}`)),
 			},
 			setupMocks: func(f *fields) {
-				f.createTweet.EXPECT().Execute("user1", "Hello, world!").Return(&domain.Tweet{
+				f.createTweet.EXPECT().Execute(gomock.Any(), "user1", "Hello, world!", domain.TweetAttachments{}).Return(&domain.Tweet{
 					ID:        "tweet1",
 					UserID:    "user1",
 					Content:   "Hello, world!",
@@ -88,6 +92,73 @@ func TestTweetController_CreateTweet(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody:   `{"id":"tweet1","user_id":"user1","content":"Hello, world!","timestamp":"2023-10-10 10:00:00 +0000 UTC"}`,
 		},
+		{
+			name: "response includes entities",
+			fields: fields{
+				createTweet: mocks.NewMockCreateTweet(ctrl),
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Hola @user2 #golang"}`)),
+			},
+			setupMocks: func(f *fields) {
+				f.createTweet.EXPECT().Execute(gomock.Any(), "user1", "Hola @user2 #golang", domain.TweetAttachments{}).Return(&domain.Tweet{
+					ID:      "tweet1",
+					UserID:  "user1",
+					Content: "Hola @user2 #golang",
+					Entities: []domain.Entity{
+						{Type: domain.EntityMention, Text: "@user2", Start: 5, End: 11, UserID: "user2"},
+						{Type: domain.EntityHashtag, Text: "#golang", Start: 12, End: 19},
+					},
+					Timestamp: time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"tweet1","user_id":"user1","content":"Hola @user2 #golang","entities":[{"type":"mention","text":"@user2","start":5,"end":11,"user_id":"user2"},{"type":"hashtag","text":"#golang","start":12,"end":19}],"timestamp":"2023-10-10 10:00:00 +0000 UTC"}`,
+		},
+		{
+			name: "response includes media",
+			fields: fields{
+				createTweet: mocks.NewMockCreateTweet(ctrl),
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Look","media_ids":["media1"]}`)),
+			},
+			setupMocks: func(f *fields) {
+				f.createTweet.EXPECT().Execute(gomock.Any(), "user1", "Look", domain.TweetAttachments{MediaIDs: []string{"media1"}}).Return(&domain.Tweet{
+					ID:        "tweet1",
+					UserID:    "user1",
+					Content:   "Look",
+					Media:     []domain.TweetMedia{{ID: "media1", ContentType: "image/jpeg", Width: 640, Height: 480}},
+					Timestamp: time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"tweet1","user_id":"user1","content":"Look","media":[{"id":"media1","type":"image/jpeg","width":640,"height":480,"url":"/media/media1","thumbnail_url":"/media/media1/thumbnail"}],"timestamp":"2023-10-10 10:00:00 +0000 UTC"}`,
+		},
+		{
+			name: "tweet with a poll",
+			fields: fields{
+				createTweet: mocks.NewMockCreateTweet(ctrl),
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Coffee?","poll":{"options":["Yes","No"],"duration_minutes":60}}`)),
+			},
+			setupMocks: func(f *fields) {
+				attachments := domain.TweetAttachments{Poll: &domain.PollDraft{Options: []string{"Yes", "No"}, Duration: time.Hour}}
+				f.createTweet.EXPECT().Execute(gomock.Any(), "user1", "Coffee?", attachments).Return(&domain.Tweet{
+					ID:        "tweet1",
+					UserID:    "user1",
+					Content:   "Coffee?",
+					Poll:      &domain.Poll{Options: []string{"Yes", "No"}, EndsAt: time.Date(2023, 10, 10, 11, 0, 0, 0, time.UTC)},
+					Timestamp: time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"tweet1","user_id":"user1","content":"Coffee?","poll":{"options":[{"text":"Yes"},{"text":"No"}],"ends_at":"2023-10-10T11:00:00Z","total_votes":0,"closed":false},"timestamp":"2023-10-10 10:00:00 +0000 UTC"}`,
+		},
 		{
 			name: "invalid request body",
 			fields: fields{
@@ -111,23 +182,64 @@ func TestTweetController_CreateTweet(t *testing.T) {
 				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Hello, world!"}`)),
 			},
 			setupMocks: func(f *fields) {
-				f.createTweet.EXPECT().Execute("user1", "Hello, world!").Return(nil, errors.New("error creating tweet"))
+				f.createTweet.EXPECT().Execute(gomock.Any(), "user1", "Hello, world!", domain.TweetAttachments{}).Return(nil, errors.New("error creating tweet"))
 			},
 			wantStatus: http.StatusBadRequest,
 			wantBody:   "error creating tweet\n",
 		},
+		{
+			name: "scheduled request",
+			fields: fields{
+				scheduleTweet: mocks.NewMockScheduleTweet(ctrl),
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Hello, world!","publish_at":"2025-03-05T09:00:00Z"}`)),
+			},
+			setupMocks: func(f *fields) {
+				publishAt := time.Date(2025, 3, 5, 9, 0, 0, 0, time.UTC)
+				createdAt := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+				f.scheduleTweet.EXPECT().Execute(gomock.Any(), "user1", "Hello, world!", domain.TweetAttachments{}, publishAt).Return(&domain.ScheduledTweet{
+					ID:        "scheduled1",
+					UserID:    "user1",
+					Content:   "Hello, world!",
+					PublishAt: publishAt,
+					Status:    domain.ScheduledTweetPending,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				}, nil)
+			},
+			wantStatus: http.StatusAccepted,
+			wantBody:   `{"id":"scheduled1","user_id":"user1","content":"Hello, world!","publish_at":"2025-03-05T09:00:00Z","status":"pending","created_at":"2025-03-04T12:00:00Z","updated_at":"2025-03-04T12:00:00Z"}`,
+		},
+		{
+			name: "publish time in the past",
+			fields: fields{
+				scheduleTweet: mocks.NewMockScheduleTweet(ctrl),
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("POST", "/tweets", strings.NewReader(`{"user_id":"user1","content":"Hello, world!","publish_at":"2025-03-05T09:00:00Z"}`)),
+			},
+			setupMocks: func(f *fields) {
+				f.scheduleTweet.EXPECT().Execute(gomock.Any(), "user1", "Hello, world!", domain.TweetAttachments{}, gomock.Any()).Return(nil, domain.ErrInvalidPublishTime)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domain.ErrInvalidPublishTime.Error() + "\n",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks(&tt.fields)
 			c := &TweetController{
-				createTweet: tt.fields.createTweet,
+				createTweet:   tt.fields.createTweet,
+				scheduleTweet: tt.fields.scheduleTweet,
 			}
 			c.CreateTweet(tt.args.w, tt.args.r)
 			res := tt.args.w.(*httptest.ResponseRecorder)
 			assert.Equal(t, tt.wantStatus, res.Code)
-			if tt.wantStatus == http.StatusOK {
+			if tt.wantStatus == http.StatusOK || tt.wantStatus == http.StatusAccepted {
 				assert.JSONEq(t, tt.wantBody, res.Body.String())
 			} else {
 				assert.Equal(t, tt.wantBody, res.Body.String())
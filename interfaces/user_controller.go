@@ -10,14 +10,12 @@ import (
 type UserController struct {
 	followUserUseCase  application.FollowUser
 	getTimelineUseCase application.GetTimeline
-	loadUsersUseCase   application.LoadUsers
 }
 
-func NewUserController(followUserUseCase application.FollowUser, getTimelineUseCase application.GetTimeline, loadUsersUseCase application.LoadUsers) *UserController {
+func NewUserController(followUserUseCase application.FollowUser, getTimelineUseCase application.GetTimeline) *UserController {
 	return &UserController{
 		followUserUseCase:  followUserUseCase,
 		getTimelineUseCase: getTimelineUseCase,
-		loadUsersUseCase:   loadUsersUseCase,
 	}
 }
 
@@ -30,12 +28,20 @@ func (c *UserController) FollowUser(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	err := c.followUserUseCase.Execute(req.FollowerID, req.FolloweeID)
+	request, err := c.followUserUseCase.Execute(r.Context(), req.FollowerID, req.FolloweeID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	if request == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	// The followee is protected and has to approve the request first.
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+_:
+	json.NewEncoder(w).Encode(newFollowRequestResponse(request))
 }
 
 func (c *UserController) GetTimeline(w http.ResponseWriter, r *http.Request) {
@@ -48,49 +54,16 @@ func (c *UserController) GetTimeline(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	tweets, err := c.getTimelineUseCase.Execute(req.UserID, req.Limit, req.Offset)
+	tweets, err := c.getTimelineUseCase.Execute(r.Context(), req.UserID, req.Limit, req.Offset)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	resp := make([]struct {
-		ID        string `json:"id"`
-		UserID    string `json:"user_id"`
-		Content   string `json:"content"`
-		Timestamp string `json:"timestamp"`
-	}, len(tweets))
+	resp := make([]tweetResponse, len(tweets))
 	for i, tweet := range tweets {
-		resp[i] = struct {
-			ID        string `json:"id"`
-			UserID    string `json:"user_id"`
-			Content   string `json:"content"`
-			Timestamp string `json:"timestamp"`
-		}{
-			ID:        tweet.ID,
-			UserID:    tweet.UserID,
-			Content:   tweet.Content,
-			Timestamp: tweet.Timestamp.String(),
-		}
+		resp[i] = newTweetResponse(tweet)
 	}
 	w.Header().Set("Content-Type", "application/json")
 _:
 	json.NewEncoder(w).Encode(resp)
 }
-
-func (c *UserController) LoadUsers(w http.ResponseWriter, r *http.Request) {
-	filePath := r.URL.Query().Get("file")
-	if filePath == "" {
-		http.Error(w, "file parameter is required", http.StatusBadRequest)
-		return
-	}
-
-	users, err := c.loadUsersUseCase.Execute(filePath)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-_:
-	json.NewEncoder(w).Encode(users)
-}
@@ -20,16 +20,15 @@ func TestFollowUser(t *testing.T) {
 
 	mockFollowUser := mocks.NewMockFollowUser(ctrl)
 	mockGetTimeline := mocks.NewMockGetTimeline(ctrl)
-	mockLoadUsers := mocks.NewMockLoadUsers(ctrl)
 
-	userController := NewUserController(mockFollowUser, mockGetTimeline, mockLoadUsers)
+	userController := NewUserController(mockFollowUser, mockGetTimeline)
 
 	t.Run("success", func(t *testing.T) {
 		reqBody := bytes.NewBufferString(`{"follower_id": "user1", "followee_id": "user2"}`)
 		req := httptest.NewRequest(http.MethodPost, "/follow", reqBody)
 		w := httptest.NewRecorder()
 
-		mockFollowUser.EXPECT().Execute("user1", "user2").Return(nil).Times(1)
+		mockFollowUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil, nil).Times(1)
 
 		userController.FollowUser(w, req)
 
@@ -39,12 +38,36 @@ func TestFollowUser(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 	})
 
+	t.Run("protected followee", func(t *testing.T) {
+		reqBody := bytes.NewBufferString(`{"follower_id": "user1", "followee_id": "user2"}`)
+		req := httptest.NewRequest(http.MethodPost, "/follow", reqBody)
+		w := httptest.NewRecorder()
+
+		request := domain.NewFollowRequest("request1", "user1", "user2")
+		mockFollowUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(request, nil).Times(1)
+
+		userController.FollowUser(w, req)
+
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var body struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		assert.Equal(t, "request1", body.ID)
+		assert.Equal(t, "requested", body.Status)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		reqBody := bytes.NewBufferString(`{"follower_id": "user1", "followee_id": "user2"}`)
 		req := httptest.NewRequest(http.MethodPost, "/follow", reqBody)
 		w := httptest.NewRecorder()
 
-		mockFollowUser.EXPECT().Execute("user1", "user2").Return(assert.AnError).Times(1)
+		mockFollowUser.EXPECT().Execute(gomock.Any(), "user1", "user2").Return(nil, assert.AnError).Times(1)
 
 		userController.FollowUser(w, req)
 
@@ -61,9 +84,8 @@ func TestGetTimeline(t *testing.T) {
 
 	mockGetTimelineUseCase := mocks.NewMockGetTimeline(ctrl)
 	mockFollowUserUseCase := mocks.NewMockFollowUser(ctrl)
-	mockLoadUsers := mocks.NewMockLoadUsers(ctrl)
 
-	userController := NewUserController(mockFollowUserUseCase, mockGetTimelineUseCase, mockLoadUsers)
+	userController := NewUserController(mockFollowUserUseCase, mockGetTimelineUseCase)
 
 	tweet := &domain.Tweet{
 		ID:        "tweet1",
@@ -77,7 +99,7 @@ func TestGetTimeline(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/timeline", reqBody)
 		w := httptest.NewRecorder()
 
-		mockGetTimelineUseCase.EXPECT().Execute("user1", 10, 0).Return([]*domain.Tweet{tweet}, nil).Times(1)
+		mockGetTimelineUseCase.EXPECT().Execute(gomock.Any(), "user1", 10, 0).Return([]*domain.Tweet{tweet}, nil).Times(1)
 
 		userController.GetTimeline(w, req)
 
@@ -104,7 +126,7 @@ func TestGetTimeline(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/timeline", reqBody)
 		w := httptest.NewRecorder()
 
-		mockGetTimelineUseCase.EXPECT().Execute("user1", 10, 0).Return(nil, assert.AnError).Times(1)
+		mockGetTimelineUseCase.EXPECT().Execute(gomock.Any(), "user1", 10, 0).Return(nil, assert.AnError).Times(1)
 
 		userController.GetTimeline(w, req)
 
@@ -114,53 +136,3 @@ func TestGetTimeline(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
-
-func TestLoadUsers(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockFollowUser := mocks.NewMockFollowUser(ctrl)
-	mockGetTimeline := mocks.NewMockGetTimeline(ctrl)
-	mockLoadUsers := mocks.NewMockLoadUsers(ctrl)
-
-	userController := NewUserController(mockFollowUser, mockGetTimeline, mockLoadUsers)
-
-	t.Run("success", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodGet, "/load_users?file=users.json", nil)
-		w := httptest.NewRecorder()
-
-		mockLoadUsers.EXPECT().Execute("users.json").Return([]domain.User{
-			{ID: "user1", Username: "User One"},
-			{ID: "user2", Username: "User Two"},
-		}, nil).Times(1)
-
-		userController.LoadUsers(w, req)
-
-		resp := w.Result()
-		defer resp.Body.Close()
-
-		var users []domain.User
-		json.NewDecoder(resp.Body).Decode(&users)
-
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
-		assert.Len(t, users, 2)
-		assert.Equal(t, "user1", users[0].ID)
-		assert.Equal(t, "User One", users[0].Username)
-		assert.Equal(t, "user2", users[1].ID)
-		assert.Equal(t, "User Two", users[1].Username)
-	})
-
-	t.Run("error", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodGet, "/load_users?file=users.json", nil)
-		w := httptest.NewRecorder()
-
-		mockLoadUsers.EXPECT().Execute("users.json").Return(nil, assert.AnError).Times(1)
-
-		userController.LoadUsers(w, req)
-
-		resp := w.Result()
-		defer resp.Body.Close()
-
-		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-	})
-}
@@ -0,0 +1,362 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pedro00627/urblog/application"
+)
+
+const maxWebSocketMessageSize = 4096
+
+var (
+	errNotAuthenticated     = errors.New("not authenticated")
+	errAlreadyAuthenticated = errors.New("already authenticated")
+	errAlreadySubscribed    = errors.New("already subscribed")
+	errNotSubscribed        = errors.New("not subscribed")
+	errUnknownChannel       = errors.New("unknown channel")
+	errUnknownMessageType   = errors.New("unknown message type")
+)
+
+type WebSocketConfig struct {
+	MaxConnections int
+	SendBuffer     int
+	PingInterval   time.Duration
+	PongWait       time.Duration
+	WriteWait      time.Duration
+}
+
+// wsRequest is a frame sent by the client: "auth" with a user_id, or
+// "subscribe"/"unsubscribe" with a channel ("home", "user" with a user_id, or
+// "notifications").
+type wsRequest struct {
+	Type        string `json:"type"`
+	Channel     string `json:"channel,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	LastEventID string `json:"last_event_id,omitempty"`
+}
+
+type wsNotification struct {
+	Type    string `json:"type"`
+	TweetID string `json:"tweet_id,omitempty"`
+	ActorID string `json:"actor_id"`
+}
+
+// wsFrame is a frame sent to the client.
+type wsFrame struct {
+	Type         string          `json:"type"`
+	Channel      string          `json:"channel,omitempty"`
+	UserID       string          `json:"user_id,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	Tweet        *tweetResponse  `json:"tweet,omitempty"`
+	Notification *wsNotification `json:"notification,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+type WebSocketController struct {
+	streamTimeline application.StreamTimeline
+	liveUpdates    application.LiveUpdates
+	config         WebSocketConfig
+	upgrader       websocket.Upgrader
+	connections    atomic.Int64
+	closing        chan struct{}
+	closeOnce      sync.Once
+}
+
+func NewWebSocketController(streamTimeline application.StreamTimeline, liveUpdates application.LiveUpdates, config WebSocketConfig) *WebSocketController {
+	return &WebSocketController{
+		streamTimeline: streamTimeline,
+		liveUpdates:    liveUpdates,
+		config:         config,
+		closing:        make(chan struct{}),
+	}
+}
+
+// Shutdown closes every connection, telling its client that the server is
+// going away, and refuses new ones. Connections are hijacked from the HTTP
+// server, which does not close them itself; Wait waits until they are closed.
+func (c *WebSocketController) Shutdown() {
+	c.closeOnce.Do(func() { close(c.closing) })
+}
+
+// Wait waits until no connection is open, or until ctx is done.
+func (c *WebSocketController) Wait(ctx context.Context) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for c.connections.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// Connect upgrades the request to a WebSocket connection over which the client
+// authenticates and subscribes to live updates.
+func (c *WebSocketController) Connect(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-c.closing:
+		http.Error(w, "shutting down", http.StatusServiceUnavailable)
+		return
+	default:
+	}
+	if c.connections.Add(1) > int64(c.config.MaxConnections) {
+		c.connections.Add(-1)
+		http.Error(w, "too many connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer c.connections.Add(-1)
+
+	ws, err := c.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	conn := &wsConnection{
+		controller:    c,
+		ws:            ws,
+		send:          make(chan wsFrame, c.config.SendBuffer),
+		done:          make(chan struct{}),
+		subscriptions: make(map[string]*wsSubscription),
+	}
+	go conn.writeLoop()
+	conn.readLoop(r.Context())
+}
+
+type wsSubscription struct {
+	close func()
+}
+
+type wsConnection struct {
+	controller *WebSocketController
+	ws         *websocket.Conn
+	send       chan wsFrame
+	done       chan struct{}
+	closeOnce  sync.Once
+
+	// userID is only accessed by the read loop.
+	userID string
+
+	mu            sync.Mutex
+	subscriptions map[string]*wsSubscription
+}
+
+func (c *wsConnection) readLoop(ctx context.Context) {
+	defer c.close()
+
+	config := c.controller.config
+	c.ws.SetReadLimit(maxWebSocketMessageSize)
+	_ = c.ws.SetReadDeadline(time.Now().Add(config.PongWait))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(config.PongWait))
+	})
+
+	for {
+		var req wsRequest
+		if err := c.ws.ReadJSON(&req); err != nil {
+			return
+		}
+		if err := c.handle(ctx, req); err != nil {
+			c.enqueue(wsFrame{Type: "error", Channel: req.Channel, Error: err.Error()})
+		}
+	}
+}
+
+func (c *wsConnection) writeLoop() {
+	config := c.controller.config
+	ping := time.NewTicker(config.PingInterval)
+	defer ping.Stop()
+	defer c.close()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.controller.closing:
+			message := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+			_ = c.ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(config.WriteWait))
+			return
+		case frame := <-c.send:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(config.WriteWait))
+			if err := c.ws.WriteJSON(frame); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(config.WriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConnection) handle(ctx context.Context, req wsRequest) error {
+	switch req.Type {
+	case "auth":
+		if c.userID != "" {
+			return errAlreadyAuthenticated
+		}
+		if err := c.controller.liveUpdates.Authenticate(ctx, req.UserID); err != nil {
+			return err
+		}
+		c.userID = req.UserID
+		c.enqueue(wsFrame{Type: "authenticated", UserID: c.userID})
+		return nil
+	case "subscribe":
+		if c.userID == "" {
+			return errNotAuthenticated
+		}
+		return c.subscribe(ctx, req)
+	case "unsubscribe":
+		key := subscriptionKey(req)
+		c.mu.Lock()
+		sub, ok := c.subscriptions[key]
+		delete(c.subscriptions, key)
+		c.mu.Unlock()
+		if !ok {
+			return errNotSubscribed
+		}
+		sub.close()
+		c.enqueue(wsFrame{Type: "unsubscribed", Channel: req.Channel, UserID: req.UserID})
+		return nil
+	}
+	return errUnknownMessageType
+}
+
+func (c *wsConnection) subscribe(ctx context.Context, req wsRequest) error {
+	key := subscriptionKey(req)
+	c.mu.Lock()
+	_, exists := c.subscriptions[key]
+	c.mu.Unlock()
+	if exists {
+		return errAlreadySubscribed
+	}
+
+	var sub *wsSubscription
+	var next func() bool
+	switch req.Channel {
+	case "home":
+		timeline, err := c.controller.streamTimeline.Subscribe(ctx, c.userID, req.LastEventID)
+		if err != nil {
+			return err
+		}
+		sub = &wsSubscription{close: timeline.Close}
+		next = func() bool {
+			event, ok := <-timeline.Events
+			if !ok {
+				return false
+			}
+			tweet := newTweetResponse(event.Tweet)
+			return c.enqueue(wsFrame{Type: "tweet", Channel: req.Channel, ID: event.ID, Tweet: &tweet})
+		}
+	case "user", "notifications":
+		var live *application.LiveSubscription
+		var err error
+		if req.Channel == "user" {
+			live, err = c.controller.liveUpdates.SubscribeUserTweets(ctx, c.userID, req.UserID)
+		} else {
+			live, err = c.controller.liveUpdates.SubscribeNotifications(ctx, c.userID)
+		}
+		if err != nil {
+			return err
+		}
+		sub = &wsSubscription{close: live.Close}
+		next = func() bool {
+			message, ok := <-live.Messages
+			if !ok {
+				return false
+			}
+			return c.enqueue(newLiveFrame(req, message))
+		}
+	default:
+		return errUnknownChannel
+	}
+
+	c.mu.Lock()
+	c.subscriptions[key] = sub
+	c.mu.Unlock()
+	c.enqueue(wsFrame{Type: "subscribed", Channel: req.Channel, UserID: req.UserID})
+	go c.forward(req, sub, next)
+	return nil
+}
+
+// forward calls next until it reports that the subscription or the connection
+// is over. If the hub dropped the subscription for falling behind, the client
+// is told so it can subscribe again.
+func (c *wsConnection) forward(req wsRequest, sub *wsSubscription, next func() bool) {
+	for next() {
+	}
+
+	key := subscriptionKey(req)
+	c.mu.Lock()
+	dropped := c.subscriptions[key] == sub
+	if dropped {
+		delete(c.subscriptions, key)
+	}
+	c.mu.Unlock()
+	if dropped {
+		c.enqueue(wsFrame{Type: "error", Channel: req.Channel, UserID: req.UserID, Error: "subscription dropped: client too slow"})
+	}
+}
+
+// enqueue queues a frame for the write loop. A connection whose send buffer is
+// full is closed rather than allowed to hold up the hubs.
+func (c *wsConnection) enqueue(frame wsFrame) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+	select {
+	case c.send <- frame:
+		return true
+	default:
+		c.close()
+		return false
+	}
+}
+
+func (c *wsConnection) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.mu.Lock()
+		for key, sub := range c.subscriptions {
+			delete(c.subscriptions, key)
+			sub.close()
+		}
+		c.mu.Unlock()
+		_ = c.ws.Close()
+	})
+}
+
+func subscriptionKey(req wsRequest) string {
+	if req.Channel == "user" {
+		return "user:" + req.UserID
+	}
+	return req.Channel
+}
+
+func newLiveFrame(req wsRequest, message application.LiveMessage) wsFrame {
+	frame := wsFrame{Channel: req.Channel}
+	if message.Tweet != nil {
+		tweet := newTweetResponse(message.Tweet)
+		frame.Type = "tweet"
+		frame.UserID = message.Tweet.UserID
+		frame.Tweet = &tweet
+	}
+	if message.Notification != nil {
+		frame.Type = "notification"
+		frame.Notification = &wsNotification{
+			Type:    string(message.Notification.Type),
+			TweetID: message.Notification.TweetID,
+			ActorID: message.Notification.ActorID,
+		}
+	}
+	return frame
+}
@@ -0,0 +1,167 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/pedro00627/urblog/application"
+	"github.com/pedro00627/urblog/domain"
+	"github.com/pedro00627/urblog/infrastructure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketController(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	user1 := domain.NewUser("user1", "user1")
+	user1.Following["user2"] = true
+	userRepo.EXPECT().FindByID(gomock.Any(), "user1").Return(user1, nil).AnyTimes()
+	userRepo.EXPECT().FindByID(gomock.Any(), "user2").Return(domain.NewUser("user2", "user2"), nil).AnyTimes()
+	userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, domain.ErrUserNotFound).AnyTimes()
+
+	timelineHub := application.NewTimelineHub(userRepo, 10, 10)
+	liveHub := application.NewLiveHub(userRepo, 10)
+	controller := NewWebSocketController(timelineHub, liveHub, WebSocketConfig{
+		MaxConnections: 1,
+		SendBuffer:     10,
+		PingInterval:   time.Second,
+		PongWait:       5 * time.Second,
+		WriteWait:      time.Second,
+	})
+	server := httptest.NewServer(http.HandlerFunc(controller.Connect))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	exchange := func(req wsRequest) wsFrame {
+		require.NoError(t, conn.WriteJSON(req))
+		var frame wsFrame
+		require.NoError(t, conn.ReadJSON(&frame))
+		return frame
+	}
+
+	t.Run("subscribe requires authentication", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "home"})
+		assert.Equal(t, "error", frame.Type)
+		assert.Equal(t, errNotAuthenticated.Error(), frame.Error)
+	})
+
+	t.Run("unknown user cannot authenticate", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "auth", UserID: "ghost"})
+		assert.Equal(t, "error", frame.Type)
+		assert.Equal(t, domain.ErrUserNotFound.Error(), frame.Error)
+	})
+
+	t.Run("authenticate", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "auth", UserID: "user1"})
+		assert.Equal(t, wsFrame{Type: "authenticated", UserID: "user1"}, frame)
+	})
+
+	t.Run("home timeline", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "home"})
+		assert.Equal(t, wsFrame{Type: "subscribed", Channel: "home"}, frame)
+
+		timelineHub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet1", UserID: "user2", Content: "hola"}})
+
+		require.NoError(t, conn.ReadJSON(&frame))
+		assert.Equal(t, "tweet", frame.Type)
+		assert.Equal(t, "home", frame.Channel)
+		assert.Equal(t, "1", frame.ID)
+		assert.Equal(t, "tweet1", frame.Tweet.ID)
+	})
+
+	t.Run("user tweets", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "user", UserID: "user2"})
+		assert.Equal(t, wsFrame{Type: "subscribed", Channel: "user", UserID: "user2"}, frame)
+
+		liveHub.Handle(context.Background(), domain.TweetCreated{Tweet: &domain.Tweet{ID: "tweet2", UserID: "user2"}})
+
+		require.NoError(t, conn.ReadJSON(&frame))
+		assert.Equal(t, "tweet", frame.Type)
+		assert.Equal(t, "user", frame.Channel)
+		assert.Equal(t, "user2", frame.UserID)
+		assert.Equal(t, "tweet2", frame.Tweet.ID)
+	})
+
+	t.Run("notifications", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "notifications"})
+		assert.Equal(t, wsFrame{Type: "subscribed", Channel: "notifications"}, frame)
+
+		liveHub.Handle(context.Background(), domain.UserFollowed{FollowerID: "user2", FolloweeID: "user1"})
+
+		require.NoError(t, conn.ReadJSON(&frame))
+		assert.Equal(t, "notification", frame.Type)
+		assert.Equal(t, &wsNotification{Type: "followed", ActorID: "user2"}, frame.Notification)
+	})
+
+	t.Run("duplicate subscription", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "notifications"})
+		assert.Equal(t, errAlreadySubscribed.Error(), frame.Error)
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "unsubscribe", Channel: "notifications"})
+		assert.Equal(t, wsFrame{Type: "unsubscribed", Channel: "notifications"}, frame)
+
+		frame = exchange(wsRequest{Type: "unsubscribe", Channel: "notifications"})
+		assert.Equal(t, errNotSubscribed.Error(), frame.Error)
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		frame := exchange(wsRequest{Type: "subscribe", Channel: "everything"})
+		assert.Equal(t, errUnknownChannel.Error(), frame.Error)
+	})
+
+	t.Run("connection limit", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+}
+
+func TestWebSocketControllerShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	controller := NewWebSocketController(application.NewTimelineHub(userRepo, 10, 10), application.NewLiveHub(userRepo, 10), WebSocketConfig{
+		MaxConnections: 10,
+		SendBuffer:     10,
+		PingInterval:   time.Second,
+		PongWait:       5 * time.Second,
+		WriteWait:      time.Second,
+	})
+	server := httptest.NewServer(http.HandlerFunc(controller.Connect))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	controller.Shutdown()
+
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, controller.Wait(ctx))
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}